@@ -5,7 +5,9 @@ import (
 	"log/slog"
 	"os"
 
+	"github.com/milsim-tools/pincer/internal/middleware"
 	"github.com/milsim-tools/pincer/pkg/pincer"
+	"github.com/milsim-tools/pincer/pkg/tracing"
 	"github.com/urfave/cli/v2"
 )
 
@@ -40,6 +42,7 @@ func main() {
 
 		Commands: []*cli.Command{
 			runCmd(),
+			migrateCmd(),
 		},
 	}
 
@@ -57,25 +60,7 @@ func runCmd() *cli.Command {
 		Action: func(ctx *cli.Context) error {
 			config := pincer.ConfigFromFlags(Version, ctx)
 
-			var level slog.Level
-			switch ctx.String("log-level") {
-			case "debug":
-				level = slog.LevelDebug
-			case "info":
-				level = slog.LevelInfo
-			case "warn":
-				level = slog.LevelWarn
-			case "error":
-				level = slog.LevelError
-			}
-
-			logger := slog.New(
-				slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{
-					Level: level,
-				}),
-			)
-
-			h, err := pincer.New(logger, config)
+			h, err := pincer.New(newLogger(ctx), config)
 			if err != nil {
 				return err
 			}
@@ -85,3 +70,23 @@ func runCmd() *cli.Command {
 	}
 	return cmd
 }
+
+func newLogger(ctx *cli.Context) *slog.Logger {
+	var level slog.Level
+	switch ctx.String("log-level") {
+	case "debug":
+		level = slog.LevelDebug
+	case "info":
+		level = slog.LevelInfo
+	case "warn":
+		level = slog.LevelWarn
+	case "error":
+		level = slog.LevelError
+	}
+
+	return slog.New(
+		middleware.NewRequestIDLogHandler(tracing.NewLogHandler(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{
+			Level: level,
+		}))),
+	)
+}
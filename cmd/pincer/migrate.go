@@ -0,0 +1,229 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"text/tabwriter"
+
+	"github.com/milsim-tools/pincer/pkg/db"
+	"github.com/milsim-tools/pincer/pkg/db/migrate"
+	"github.com/milsim-tools/pincer/pkg/pincer"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	flagModule = "module"
+	flagSteps  = "steps"
+	flagDir    = "dir"
+)
+
+func migrateCmd() *cli.Command {
+	moduleFlag := &cli.StringSliceFlag{
+		Name:  flagModule,
+		Usage: "The module(s) to migrate. Defaults to every module",
+	}
+
+	cmd := &cli.Command{
+		Name:  "migrate",
+		Usage: "Manages the database schema of each module",
+		Subcommands: []*cli.Command{
+			{
+				Name:  "up",
+				Usage: "Applies every pending migration",
+				Flags: append([]cli.Flag{moduleFlag}, db.Flags...),
+				Action: func(ctx *cli.Context) error {
+					database, sources, err := openDatabase(ctx, ctx.StringSlice(flagModule))
+					if err != nil {
+						return err
+					}
+
+					for _, source := range sources {
+						migrator := database.migrator(source)
+
+						applied, err := migrator.Up(ctx.Context, source)
+						if err != nil {
+							return err
+						}
+						fmt.Printf("%s: applied %d migration(s)\n", source.Module, applied)
+					}
+
+					return nil
+				},
+			},
+			{
+				Name:  "down",
+				Usage: "Reverts the most recently applied migrations of a module",
+				Flags: append([]cli.Flag{
+					&cli.StringFlag{
+						Name:     flagModule,
+						Usage:    "The module to revert migrations of",
+						Required: true,
+					},
+					&cli.IntFlag{
+						Name:  flagSteps,
+						Value: 1,
+						Usage: "How many migrations to revert",
+					},
+				}, db.Flags...),
+				Action: func(ctx *cli.Context) error {
+					database, sources, err := openDatabase(ctx, []string{ctx.String(flagModule)})
+					if err != nil {
+						return err
+					}
+
+					migrator := database.migrator(sources[0])
+
+					reverted, err := migrator.Down(ctx.Context, sources[0], ctx.Int(flagSteps))
+					if err != nil {
+						return err
+					}
+					fmt.Printf("%s: reverted %d migration(s)\n", sources[0].Module, reverted)
+
+					return nil
+				},
+			},
+			{
+				Name:  "status",
+				Usage: "Lists the migrations of each module and whether they are applied",
+				Flags: append([]cli.Flag{moduleFlag}, db.Flags...),
+				Action: func(ctx *cli.Context) error {
+					database, sources, err := openDatabase(ctx, ctx.StringSlice(flagModule))
+					if err != nil {
+						return err
+					}
+
+					w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+					fmt.Fprintln(w, "MODULE\tVERSION\tNAME\tAPPLIED AT")
+					for _, source := range sources {
+						migrator := database.migrator(source)
+
+						statuses, err := migrator.Status(ctx.Context, source)
+						if err != nil {
+							return err
+						}
+
+						for _, status := range statuses {
+							appliedAt := "pending"
+							if status.Applied {
+								appliedAt = status.AppliedAt.Format("2006-01-02 15:04:05")
+							}
+							fmt.Fprintf(w, "%s\t%d\t%s\t%s\n", source.Module, status.Version, status.Name, appliedAt)
+						}
+					}
+
+					return w.Flush()
+				},
+			},
+			{
+				Name:      "create",
+				Usage:     "Creates an empty pair of up and down migrations for a module",
+				ArgsUsage: "<name>",
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:     flagModule,
+						Usage:    "The module to create the migration for",
+						Required: true,
+					},
+					&cli.StringFlag{
+						Name:  flagDir,
+						Usage: "The directory to create the migration in. Defaults to the module's migrations in this repository",
+					},
+				},
+				Action: func(ctx *cli.Context) error {
+					if ctx.NArg() != 1 {
+						return fmt.Errorf("expected the name of the migration")
+					}
+
+					source, err := findSource(ctx.String(flagModule))
+					if err != nil {
+						return err
+					}
+
+					dir := ctx.String(flagDir)
+					if dir == "" {
+						dir = filepath.Join("pkg", source.Module, source.Dir)
+					}
+
+					up, down, err := migrate.Create(dir, ctx.Args().First())
+					if err != nil {
+						return err
+					}
+					fmt.Printf("created %s\ncreated %s\n", up, down)
+
+					return nil
+				},
+			},
+		},
+	}
+
+	return cmd
+}
+
+// migrationDatabase is the database migrations are applied to.
+type migrationDatabase struct {
+	handles map[string]*db.Db
+}
+
+// openDatabase connects to the database of the given modules, or of every
+// module if none are given, and returns their migration sources.
+func openDatabase(ctx *cli.Context, modules []string) (*migrationDatabase, []migrate.Source, error) {
+	var sources []migrate.Source
+	for _, module := range modules {
+		source, err := findSource(module)
+		if err != nil {
+			return nil, nil, err
+		}
+		sources = append(sources, source)
+	}
+	if len(sources) == 0 {
+		sources = pincer.Migrations
+	}
+
+	cfg := db.ConfigFromFlags(ctx)
+	logger := newLogger(ctx)
+	database, err := db.New(logger, cfg, prometheus.NewRegistry())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	handles := make(map[string]*db.Db, len(sources))
+	for _, source := range sources {
+		handle, err := database.Module(source.Module, logger.With("module", source.Module))
+		if err != nil {
+			return nil, nil, err
+		}
+		handles[source.Module] = handle
+	}
+
+	connectCtx := ctx.Context
+	if cfg.ConnectTimeout > 0 {
+		var cancel context.CancelFunc
+		connectCtx, cancel = context.WithTimeout(connectCtx, cfg.ConnectTimeout)
+		defer cancel()
+	}
+	if err := database.Connect(connectCtx); err != nil {
+		return nil, nil, err
+	}
+
+	return &migrationDatabase{handles: handles}, sources, nil
+}
+
+// migrator returns a migrator for the database of the source's module.
+func (d *migrationDatabase) migrator(source migrate.Source) *migrate.Migrator {
+	return d.handles[source.Module].Migrator()
+}
+
+func findSource(module string) (migrate.Source, error) {
+	i := slices.IndexFunc(pincer.Migrations, func(source migrate.Source) bool {
+		return source.Module == module
+	})
+	if i < 0 {
+		return migrate.Source{}, fmt.Errorf("module %s has no migrations", module)
+	}
+
+	return pincer.Migrations[i], nil
+}
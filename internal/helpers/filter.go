@@ -0,0 +1,376 @@
+package helpers
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/cel-go/cel"
+	celast "github.com/google/cel-go/common/ast"
+	"github.com/google/cel-go/common/operators"
+	"github.com/google/cel-go/common/types"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm/clause"
+)
+
+// FilterField is the request field List RPCs take their filter from.
+const FilterField = "filter"
+
+const (
+	// maxFilterLength bounds the size of filter expressions, in code points.
+	maxFilterLength = 1024
+
+	// maxFilterDepth bounds the nesting of filter expressions.
+	maxFilterDepth = 32
+)
+
+var timestampName = (&timestamppb.Timestamp{}).ProtoReflect().Descriptor().FullName()
+
+// FilterColumns maps the fields of a resource message that List RPCs can be
+// filtered by to the columns they are stored in.
+type FilterColumns map[string]string
+
+// Filter translates the filter expressions of a List RPC into query
+// conditions.
+//
+// Filters are CEL expressions over the fields of the resource message, such
+// as `display_name.contains("Ranger")` or
+// `status == APPROVED && created_at > timestamp("2025-01-01T00:00:00Z")`.
+// The values of enum fields are named without the enum's prefix. Expressions
+// are type-checked against the message, and only comparisons of fields with
+// literals are supported, so every filter translates to a parameterised
+// condition.
+type Filter struct {
+	env       *cel.Env
+	columns   FilterColumns
+	constants map[string]int64
+}
+
+// NewFilter returns a Filter for the fields of the resource message with a
+// column.
+func NewFilter(resource proto.Message, columns FilterColumns) (*Filter, error) {
+	fields := resource.ProtoReflect().Descriptor().Fields()
+
+	f := &Filter{columns: columns, constants: map[string]int64{}}
+	opts := []cel.EnvOption{
+		cel.ClearMacros(),
+		cel.ParserExpressionSizeLimit(maxFilterLength),
+		cel.ParserRecursionLimit(maxFilterDepth),
+	}
+	for name := range columns {
+		field := fields.ByName(protoreflect.Name(name))
+		if field == nil {
+			return nil, fmt.Errorf("%s has no field %s", resource.ProtoReflect().Descriptor().FullName(), name)
+		}
+		if field.IsList() || field.IsMap() {
+			return nil, fmt.Errorf("cannot filter by repeated field %s", name)
+		}
+
+		t, err := filterType(field)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, cel.Variable(name, t))
+
+		if field.Kind() == protoreflect.EnumKind {
+			enum := field.Enum()
+			prefix := enumPrefix(enum)
+			for i := range enum.Values().Len() {
+				value := enum.Values().Get(i)
+				constant := strings.TrimPrefix(string(value.Name()), prefix)
+				if _, ok := f.constants[constant]; ok {
+					return nil, fmt.Errorf("the value %s of %s is ambiguous", constant, enum.FullName())
+				}
+				f.constants[constant] = int64(value.Number())
+				opts = append(opts, cel.Constant(constant, cel.IntType, types.Int(value.Number())))
+			}
+		}
+	}
+
+	env, err := cel.NewEnv(opts...)
+	if err != nil {
+		return nil, err
+	}
+	f.env = env
+
+	return f, nil
+}
+
+// MustNewFilter is like NewFilter, but panics if the filter cannot be created.
+func MustNewFilter(resource proto.Message, columns FilterColumns) *Filter {
+	f, err := NewFilter(resource, columns)
+	if err != nil {
+		panic(err)
+	}
+	return f
+}
+
+// Parse translates the filter into a query condition, or nil if the filter is
+// empty.
+//
+// The returned error is an InvalidArgument status, with the filter field
+// violation as its details.
+func (f *Filter) Parse(filter string) (clause.Expression, error) {
+	if strings.TrimSpace(filter) == "" {
+		return nil, nil
+	}
+
+	ast, issues := f.env.Compile(filter)
+	if issues.Err() != nil {
+		return nil, InvalidArgument(FilterField, issues.Err().Error())
+	}
+	if !ast.OutputType().IsExactType(cel.BoolType) {
+		return nil, InvalidArgument(FilterField, "the filter must be a condition, not a "+ast.OutputType().String())
+	}
+
+	condition, err := f.condition(ast.NativeRep().Expr())
+	if err != nil {
+		return nil, InvalidArgument(FilterField, err.Error())
+	}
+
+	return condition, nil
+}
+
+// condition translates a boolean expression.
+func (f *Filter) condition(e celast.Expr) (clause.Expression, error) {
+	switch e.Kind() {
+	case celast.IdentKind:
+		// A boolean field on its own.
+		column, ok := f.columns[e.AsIdent()]
+		if !ok {
+			return nil, fmt.Errorf("%s is not a condition", e.AsIdent())
+		}
+		return clause.Eq{Column: clause.Column{Name: column}, Value: true}, nil
+
+	case celast.CallKind:
+		call := e.AsCall()
+		args := call.Args()
+
+		switch call.FunctionName() {
+		case operators.LogicalAnd, operators.LogicalOr:
+			conditions := make([]clause.Expression, 0, len(args))
+			for _, arg := range args {
+				condition, err := f.condition(arg)
+				if err != nil {
+					return nil, err
+				}
+				conditions = append(conditions, condition)
+			}
+			if call.FunctionName() == operators.LogicalAnd {
+				return clause.And(conditions...), nil
+			}
+			return clause.Or(conditions...), nil
+
+		case operators.LogicalNot:
+			condition, err := f.condition(args[0])
+			if err != nil {
+				return nil, err
+			}
+			return clause.Not(condition), nil
+
+		case operators.Equals, operators.NotEquals, operators.Less, operators.LessEquals, operators.Greater, operators.GreaterEquals:
+			return f.comparison(call.FunctionName(), args[0], args[1])
+
+		case operators.In:
+			return f.in(args[0], args[1])
+
+		case "contains", "startsWith", "endsWith":
+			if call.IsMemberFunction() {
+				return f.like(call.FunctionName(), call.Target(), args[0])
+			}
+		}
+
+		return nil, fmt.Errorf("unsupported function %s", strings.Trim(call.FunctionName(), "_@"))
+	}
+
+	return nil, errors.New("unsupported expression, filters compare fields with values")
+}
+
+// comparison translates a comparison of a field with a value, in either
+// order.
+func (f *Filter) comparison(op string, lhs, rhs celast.Expr) (clause.Expression, error) {
+	column, value, err := f.operands(lhs, rhs)
+	if err != nil {
+		// The value may come first, as in `5 < field`, which is the same as
+		// the mirrored comparison.
+		var swapErr error
+		column, value, swapErr = f.operands(rhs, lhs)
+		if swapErr != nil {
+			return nil, err
+		}
+		op = mirrored[op]
+	}
+
+	col := clause.Column{Name: column}
+	switch op {
+	case operators.Equals:
+		return clause.Eq{Column: col, Value: value}, nil
+	case operators.NotEquals:
+		return clause.Neq{Column: col, Value: value}, nil
+	case operators.Less:
+		return clause.Lt{Column: col, Value: value}, nil
+	case operators.LessEquals:
+		return clause.Lte{Column: col, Value: value}, nil
+	case operators.Greater:
+		return clause.Gt{Column: col, Value: value}, nil
+	default:
+		return clause.Gte{Column: col, Value: value}, nil
+	}
+}
+
+// mirrored maps comparisons to the comparison with their operands swapped.
+var mirrored = map[string]string{
+	operators.Equals:        operators.Equals,
+	operators.NotEquals:     operators.NotEquals,
+	operators.Less:          operators.Greater,
+	operators.LessEquals:    operators.GreaterEquals,
+	operators.Greater:       operators.Less,
+	operators.GreaterEquals: operators.LessEquals,
+}
+
+// in translates `field in [values...]`.
+func (f *Filter) in(field, list celast.Expr) (clause.Expression, error) {
+	column, err := f.column(field)
+	if err != nil {
+		return nil, err
+	}
+	if list.Kind() != celast.ListKind {
+		return nil, errors.New("in must be followed by a list of values")
+	}
+
+	elements := list.AsList().Elements()
+	values := make([]any, 0, len(elements))
+	for _, element := range elements {
+		value, err := f.value(element)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+
+	return clause.IN{Column: clause.Column{Name: column}, Values: values}, nil
+}
+
+// likeEscaper escapes the wildcards of LIKE patterns.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// like translates the contains, startsWith and endsWith string functions.
+func (f *Filter) like(function string, field, arg celast.Expr) (clause.Expression, error) {
+	column, err := f.column(field)
+	if err != nil {
+		return nil, err
+	}
+	value, err := f.value(arg)
+	if err != nil {
+		return nil, err
+	}
+	s, ok := value.(string)
+	if !ok {
+		return nil, fmt.Errorf("%s takes a string", function)
+	}
+
+	pattern := likeEscaper.Replace(s)
+	switch function {
+	case "contains":
+		pattern = "%" + pattern + "%"
+	case "startsWith":
+		pattern = pattern + "%"
+	case "endsWith":
+		pattern = "%" + pattern
+	}
+
+	return clause.Expr{SQL: `? LIKE ? ESCAPE '\'`, Vars: []any{clause.Column{Name: column}, pattern}}, nil
+}
+
+// operands returns the column and value of a comparison.
+func (f *Filter) operands(field, value celast.Expr) (string, any, error) {
+	column, err := f.column(field)
+	if err != nil {
+		return "", nil, err
+	}
+	v, err := f.value(value)
+	if err != nil {
+		return "", nil, err
+	}
+	return column, v, nil
+}
+
+// column returns the column of a field.
+func (f *Filter) column(e celast.Expr) (string, error) {
+	if e.Kind() == celast.IdentKind {
+		if column, ok := f.columns[e.AsIdent()]; ok {
+			return column, nil
+		}
+	}
+	return "", errors.New("comparisons must be between a field and a value")
+}
+
+// value returns the value of a literal, an enum value or a timestamp.
+func (f *Filter) value(e celast.Expr) (any, error) {
+	switch e.Kind() {
+	case celast.LiteralKind:
+		switch v := e.AsLiteral().(type) {
+		case types.String, types.Int, types.Uint, types.Double, types.Bool:
+			return v.Value(), nil
+		}
+
+	case celast.IdentKind:
+		if v, ok := f.constants[e.AsIdent()]; ok {
+			return v, nil
+		}
+
+	case celast.CallKind:
+		call := e.AsCall()
+		if call.FunctionName() == "timestamp" && len(call.Args()) == 1 && call.Args()[0].Kind() == celast.LiteralKind {
+			if s, ok := call.Args()[0].AsLiteral().(types.String); ok {
+				t, err := time.Parse(time.RFC3339Nano, string(s))
+				if err != nil {
+					return nil, fmt.Errorf("invalid timestamp %q, expected RFC 3339", string(s))
+				}
+				return t, nil
+			}
+		}
+	}
+
+	return nil, errors.New("comparisons must be between a field and a value")
+}
+
+// filterType returns the CEL type of a field.
+func filterType(field protoreflect.FieldDescriptor) (*cel.Type, error) {
+	switch field.Kind() {
+	case protoreflect.StringKind:
+		return cel.StringType, nil
+	case protoreflect.BoolKind:
+		return cel.BoolType, nil
+	case protoreflect.EnumKind,
+		protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return cel.IntType, nil
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return cel.UintType, nil
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return cel.DoubleType, nil
+	case protoreflect.MessageKind:
+		if field.Message().FullName() == timestampName {
+			return cel.TimestampType, nil
+		}
+	}
+
+	return nil, fmt.Errorf("cannot filter by %s, fields of type %s are not supported", field.Name(), field.Kind())
+}
+
+// enumPrefix returns the prefix of the values of the enum, by the protobuf
+// style guide the enum's name in upper snake case.
+func enumPrefix(enum protoreflect.EnumDescriptor) string {
+	var b strings.Builder
+	for i, r := range string(enum.Name()) {
+		if i > 0 && r >= 'A' && r <= 'Z' {
+			b.WriteByte('_')
+		}
+		b.WriteRune(r)
+	}
+	return strings.ToUpper(b.String()) + "_"
+}
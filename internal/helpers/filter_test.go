@@ -0,0 +1,152 @@
+package helpers
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/glebarez/sqlite"
+	applicationsv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/applications/v1"
+	usersv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/users/v1"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/logger"
+)
+
+func TestFilterParse(t *testing.T) {
+	users := MustNewFilter(&usersv1.User{}, FilterColumns{
+		"username":     "username",
+		"display_name": "name",
+		"created_at":   "created_at",
+	})
+	applications := MustNewFilter(&applicationsv1.Application{}, FilterColumns{
+		"status":     "status",
+		"decided_at": "decided_at",
+	})
+
+	tests := []struct {
+		name   string
+		filter *Filter
+		expr   string
+		want   string
+	}{
+		{name: "empty", filter: users, expr: "  "},
+		{name: "equals", filter: users, expr: `username == "ranger"`, want: "`username` = \"ranger\""},
+		{name: "column name", filter: users, expr: `display_name != "Ranger"`, want: "`name` <> \"Ranger\""},
+		{name: "mirrored equals", filter: users, expr: `"ranger" == username`, want: "`username` = \"ranger\""},
+		{name: "mirrored less", filter: users, expr: `"m" < username`, want: "`username` > \"m\""},
+		{name: "mirrored less or equal", filter: users, expr: `"m" <= username`, want: "`username` >= \"m\""},
+		{name: "mirrored greater", filter: users, expr: `"m" > username`, want: "`username` < \"m\""},
+		{name: "mirrored greater or equal", filter: users, expr: `"m" >= username`, want: "`username` <= \"m\""},
+		{name: "contains", filter: users, expr: `display_name.contains("Ranger")`, want: "`name` LIKE \"%Ranger%\" ESCAPE '\\'"},
+		{name: "startsWith", filter: users, expr: `display_name.startsWith("Ranger")`, want: "`name` LIKE \"Ranger%\" ESCAPE '\\'"},
+		{name: "endsWith", filter: users, expr: `display_name.endsWith("Ranger")`, want: "`name` LIKE \"%Ranger\" ESCAPE '\\'"},
+		{name: "escaped wildcards", filter: users, expr: `username.contains("100%_sure\\")`, want: "`username` LIKE \"%100\\%\\_sure\\\\%\" ESCAPE '\\'"},
+		{name: "timestamp", filter: users, expr: `created_at >= timestamp("2025-01-01T00:00:00Z")`, want: "`created_at` >= \"2025-01-01 00:00:00\""},
+		{name: "enum", filter: applications, expr: `status == ACCEPTED`, want: "`status` = 2"},
+		{name: "mirrored enum", filter: applications, expr: `REJECTED != status`, want: "`status` <> 3"},
+		{name: "in", filter: applications, expr: `status in [PENDING, ACCEPTED]`, want: "`status` IN (1,2)"},
+		{name: "and", filter: users, expr: `username == "a" && display_name == "b"`, want: "`username` = \"a\" AND `name` = \"b\""},
+		{name: "or", filter: users, expr: `username == "a" || username == "b"`, want: "(`username` = \"a\" OR `username` = \"b\")"},
+		{name: "not", filter: users, expr: `!(username == "a")`, want: "`username` <> \"a\""},
+		{
+			name:   "nested",
+			filter: applications,
+			expr:   `status == PENDING || (status == ACCEPTED && decided_at > timestamp("2025-06-01T12:00:00Z"))`,
+			want:   "(`status` = 1 OR (`status` = 2 AND `decided_at` > \"2025-06-01 12:00:00\"))",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			condition, err := tt.filter.Parse(tt.expr)
+			if err != nil {
+				t.Fatalf("Parse(%q): %v", tt.expr, err)
+			}
+			if got := whereSQL(t, condition); got != tt.want {
+				t.Errorf("Parse(%q) = %s, want %s", tt.expr, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFilterParseInvalid(t *testing.T) {
+	filter := MustNewFilter(&applicationsv1.Application{}, FilterColumns{
+		"user_id":    "user_id",
+		"status":     "status",
+		"decided_at": "decided_at",
+	})
+
+	tests := []struct {
+		name string
+		expr string
+	}{
+		{name: "syntax", expr: `status ==`},
+		{name: "unknown field", expr: `comment == "a"`},
+		{name: "unknown enum value", expr: `status == APPROVED`},
+		{name: "type mismatch", expr: `user_id == 1`},
+		{name: "not a condition", expr: `user_id`},
+		{name: "two fields", expr: `user_id == user_id`},
+		{name: "two values", expr: `"a" == "a"`},
+		{name: "computed value", expr: `user_id == "a" + "b"`},
+		{name: "invalid timestamp", expr: `decided_at > timestamp("yesterday")`},
+		{name: "computed timestamp", expr: `decided_at > timestamp("2025-01-01T00:00:00Z") + duration("1h")`},
+		{name: "unsupported function", expr: `user_id.matches("a.*")`},
+		{name: "macro", expr: `[user_id].exists(u, u == "a")`},
+		{name: "in without a list", expr: `"a" in user_id`},
+		{name: "too long", expr: strings.Repeat(`user_id == "a" || `, 100) + `user_id == "a"`},
+		{name: "too deep", expr: strings.Repeat("(", 40) + `user_id == "a"` + strings.Repeat(")", 40)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			condition, err := filter.Parse(tt.expr)
+			if err == nil {
+				t.Fatalf("Parse(%q) = %v, want an error", tt.expr, condition)
+			}
+
+			s, ok := status.FromError(err)
+			if !ok || s.Code() != codes.InvalidArgument {
+				t.Fatalf("Parse(%q): %v, want an InvalidArgument status", tt.expr, err)
+			}
+			var request *errdetails.BadRequest
+			for _, detail := range s.Details() {
+				if d, ok := detail.(*errdetails.BadRequest); ok {
+					request = d
+				}
+			}
+			if request == nil || len(request.FieldViolations) != 1 || request.FieldViolations[0].Field != FilterField {
+				t.Errorf("Parse(%q): details %v, want a violation of %s", tt.expr, s.Details(), FilterField)
+			}
+		})
+	}
+}
+
+func TestNewFilterUnknownField(t *testing.T) {
+	if _, err := NewFilter(&usersv1.User{}, FilterColumns{"rank": "rank"}); err == nil {
+		t.Error("NewFilter() with an unknown field succeeded, want an error")
+	}
+}
+
+// whereSQL renders the condition as the WHERE clause of a query, with its
+// values inlined.
+func whereSQL(t *testing.T, condition clause.Expression) string {
+	t.Helper()
+	if condition == nil {
+		return ""
+	}
+
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{Logger: logger.Discard})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sql := db.ToSQL(func(tx *gorm.DB) *gorm.DB {
+		return tx.Table("resources").Where(condition).Find(&[]map[string]any{})
+	})
+
+	where, ok := strings.CutPrefix(sql, "SELECT * FROM `resources` WHERE ")
+	if !ok {
+		t.Fatalf("unexpected query %s", sql)
+	}
+	return where
+}
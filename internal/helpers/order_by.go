@@ -0,0 +1,90 @@
+package helpers
+
+import (
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// OrderByField is the request field List RPCs take their order from.
+const OrderByField = "order_by"
+
+// fieldPath matches the field paths of an order_by, such as `display_name` or
+// `unit.display_name`.
+var fieldPath = regexp.MustCompile(`^[a-z][a-z0-9_]*(\.[a-z][a-z0-9_]*)*$`)
+
+// OrderByColumns maps the field paths a resource can be ordered by to the
+// columns they are stored in. Only the fields it contains can be ordered by,
+// so that no column is queried, or revealed, that is not meant to be.
+type OrderByColumns map[string]string
+
+// ParseOrderBy parses an AIP-132 order_by, a comma separated list of field
+// paths each optionally followed by `desc` (or `asc`, the default), such as
+// `display_name desc, created_at`.
+//
+// The returned error is an InvalidArgument status, with the order_by field
+// violation as its details.
+func ParseOrderBy(orderBy string, columns OrderByColumns) ([]Order, error) {
+	if strings.TrimSpace(orderBy) == "" {
+		return nil, nil
+	}
+
+	var order []Order
+	for part := range strings.SplitSeq(orderBy, ",") {
+		fields := strings.Fields(part)
+		if len(fields) == 0 || len(fields) > 2 {
+			return nil, InvalidArgument(OrderByField, fmt.Sprintf("invalid order %q, expected a field optionally followed by asc or desc", strings.TrimSpace(part)))
+		}
+
+		path := fields[0]
+		if !fieldPath.MatchString(path) {
+			return nil, InvalidArgument(OrderByField, fmt.Sprintf("invalid field path %q", path))
+		}
+
+		column, ok := columns[path]
+		if !ok {
+			return nil, InvalidArgument(OrderByField, fmt.Sprintf("cannot order by %q, expected one of %s", path, strings.Join(slices.Sorted(maps.Keys(columns)), ", ")))
+		}
+		if slices.ContainsFunc(order, func(o Order) bool { return o.Column == column }) {
+			return nil, InvalidArgument(OrderByField, fmt.Sprintf("%q is ordered by more than once", path))
+		}
+
+		desc := false
+		if len(fields) == 2 {
+			switch strings.ToLower(fields[1]) {
+			case "asc":
+			case "desc":
+				desc = true
+			default:
+				return nil, InvalidArgument(OrderByField, fmt.Sprintf("invalid direction %q for %q, expected asc or desc", fields[1], path))
+			}
+		}
+
+		order = append(order, Order{Column: column, Desc: desc})
+	}
+
+	return order, nil
+}
+
+// InvalidArgument returns an InvalidArgument status error for the request
+// field, with the violation as its details so clients can tell which field it
+// is about.
+func InvalidArgument(field string, description string) error {
+	st := status.New(codes.InvalidArgument, field+": "+description)
+	detailed, err := st.WithDetails(&errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{
+			{Field: field, Description: description},
+		},
+	})
+	if err != nil {
+		return st.Err()
+	}
+
+	return detailed.Err()
+}
@@ -1,12 +1,22 @@
 package helpers
 
 import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
 	"time"
 
-	"github.com/milsim-tools/pincer/internal/models"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
 )
 
 const (
@@ -14,17 +24,17 @@ const (
 	MaxPageSize     = 100
 )
 
-type PaginationCursor struct {
-	CreatedAt time.Time
-}
+// The keyset every page is ordered by last, so that rows with equal values in
+// the requested order still have a stable position.
+const (
+	createdAtColumn = "created_at"
+	idColumn        = "id"
+)
 
-func (pc PaginationCursor) String() (string, error) {
-	bytes, err := json.Marshal(pc)
-	if err != nil {
-		return "", err
-	}
-	return base64.URLEncoding.EncodeToString(bytes), nil
-}
+// ErrInvalidPageToken is returned for page tokens that were not issued by a
+// Paginator with the same secret, or were issued for a request for another
+// resource, with other filters or another order.
+var ErrInvalidPageToken = errors.New("invalid page_token")
 
 // GetPageLimit ensures the page size is within acceptable bounds.
 func GetPageLimit(pageSize int) int {
@@ -37,50 +47,319 @@ func GetPageLimit(pageSize int) int {
 	return limit
 }
 
-type Model interface {
-	models.Model
+// Order is a column a page is sorted by.
+type Order struct {
+	Column string
+	Desc   bool
+}
+
+// Page is the pagination of a List request.
+type Page struct {
+	// Size is the requested page size, bounded by GetPageLimit.
+	Size int
+
+	// Token is the page token of the request, empty for the first page.
+	Token string
+
+	// Order is the requested sort order. It is followed by created_at and id
+	// unless it already contains them, newest first by default.
+	Order []Order
+
+	// Filters are the filters of the request. A page token is only accepted
+	// for a request with the same filters as the one it was issued for.
+	Filters []any
+}
+
+// Paginator issues and verifies the page tokens of List RPCs. Tokens are
+// signed, so that clients cannot craft them to read past the rows a query
+// would otherwise return.
+type Paginator struct {
+	key []byte
+}
+
+// NewPaginator returns a Paginator signing tokens with the secret. Without a
+// secret a random one is used, in which case tokens are only valid for the
+// lifetime of the process.
+func NewPaginator(secret string) (*Paginator, error) {
+	key := []byte(secret)
+	if len(key) == 0 {
+		key = make([]byte, 32)
+		if _, err := rand.Read(key); err != nil {
+			return nil, err
+		}
+	}
+
+	return &Paginator{key: key}, nil
+}
+
+// token is the content of a page token.
+type token struct {
+	// Keys are the values of the last row of the previous page in each
+	// ordered column.
+	Keys []keyValue `json:"k"`
+
+	// Filters is a hash of the resource, filters and order of the request
+	// the token was issued for.
+	Filters string `json:"f"`
+}
+
+// keyValue is a value of a keyset, which keeps its type through encoding so
+// that it compares the same way as the column it was read from.
+type keyValue struct {
+	Kind  string          `json:"t"`
+	Value json.RawMessage `json:"v"`
+}
+
+// Paginate returns a page of the query in the requested order, and the token
+// of the next page, which is empty if this is the last page.
+func Paginate[T any](ctx context.Context, p *Paginator, qb gorm.ChainInterface[T], page Page) ([]T, string, error) {
+	order := keysetOrder(page.Order)
+
+	filters, err := filtersHash(reflect.TypeFor[T]().String(), order, page.Filters)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if page.Token != "" {
+		keys, err := p.decode(page.Token, filters)
+		if err != nil {
+			return nil, "", err
+		}
+		if len(keys) != len(order) {
+			return nil, "", ErrInvalidPageToken
+		}
+		qb = qb.Where(after(order, keys))
+	}
+
+	for _, o := range order {
+		qb = qb.Order(clause.OrderByColumn{Column: clause.Column{Name: o.Column}, Desc: o.Desc})
+	}
+
+	limit := GetPageLimit(page.Size)
+	rows, err := qb.Limit(limit + 1).Find(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(rows) <= limit {
+		return rows, "", nil
+	}
+	rows = rows[:limit]
+
+	keys, err := keysOf(ctx, &rows[len(rows)-1], order)
+	if err != nil {
+		return nil, "", err
+	}
+
+	next, err := p.encode(token{Keys: keys, Filters: filters})
+	if err != nil {
+		return nil, "", err
+	}
+
+	return rows, next, nil
+}
+
+// keysetOrder completes the requested order with the created_at and id
+// columns, which together identify a row.
+func keysetOrder(requested []Order) []Order {
+	order := make([]Order, 0, len(requested)+2)
+	createdAt := Order{Column: createdAtColumn, Desc: true}
+	hasCreatedAt, hasID := false, false
+	for _, o := range requested {
+		switch o.Column {
+		case createdAtColumn:
+			createdAt, hasCreatedAt = o, true
+		case idColumn:
+			hasID = true
+		}
+		order = append(order, o)
+	}
+
+	if !hasCreatedAt {
+		order = append(order, createdAt)
+	}
+	if !hasID {
+		order = append(order, Order{Column: idColumn, Desc: createdAt.Desc})
+	}
+
+	return order
 }
 
-func ApplyPageLimit[T any](iface gorm.ChainInterface[T], pageSize int) gorm.ChainInterface[T] {
-	limit := GetPageLimit(pageSize)
-	qb := iface.Limit(limit)
-	return qb
+// after matches the rows that come after the row with the given keys in the
+// order, that is rows greater in the first column, or equal in it and greater
+// in the second, and so on.
+func after(order []Order, keys []any) clause.Expression {
+	alternatives := make([]clause.Expression, 0, len(order))
+	for i, o := range order {
+		conditions := make([]clause.Expression, 0, i+1)
+		for j := range i {
+			conditions = append(conditions, clause.Eq{Column: clause.Column{Name: order[j].Column}, Value: keys[j]})
+		}
+
+		column := clause.Column{Name: o.Column}
+		if o.Desc {
+			conditions = append(conditions, clause.Lt{Column: column, Value: keys[i]})
+		} else {
+			conditions = append(conditions, clause.Gt{Column: column, Value: keys[i]})
+		}
+
+		alternatives = append(alternatives, clause.And(conditions...))
+	}
+
+	return clause.Or(alternatives...)
 }
 
-func ApplyCursor[T any](iface gorm.ChainInterface[T], pc *PaginationCursor) gorm.ChainInterface[T] {
-	qb := iface.Where("created_at < ?", pc.CreatedAt)
-	return qb
+var schemas sync.Map
+
+// keysOf reads the values of the ordered columns from the row.
+func keysOf[T any](ctx context.Context, row *T, order []Order) ([]keyValue, error) {
+	s, err := schema.Parse(row, &schemas, schema.NamingStrategy{})
+	if err != nil {
+		return nil, err
+	}
+
+	value := reflect.ValueOf(row).Elem()
+	keys := make([]keyValue, 0, len(order))
+	for _, o := range order {
+		field := s.LookUpField(o.Column)
+		if field == nil {
+			return nil, fmt.Errorf("%s has no column %s to paginate by", s.Name, o.Column)
+		}
+
+		v, _ := field.ValueOf(ctx, value)
+		key, err := encodeKey(v)
+		if err != nil {
+			return nil, fmt.Errorf("cannot paginate by %s: %w", o.Column, err)
+		}
+		keys = append(keys, key)
+	}
+
+	return keys, nil
 }
 
-func GenerateCursor(items []models.Model) *PaginationCursor {
-	if len(items) == 0 {
-		return nil
+func encodeKey(v any) (keyValue, error) {
+	var kind string
+	switch x := v.(type) {
+	case time.Time:
+		kind, v = "time", x.Format(time.RFC3339Nano)
+	case string:
+		kind = "string"
+	case bool:
+		kind = "bool"
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		kind = "int"
+	case float32, float64:
+		kind = "float"
+	default:
+		return keyValue{}, fmt.Errorf("unsupported type %T", v)
 	}
-	pc := &PaginationCursor{
-		CreatedAt: items[len(items)-1].CreatedAt,
+
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return keyValue{}, err
 	}
-	return pc
+
+	return keyValue{Kind: kind, Value: raw}, nil
 }
 
-func GenerateCursorString(items []models.Model) string {
-	pc := GenerateCursor(items)
-	if val, err := pc.String(); err != nil {
-		return ""
-	} else {
-		return val
+func decodeKey(key keyValue) (any, error) {
+	var err error
+	switch key.Kind {
+	case "time":
+		var s string
+		if err = json.Unmarshal(key.Value, &s); err == nil {
+			return time.Parse(time.RFC3339Nano, s)
+		}
+	case "string":
+		var s string
+		if err = json.Unmarshal(key.Value, &s); err == nil {
+			return s, nil
+		}
+	case "bool":
+		var b bool
+		if err = json.Unmarshal(key.Value, &b); err == nil {
+			return b, nil
+		}
+	case "int":
+		var i int64
+		if err = json.Unmarshal(key.Value, &i); err == nil {
+			return i, nil
+		}
+	case "float":
+		var f float64
+		if err = json.Unmarshal(key.Value, &f); err == nil {
+			return f, nil
+		}
+	default:
+		err = fmt.Errorf("unknown key type %q", key.Kind)
 	}
+
+	return nil, err
 }
 
-func CursorFromString(s string) (*PaginationCursor, error) {
-	if s == "" {
-		return nil, nil
+// filtersHash hashes the resource, order and filters of a request.
+func filtersHash(resource string, order []Order, filters []any) (string, error) {
+	raw, err := json.Marshal(struct {
+		Resource string
+		Order    []Order
+		Filters  []any
+	}{resource, order, filters})
+	if err != nil {
+		return "", err
 	}
 
-	var pc PaginationCursor
-	bytes, err := base64.URLEncoding.DecodeString(s)
+	sum := sha256.Sum256(raw)
+	return base64.RawURLEncoding.EncodeToString(sum[:16]), nil
+}
+
+// encode serializes and signs the token.
+func (p *Paginator) encode(t token) (string, error) {
+	payload, err := json.Marshal(t)
 	if err != nil {
-		return &pc, err
+		return "", err
 	}
-	err = json.Unmarshal(bytes, &pc)
-	return &pc, err
+
+	return base64.RawURLEncoding.EncodeToString(payload) + "." + base64.RawURLEncoding.EncodeToString(p.sign(payload)), nil
+}
+
+// decode verifies the token and returns its keys, if it was issued for a
+// request with the given filters.
+func (p *Paginator) decode(s string, filters string) ([]any, error) {
+	encodedPayload, encodedSignature, ok := strings.Cut(s, ".")
+	if !ok {
+		return nil, ErrInvalidPageToken
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encodedPayload)
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(encodedSignature)
+	if err != nil || !hmac.Equal(signature, p.sign(payload)) {
+		return nil, ErrInvalidPageToken
+	}
+
+	var t token
+	if err := json.Unmarshal(payload, &t); err != nil {
+		return nil, ErrInvalidPageToken
+	}
+	if t.Filters != filters {
+		return nil, fmt.Errorf("%w: the token was issued for another request", ErrInvalidPageToken)
+	}
+
+	keys := make([]any, 0, len(t.Keys))
+	for _, key := range t.Keys {
+		v, err := decodeKey(key)
+		if err != nil {
+			return nil, ErrInvalidPageToken
+		}
+		keys = append(keys, v)
+	}
+
+	return keys, nil
+}
+
+func (p *Paginator) sign(payload []byte) []byte {
+	mac := hmac.New(sha256.New, p.key)
+	mac.Write(payload)
+	return mac.Sum(nil)
 }
@@ -0,0 +1,289 @@
+package helpers
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/glebarez/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func TestGetPageLimit(t *testing.T) {
+	tests := []struct {
+		size int
+		want int
+	}{
+		{size: -1, want: DefaultPageSize},
+		{size: 0, want: DefaultPageSize},
+		{size: 1, want: 1},
+		{size: MaxPageSize, want: MaxPageSize},
+		{size: MaxPageSize + 1, want: MaxPageSize},
+	}
+	for _, tt := range tests {
+		if got := GetPageLimit(tt.size); got != tt.want {
+			t.Errorf("GetPageLimit(%d) = %d, want %d", tt.size, got, tt.want)
+		}
+	}
+}
+
+func TestKeysetOrder(t *testing.T) {
+	tests := []struct {
+		name      string
+		requested []Order
+		want      []Order
+	}{
+		{
+			name: "default",
+			want: []Order{{Column: "created_at", Desc: true}, {Column: "id", Desc: true}},
+		},
+		{
+			name:      "other column",
+			requested: []Order{{Column: "name"}},
+			want:      []Order{{Column: "name"}, {Column: "created_at", Desc: true}, {Column: "id", Desc: true}},
+		},
+		{
+			name:      "created_at ascending",
+			requested: []Order{{Column: "created_at"}},
+			want:      []Order{{Column: "created_at"}, {Column: "id"}},
+		},
+		{
+			name:      "id requested",
+			requested: []Order{{Column: "id", Desc: true}, {Column: "created_at"}},
+			want:      []Order{{Column: "id", Desc: true}, {Column: "created_at"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := keysetOrder(tt.requested); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("keysetOrder(%v) = %v, want %v", tt.requested, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEncodeKey(t *testing.T) {
+	at := time.Date(2025, 3, 14, 15, 9, 26, 535897932, time.UTC)
+	tests := []struct {
+		value any
+		want  any
+	}{
+		{value: at, want: at},
+		{value: "a.b", want: "a.b"},
+		{value: true, want: true},
+		{value: int32(-7), want: int64(-7)},
+		{value: uint8(7), want: int64(7)},
+		{value: 1.5, want: 1.5},
+	}
+	for _, tt := range tests {
+		key, err := encodeKey(tt.value)
+		if err != nil {
+			t.Errorf("encodeKey(%v): %v", tt.value, err)
+			continue
+		}
+		got, err := decodeKey(key)
+		if err != nil {
+			t.Errorf("decodeKey(%v): %v", key, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("decodeKey(encodeKey(%v)) = %#v, want %#v", tt.value, got, tt.want)
+		}
+	}
+
+	if _, err := encodeKey([]byte("id")); err == nil {
+		t.Error("encodeKey([]byte) succeeded, want an error")
+	}
+	if _, err := decodeKey(keyValue{Kind: "bytes", Value: []byte(`"aWQ="`)}); err == nil {
+		t.Error("decodeKey of an unknown kind succeeded, want an error")
+	}
+}
+
+func TestPaginatorDecode(t *testing.T) {
+	p, err := NewPaginator("secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := NewPaginator("another secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	order := keysetOrder(nil)
+	filters, err := filtersHash("users", order, []any{"display_name.contains(\"a\")"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	at := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	createdAt, _ := encodeKey(at)
+	id, _ := encodeKey("0001")
+	valid, err := p.encode(token{Keys: []keyValue{createdAt, id}, Filters: filters})
+	if err != nil {
+		t.Fatal(err)
+	}
+	payload, signature, _ := strings.Cut(valid, ".")
+
+	// A token with other keys, signed by the same paginator, so that its
+	// signature can be moved onto the payload of the valid token.
+	forgedID, _ := encodeKey("9999")
+	forged, err := p.encode(token{Keys: []keyValue{createdAt, forgedID}, Filters: filters})
+	if err != nil {
+		t.Fatal(err)
+	}
+	forgedPayload, forgedSignature, _ := strings.Cut(forged, ".")
+
+	otherFilters := func(resource string, order []Order, filters []any) string {
+		t.Helper()
+		hash, err := filtersHash(resource, order, filters)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return hash
+	}
+
+	tests := []struct {
+		name    string
+		token   string
+		filters string
+		wantErr bool
+	}{
+		{name: "valid", token: valid, filters: filters},
+		{name: "no signature", token: payload, filters: filters, wantErr: true},
+		{name: "empty signature", token: payload + ".", filters: filters, wantErr: true},
+		{name: "invalid base64", token: payload + ".!!", filters: filters, wantErr: true},
+		{name: "not json", token: base64.RawURLEncoding.EncodeToString([]byte("keys")) + "." + signature, filters: filters, wantErr: true},
+		{name: "payload swapped", token: forgedPayload + "." + signature, filters: filters, wantErr: true},
+		{name: "signature swapped", token: payload + "." + forgedSignature, filters: filters, wantErr: true},
+		{name: "payload tampered", token: tamper(payload) + "." + signature, filters: filters, wantErr: true},
+		{name: "signature tampered", token: payload + "." + tamper(signature), filters: filters, wantErr: true},
+		{name: "another secret", token: mustEncode(t, other, token{Keys: []keyValue{createdAt, id}, Filters: filters}), filters: filters, wantErr: true},
+		{name: "another resource", token: valid, filters: otherFilters("units", order, []any{"display_name.contains(\"a\")"}), wantErr: true},
+		{name: "other filters", token: valid, filters: otherFilters("users", order, []any{"display_name.contains(\"b\")"}), wantErr: true},
+		{name: "no filters", token: valid, filters: otherFilters("users", order, nil), wantErr: true},
+		{name: "another order", token: valid, filters: otherFilters("users", keysetOrder([]Order{{Column: "created_at"}}), []any{"display_name.contains(\"a\")"}), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keys, err := p.decode(tt.token, tt.filters)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidPageToken) {
+					t.Fatalf("decode() = %v, %v, want %v", keys, err, ErrInvalidPageToken)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("decode(): %v", err)
+			}
+			if want := []any{at, "0001"}; !reflect.DeepEqual(keys, want) {
+				t.Errorf("decode() = %v, want %v", keys, want)
+			}
+		})
+	}
+}
+
+// tamper changes the first character of an encoded part of a token.
+func tamper(s string) string {
+	if s[0] == 'A' {
+		return "B" + s[1:]
+	}
+	return "A" + s[1:]
+}
+
+func mustEncode(t *testing.T, p *Paginator, tok token) string {
+	t.Helper()
+	s, err := p.encode(tok)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+type paginatedRow struct {
+	ID        string
+	Name      string
+	CreatedAt time.Time
+}
+
+func TestPaginate(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{Logger: logger.Discard})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.AutoMigrate(&paginatedRow{}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Rows share names and creation times, so that pages can only be walked
+	// without skipping or repeating rows if ties are broken by id.
+	at := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	var rows []paginatedRow
+	for i := range 23 {
+		rows = append(rows, paginatedRow{
+			ID:        fmt.Sprintf("%04d", i),
+			Name:      fmt.Sprintf("name-%d", i%3),
+			CreatedAt: at.Add(time.Duration(i/4) * time.Hour),
+		})
+	}
+	if err := db.Create(&rows).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	p, err := NewPaginator("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	orders := [][]Order{
+		nil,
+		{{Column: "created_at"}},
+		{{Column: "name"}},
+		{{Column: "name", Desc: true}, {Column: "created_at"}},
+	}
+	for _, order := range orders {
+		t.Run(fmt.Sprint(order), func(t *testing.T) {
+			seen := map[string]bool{}
+			pageToken := ""
+			for pages := 0; ; pages++ {
+				if pages > len(rows) {
+					t.Fatal("pagination does not end")
+				}
+				page, next, err := Paginate(ctx, p, gorm.G[paginatedRow](db).Select("*"), Page{Size: 5, Token: pageToken, Order: order})
+				if err != nil {
+					t.Fatal(err)
+				}
+				if len(page) > 5 {
+					t.Fatalf("got %d rows, want at most 5", len(page))
+				}
+				for _, row := range page {
+					if seen[row.ID] {
+						t.Fatalf("row %s returned twice", row.ID)
+					}
+					seen[row.ID] = true
+				}
+				if next == "" {
+					break
+				}
+				pageToken = next
+			}
+			if len(seen) != len(rows) {
+				t.Errorf("got %d rows, want %d", len(seen), len(rows))
+			}
+		})
+	}
+
+	_, next, err := Paginate(ctx, p, gorm.G[paginatedRow](db).Select("*"), Page{Size: 5, Filters: []any{"a"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := Paginate(ctx, p, gorm.G[paginatedRow](db).Select("*"), Page{Size: 5, Token: next, Filters: []any{"b"}}); !errors.Is(err, ErrInvalidPageToken) {
+		t.Errorf("Paginate() with the token of other filters = %v, want %v", err, ErrInvalidPageToken)
+	}
+}
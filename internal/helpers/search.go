@@ -0,0 +1,197 @@
+package helpers
+
+import (
+	"html"
+	"strings"
+	"unicode"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// RankColumn is the column a Search ranks matching rows in.
+	RankColumn = "rank"
+
+	// maxSearchTerms bounds the number of words of a search that are used.
+	maxSearchTerms = 8
+
+	// similarityThreshold is the trigram word similarity above which a fuzzy
+	// column matches a search despite typos.
+	similarityThreshold = 0.4
+
+	// maxExcerptLength bounds the length of highlights of long columns.
+	maxExcerptLength = 160
+)
+
+// SearchColumn is a column matched by a Search.
+type SearchColumn struct {
+	Name string
+
+	// Weight scales the rank of matches in the column.
+	Weight float64
+
+	// Fuzzy columns also match words within a few typos of the search, on
+	// Postgres.
+	Fuzzy bool
+}
+
+// Search matches the rows of a table against free text, as the words of the
+// text each prefixing a word of one of the columns.
+//
+// On Postgres the columns are indexed by a tsvector column, generated by the
+// module's migrations with the same columns, and fuzzy columns are compared
+// by trigram similarity with pg_trgm. On SQLite prefixes are matched with
+// LIKE, without typo tolerance.
+type Search struct {
+	// Vector is the tsvector column indexing the columns on Postgres.
+	Vector string
+
+	Columns []SearchColumn
+}
+
+// SearchTerms returns the lowercased words of the text that are searched
+// for.
+func SearchTerms(text string) []string {
+	terms := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	if len(terms) > maxSearchTerms {
+		terms = terms[:maxSearchTerms]
+	}
+	return terms
+}
+
+// Query returns the rows of the table matching the terms, with their rank in
+// the RankColumn, for use as a subquery such as
+// `Table("(?) AS results", query)`.
+func (s Search) Query(db *gorm.DB, table string, terms []string) *gorm.DB {
+	if db.Dialector.Name() == "postgres" {
+		return s.postgres(db, table, terms)
+	}
+	return s.like(db, table, terms)
+}
+
+func (s Search) postgres(db *gorm.DB, table string, terms []string) *gorm.DB {
+	prefixes := make([]string, 0, len(terms))
+	for _, term := range terms {
+		prefixes = append(prefixes, term+":*")
+	}
+	query := clause.Expr{SQL: "to_tsquery('simple', ?)", Vars: []any{strings.Join(prefixes, " & ")}}
+	text := strings.Join(terms, " ")
+	vector := clause.Column{Name: s.Vector}
+
+	rank := []string{"ts_rank(?, ?)"}
+	rankVars := []any{vector, query}
+	match := []string{"? @@ ?"}
+	matchVars := []any{vector, query}
+	for _, column := range s.Columns {
+		if !column.Fuzzy {
+			continue
+		}
+		rank = append(rank, "? * public.word_similarity(?, ?)")
+		rankVars = append(rankVars, column.Weight, text, clause.Column{Name: column.Name})
+		match = append(match, "public.word_similarity(?, ?) >= ?")
+		matchVars = append(matchVars, text, clause.Column{Name: column.Name}, similarityThreshold)
+	}
+
+	return db.Table(table).
+		Select("*, ("+strings.Join(rank, " + ")+")::float8 AS "+RankColumn, rankVars...).
+		Where(strings.Join(match, " OR "), matchVars...)
+}
+
+func (s Search) like(db *gorm.DB, table string, terms []string) *gorm.DB {
+	var rank []string
+	var rankVars []any
+	query := db.Table(table)
+	for _, term := range terms {
+		prefix := likeEscaper.Replace(term) + "%"
+		word := "% " + prefix
+
+		var match []string
+		var matchVars []any
+		for _, column := range s.Columns {
+			col := clause.Column{Name: column.Name}
+			match = append(match, `lower(?) LIKE ? ESCAPE '\' OR lower(?) LIKE ? ESCAPE '\'`)
+			matchVars = append(matchVars, col, prefix, col, word)
+			rank = append(rank, `CASE WHEN lower(?) LIKE ? ESCAPE '\' OR lower(?) LIKE ? ESCAPE '\' THEN ? ELSE 0 END`)
+			rankVars = append(rankVars, col, prefix, col, word, column.Weight)
+		}
+		query = query.Where("("+strings.Join(match, " OR ")+")", matchVars...)
+	}
+
+	return query.Select("*, (0.0 + "+strings.Join(rank, " + ")+") AS "+RankColumn, rankVars...)
+}
+
+// Highlight returns the text, HTML-escaped, with the words the terms prefix
+// wrapped in <mark> tags, and whether any word matched. Text longer than an
+// excerpt is cut down to the part around the first match.
+func Highlight(text string, terms []string) (string, bool) {
+	type span struct{ start, end int }
+
+	var matches []span
+	start := -1
+	for i, r := range text + " " {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if start < 0 {
+				start = i
+			}
+			continue
+		}
+		if start < 0 {
+			continue
+		}
+
+		word := strings.ToLower(text[start:i])
+		for _, term := range terms {
+			if strings.HasPrefix(word, term) {
+				matches = append(matches, span{start, i})
+				break
+			}
+		}
+		start = -1
+	}
+	if len(matches) == 0 {
+		return "", false
+	}
+
+	from, to := 0, len(text)
+	if len(text) > maxExcerptLength {
+		from = max(0, matches[0].start-maxExcerptLength/4)
+		for from > 0 && !isSpace(text[from-1]) {
+			from--
+		}
+		to = min(len(text), from+maxExcerptLength)
+		for to < len(text) && !isSpace(text[to]) {
+			to++
+		}
+	}
+
+	var b strings.Builder
+	if from > 0 {
+		b.WriteString("…")
+	}
+	last := from
+	for _, m := range matches {
+		if m.start < from || m.end > to {
+			continue
+		}
+		b.WriteString(html.EscapeString(text[last:m.start]))
+		b.WriteString("<mark>")
+		b.WriteString(html.EscapeString(text[m.start:m.end]))
+		b.WriteString("</mark>")
+		last = m.end
+	}
+	b.WriteString(html.EscapeString(text[last:to]))
+	if to < len(text) {
+		b.WriteString("…")
+	}
+
+	return b.String(), true
+}
+
+// isSpace reports whether the byte is ASCII whitespace, which is never part
+// of a multi-byte character, so excerpts are cut between characters.
+func isSpace(b byte) bool {
+	return b == ' ' || b == '\t' || b == '\n' || b == '\r'
+}
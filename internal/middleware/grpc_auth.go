@@ -0,0 +1,37 @@
+package middleware
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const authorizationKey = "authorization"
+
+// ForwardAuthorizationUnaryClientInterceptor forwards the caller's credentials
+// from the incoming request to outgoing calls to other modules, so that they
+// are made on behalf of the same caller.
+func ForwardAuthorizationUnaryClientInterceptor(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	return invoker(forwardAuthorization(ctx), method, req, reply, cc, opts...)
+}
+
+// ForwardAuthorizationStreamClientInterceptor is the streaming counterpart of
+// ForwardAuthorizationUnaryClientInterceptor.
+func ForwardAuthorizationStreamClientInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return streamer(forwardAuthorization(ctx), desc, cc, method, opts...)
+}
+
+func forwardAuthorization(ctx context.Context) context.Context {
+	// Credentials explicitly set on the outgoing context take precedence.
+	if out, ok := metadata.FromOutgoingContext(ctx); ok && len(out.Get(authorizationKey)) > 0 {
+		return ctx
+	}
+
+	values := metadata.ValueFromIncomingContext(ctx, authorizationKey)
+	if len(values) == 0 {
+		return ctx
+	}
+
+	return metadata.AppendToOutgoingContext(ctx, authorizationKey, values[0])
+}
@@ -0,0 +1,58 @@
+package middleware
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// GRPCServerInstrument records the number, latency and concurrency of gRPC
+// requests.
+type GRPCServerInstrument struct {
+	Duration *prometheus.HistogramVec
+	InFlight *prometheus.GaugeVec
+}
+
+// NewGRPCServerInstrument creates the gRPC server metrics and registers them
+// with reg.
+func NewGRPCServerInstrument(reg prometheus.Registerer) GRPCServerInstrument {
+	return GRPCServerInstrument{
+		Duration: promauto.With(reg).NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "pincer_grpc_request_duration_seconds",
+			Help:    "Time (in seconds) spent serving gRPC requests.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"method", "status_code"}),
+		InFlight: promauto.With(reg).NewGaugeVec(prometheus.GaugeOpts{
+			Name: "pincer_grpc_requests_in_flight",
+			Help: "Current number of gRPC requests being served.",
+		}, []string{"method"}),
+	}
+}
+
+// UnaryServerInterceptor is an interceptor that instruments gRPC requests.
+func (i GRPCServerInstrument) UnaryServerInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	inFlight := i.InFlight.WithLabelValues(info.FullMethod)
+	inFlight.Inc()
+	defer inFlight.Dec()
+
+	begin := time.Now()
+	resp, err := handler(ctx, req)
+	i.Duration.WithLabelValues(info.FullMethod, status.Code(err).String()).Observe(time.Since(begin).Seconds())
+	return resp, err
+}
+
+// StreamServerInterceptor is an interceptor that instruments gRPC streams.
+func (i GRPCServerInstrument) StreamServerInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	inFlight := i.InFlight.WithLabelValues(info.FullMethod)
+	inFlight.Inc()
+	defer inFlight.Dec()
+
+	begin := time.Now()
+	err := handler(srv, ss)
+	i.Duration.WithLabelValues(info.FullMethod, status.Code(err).String()).Observe(time.Since(begin).Seconds())
+	return err
+}
@@ -67,12 +67,12 @@ func (s GRPCServerLog) UnaryServerInterceptor(ctx context.Context, req interface
 			entry = entry.With("request", req)
 		}
 		if grpcUtils.IsCanceled(err) {
-			entry.Debug(gRPC, "err", err)
+			entry.DebugContext(ctx, gRPC, "err", err)
 		} else {
-			entry.Warn(gRPC, "err", err)
+			entry.WarnContext(ctx, gRPC, "err", err)
 		}
 	} else {
-		entry.Debug(fmt.Sprintf("%s (success)", gRPC))
+		entry.DebugContext(ctx, fmt.Sprintf("%s (success)", gRPC))
 	}
 	return resp, err
 }
@@ -85,15 +85,17 @@ func (s GRPCServerLog) StreamServerInterceptor(srv any, ss grpc.ServerStream, in
 		return nil
 	}
 
+	ctx := ss.Context()
+
 	entry := s.Log.With("method", info.FullMethod, "duration", time.Since(begin))
 	if err != nil {
 		if grpcUtils.IsCanceled(err) {
-			entry.Debug(gRPC, "err", err)
+			entry.DebugContext(ctx, gRPC, "err", err)
 		} else {
-			entry.Warn(gRPC, "err", err)
+			entry.WarnContext(ctx, gRPC, "err", err)
 		}
 	} else {
-		entry.Debug(fmt.Sprintf("%s (success)", gRPC))
+		entry.DebugContext(ctx, fmt.Sprintf("%s (success)", gRPC))
 	}
 	return err
 }
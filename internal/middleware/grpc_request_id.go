@@ -0,0 +1,104 @@
+package middleware
+
+import (
+	"context"
+	"log/slog"
+
+	grpcmiddleware "github.com/grpc-ecosystem/go-grpc-middleware/v2"
+	"github.com/oklog/ulid/v2"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// RequestIDKey is the metadata key, and HTTP header, carrying the ID of a
+// request.
+const RequestIDKey = "x-request-id"
+
+// maxRequestIDLength bounds the IDs accepted from callers, which are written
+// to every log line of the request.
+const maxRequestIDLength = 128
+
+type requestIDContextKey struct{}
+
+// RequestIDFromContext returns the ID of the request being served, if any.
+func RequestIDFromContext(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(requestIDContextKey{}).(string)
+	return id, ok
+}
+
+// RequestIDUnaryServerInterceptor assigns each request an ID, taken from the
+// caller if it sent one, and returns it in the response headers.
+func RequestIDUnaryServerInterceptor(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	return handler(withRequestID(ctx), req)
+}
+
+// RequestIDStreamServerInterceptor is the streaming counterpart of
+// RequestIDUnaryServerInterceptor.
+func RequestIDStreamServerInterceptor(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	wrapped := grpcmiddleware.WrapServerStream(ss)
+	wrapped.WrappedContext = withRequestID(ss.Context())
+	return handler(srv, wrapped)
+}
+
+func withRequestID(ctx context.Context) context.Context {
+	id := ulid.Make().String()
+	if values := metadata.ValueFromIncomingContext(ctx, RequestIDKey); len(values) > 0 && values[0] != "" && len(values[0]) <= maxRequestIDLength {
+		id = values[0]
+	}
+
+	_ = grpc.SetHeader(ctx, metadata.Pairs(RequestIDKey, id))
+
+	return context.WithValue(ctx, requestIDContextKey{}, id)
+}
+
+// ForwardRequestIDUnaryClientInterceptor passes the ID of the request being
+// served on to outgoing calls to other modules, so that their logs share it.
+func ForwardRequestIDUnaryClientInterceptor(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	return invoker(forwardRequestID(ctx), method, req, reply, cc, opts...)
+}
+
+// ForwardRequestIDStreamClientInterceptor is the streaming counterpart of
+// ForwardRequestIDUnaryClientInterceptor.
+func ForwardRequestIDStreamClientInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return streamer(forwardRequestID(ctx), desc, cc, method, opts...)
+}
+
+func forwardRequestID(ctx context.Context) context.Context {
+	if out, ok := metadata.FromOutgoingContext(ctx); ok && len(out.Get(RequestIDKey)) > 0 {
+		return ctx
+	}
+
+	id, ok := RequestIDFromContext(ctx)
+	if !ok {
+		return ctx
+	}
+
+	return metadata.AppendToOutgoingContext(ctx, RequestIDKey, id)
+}
+
+// RequestIDLogHandler decorates records logged with the context of a request
+// with the request's ID.
+type RequestIDLogHandler struct {
+	slog.Handler
+}
+
+// NewRequestIDLogHandler wraps handler in a RequestIDLogHandler.
+func NewRequestIDLogHandler(handler slog.Handler) *RequestIDLogHandler {
+	return &RequestIDLogHandler{Handler: handler}
+}
+
+func (h *RequestIDLogHandler) Handle(ctx context.Context, record slog.Record) error {
+	if id, ok := RequestIDFromContext(ctx); ok {
+		record.AddAttrs(slog.String("request_id", id))
+	}
+
+	return h.Handler.Handle(ctx, record)
+}
+
+func (h *RequestIDLogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &RequestIDLogHandler{Handler: h.Handler.WithAttrs(attrs)}
+}
+
+func (h *RequestIDLogHandler) WithGroup(name string) slog.Handler {
+	return &RequestIDLogHandler{Handler: h.Handler.WithGroup(name)}
+}
@@ -0,0 +1,93 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// HTTPInstrument records the number, latency and concurrency of HTTP requests.
+type HTTPInstrument struct {
+	Duration *prometheus.HistogramVec
+	InFlight prometheus.Gauge
+}
+
+// NewHTTPInstrument creates the HTTP server metrics and registers them with
+// reg.
+func NewHTTPInstrument(reg prometheus.Registerer) HTTPInstrument {
+	return HTTPInstrument{
+		Duration: promauto.With(reg).NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "pincer_http_request_duration_seconds",
+			Help:    "Time (in seconds) spent serving HTTP requests.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"method", "route", "status_code"}),
+		InFlight: promauto.With(reg).NewGauge(prometheus.GaugeOpts{
+			Name: "pincer_http_requests_in_flight",
+			Help: "Current number of HTTP requests being served.",
+		}),
+	}
+}
+
+type routeKey struct{}
+
+// Wrap instruments requests served by the gorilla router. Requests are
+// labelled with the template of the route they matched.
+func (i HTTPInstrument) Wrap(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		i.InFlight.Inc()
+		defer i.InFlight.Dec()
+
+		route := "other"
+		if current := mux.CurrentRoute(r); current != nil {
+			if tmpl, err := current.GetPathTemplate(); err == nil {
+				route = tmpl
+			}
+		}
+
+		rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		begin := time.Now()
+		next.ServeHTTP(rec, r.WithContext(context.WithValue(r.Context(), routeKey{}, &route)))
+		i.Duration.WithLabelValues(r.Method, route, strconv.Itoa(rec.status)).Observe(time.Since(begin).Seconds())
+	})
+}
+
+// GatewayMiddleware refines the route label of requests served by the REST
+// gateway to the HTTP binding they matched, rather than the gateway's prefix.
+func (i HTTPInstrument) GatewayMiddleware(next runtime.HandlerFunc) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		if route, ok := r.Context().Value(routeKey{}).(*string); ok {
+			if pattern, ok := runtime.HTTPPathPattern(r.Context()); ok {
+				*route = pattern
+			}
+		}
+
+		next(w, r, pathParams)
+	}
+}
+
+// statusRecorder captures the status code written to a response.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *statusRecorder) Flush() {
+	if f, ok := r.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func (r *statusRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
@@ -0,0 +1,281 @@
+package tlsutil
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+// file tracks the modification time and size of a file, so that changes to it
+// (e.g. a certificate being rotated) can be detected.
+type file struct {
+	path    string
+	modTime time.Time
+	size    int64
+}
+
+// changed reports whether the file changed since it was last seen.
+func (f *file) changed() (bool, error) {
+	info, err := os.Stat(f.path)
+	if err != nil {
+		return false, err
+	}
+
+	if info.ModTime().Equal(f.modTime) && info.Size() == f.size {
+		return false, nil
+	}
+
+	f.modTime = info.ModTime()
+	f.size = info.Size()
+	return true, nil
+}
+
+// KeyPair serves a certificate and private key from disk, reloading them
+// whenever either file changes.
+type KeyPair struct {
+	mu   sync.Mutex
+	cert *tls.Certificate
+
+	certFile file
+	keyFile  file
+}
+
+// NewKeyPair loads the certificate and key, failing if they are invalid.
+func NewKeyPair(certFile, keyFile string) (*KeyPair, error) {
+	kp := &KeyPair{
+		certFile: file{path: certFile},
+		keyFile:  file{path: keyFile},
+	}
+
+	if _, err := kp.Certificate(); err != nil {
+		return nil, err
+	}
+
+	return kp, nil
+}
+
+// Certificate returns the current certificate, reloading it from disk if the
+// files changed. If reloading fails, the previous certificate keeps being
+// used until the files are fixed.
+func (kp *KeyPair) Certificate() (*tls.Certificate, error) {
+	kp.mu.Lock()
+	defer kp.mu.Unlock()
+
+	certChanged, certErr := kp.certFile.changed()
+	keyChanged, keyErr := kp.keyFile.changed()
+	if err := errors.Join(certErr, keyErr); err != nil {
+		if kp.cert != nil {
+			return kp.cert, nil
+		}
+		return nil, err
+	}
+
+	if certChanged || keyChanged || kp.cert == nil {
+		cert, err := tls.LoadX509KeyPair(kp.certFile.path, kp.keyFile.path)
+		if err != nil {
+			if kp.cert != nil {
+				return kp.cert, nil
+			}
+			return nil, fmt.Errorf("failed to load key pair %s: %w", kp.certFile.path, err)
+		}
+		kp.cert = &cert
+	}
+
+	return kp.cert, nil
+}
+
+func (kp *KeyPair) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	return kp.Certificate()
+}
+
+func (kp *KeyPair) GetClientCertificate(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	return kp.Certificate()
+}
+
+// CertPool serves a pool of CA certificates from disk, reloading it whenever
+// the file changes.
+type CertPool struct {
+	mu   sync.Mutex
+	pool *x509.CertPool
+
+	file file
+}
+
+// NewCertPool loads the CA bundle, failing if it contains no certificates.
+func NewCertPool(caFile string) (*CertPool, error) {
+	cp := &CertPool{
+		file: file{path: caFile},
+	}
+
+	if _, err := cp.Pool(); err != nil {
+		return nil, err
+	}
+
+	return cp, nil
+}
+
+// Pool returns the current pool, reloading it from disk if the file changed.
+// If reloading fails, the previous pool keeps being used.
+func (cp *CertPool) Pool() (*x509.CertPool, error) {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+
+	changed, err := cp.file.changed()
+	if err != nil {
+		if cp.pool != nil {
+			return cp.pool, nil
+		}
+		return nil, err
+	}
+
+	if changed || cp.pool == nil {
+		bytes, err := os.ReadFile(cp.file.path)
+		if err != nil {
+			if cp.pool != nil {
+				return cp.pool, nil
+			}
+			return nil, err
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(bytes) {
+			if cp.pool != nil {
+				return cp.pool, nil
+			}
+			return nil, fmt.Errorf("no certificates found in %s", cp.file.path)
+		}
+		cp.pool = pool
+	}
+
+	return cp.pool, nil
+}
+
+// ServerConfig configures TLS for a listener.
+type ServerConfig struct {
+	CertFile string
+	KeyFile  string
+
+	// ClientCAFile is the CA bundle client certificates are verified against.
+	// If unset, clients are not asked for certificates.
+	ClientCAFile string
+
+	// ClientAuth is the policy for client certificates when ClientCAFile is
+	// set.
+	ClientAuth tls.ClientAuthType
+}
+
+// Enabled reports whether the listener should serve TLS.
+func (c ServerConfig) Enabled() bool {
+	return c.CertFile != "" && c.KeyFile != ""
+}
+
+// NewServerConfig creates a tls.Config serving the configured certificate,
+// and verifying client certificates if a client CA is configured. Certificate
+// and CA files are reloaded as they change on disk.
+func NewServerConfig(c ServerConfig) (*tls.Config, error) {
+	keyPair, err := NewKeyPair(c.CertFile, c.KeyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	config := &tls.Config{
+		MinVersion:     tls.VersionTLS12,
+		GetCertificate: keyPair.GetCertificate,
+	}
+
+	if c.ClientCAFile == "" {
+		return config, nil
+	}
+
+	clientCAs, err := NewCertPool(c.ClientCAFile)
+	if err != nil {
+		return nil, err
+	}
+
+	config.GetConfigForClient = func(*tls.ClientHelloInfo) (*tls.Config, error) {
+		pool, err := clientCAs.Pool()
+		if err != nil {
+			return nil, err
+		}
+
+		perConn := config.Clone()
+		perConn.GetConfigForClient = nil
+		perConn.ClientCAs = pool
+		perConn.ClientAuth = c.ClientAuth
+		return perConn, nil
+	}
+
+	return config, nil
+}
+
+// ClientConfig configures TLS for outgoing connections.
+type ClientConfig struct {
+	// CAFile is the CA bundle server certificates are verified against. The
+	// system roots are used if unset.
+	CAFile string
+
+	// CertFile and KeyFile are the certificate presented to servers that
+	// require client certificates.
+	CertFile string
+	KeyFile  string
+
+	ServerName         string
+	InsecureSkipVerify bool
+}
+
+// NewClientConfig creates a tls.Config for dialling TLS servers. Certificate
+// and CA files are reloaded as they change on disk.
+func NewClientConfig(c ClientConfig) (*tls.Config, error) {
+	config := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		ServerName:         c.ServerName,
+		InsecureSkipVerify: c.InsecureSkipVerify,
+	}
+
+	if c.CertFile != "" || c.KeyFile != "" {
+		keyPair, err := NewKeyPair(c.CertFile, c.KeyFile)
+		if err != nil {
+			return nil, err
+		}
+		config.GetClientCertificate = keyPair.GetClientCertificate
+	}
+
+	if c.CAFile != "" && !c.InsecureSkipVerify {
+		rootCAs, err := NewCertPool(c.CAFile)
+		if err != nil {
+			return nil, err
+		}
+
+		// The standard verification only consults a fixed RootCAs pool, so
+		// it is replaced by an equivalent check against the current pool.
+		config.InsecureSkipVerify = true
+		config.VerifyConnection = func(state tls.ConnectionState) error {
+			if len(state.PeerCertificates) == 0 {
+				return errors.New("server presented no certificate")
+			}
+
+			pool, err := rootCAs.Pool()
+			if err != nil {
+				return err
+			}
+
+			opts := x509.VerifyOptions{
+				DNSName:       state.ServerName,
+				Roots:         pool,
+				Intermediates: x509.NewCertPool(),
+			}
+			for _, cert := range state.PeerCertificates[1:] {
+				opts.Intermediates.AddCert(cert)
+			}
+
+			_, err = state.PeerCertificates[0].Verify(opts)
+			return err
+		}
+	}
+
+	return config, nil
+}
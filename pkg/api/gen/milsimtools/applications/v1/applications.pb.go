@@ -0,0 +1,975 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: milsimtools/applications/v1/applications.proto
+
+package applicationsv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// The kind of answer a question takes.
+type ApplicationQuestionType int32
+
+const (
+	ApplicationQuestionType_APPLICATION_QUESTION_TYPE_UNSPECIFIED ApplicationQuestionType = 0
+	// A single line of text.
+	ApplicationQuestionType_APPLICATION_QUESTION_TYPE_SHORT_TEXT ApplicationQuestionType = 1
+	// Free text spanning several paragraphs.
+	ApplicationQuestionType_APPLICATION_QUESTION_TYPE_LONG_TEXT ApplicationQuestionType = 2
+	// One of the question's options.
+	ApplicationQuestionType_APPLICATION_QUESTION_TYPE_SINGLE_CHOICE ApplicationQuestionType = 3
+	// Any number of the question's options.
+	ApplicationQuestionType_APPLICATION_QUESTION_TYPE_MULTIPLE_CHOICE ApplicationQuestionType = 4
+)
+
+// Enum value maps for ApplicationQuestionType.
+var (
+	ApplicationQuestionType_name = map[int32]string{
+		0: "APPLICATION_QUESTION_TYPE_UNSPECIFIED",
+		1: "APPLICATION_QUESTION_TYPE_SHORT_TEXT",
+		2: "APPLICATION_QUESTION_TYPE_LONG_TEXT",
+		3: "APPLICATION_QUESTION_TYPE_SINGLE_CHOICE",
+		4: "APPLICATION_QUESTION_TYPE_MULTIPLE_CHOICE",
+	}
+	ApplicationQuestionType_value = map[string]int32{
+		"APPLICATION_QUESTION_TYPE_UNSPECIFIED":     0,
+		"APPLICATION_QUESTION_TYPE_SHORT_TEXT":      1,
+		"APPLICATION_QUESTION_TYPE_LONG_TEXT":       2,
+		"APPLICATION_QUESTION_TYPE_SINGLE_CHOICE":   3,
+		"APPLICATION_QUESTION_TYPE_MULTIPLE_CHOICE": 4,
+	}
+)
+
+func (x ApplicationQuestionType) Enum() *ApplicationQuestionType {
+	p := new(ApplicationQuestionType)
+	*p = x
+	return p
+}
+
+func (x ApplicationQuestionType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ApplicationQuestionType) Descriptor() protoreflect.EnumDescriptor {
+	return file_milsimtools_applications_v1_applications_proto_enumTypes[0].Descriptor()
+}
+
+func (ApplicationQuestionType) Type() protoreflect.EnumType {
+	return &file_milsimtools_applications_v1_applications_proto_enumTypes[0]
+}
+
+func (x ApplicationQuestionType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ApplicationQuestionType.Descriptor instead.
+func (ApplicationQuestionType) EnumDescriptor() ([]byte, []int) {
+	return file_milsimtools_applications_v1_applications_proto_rawDescGZIP(), []int{0}
+}
+
+// The status of an application.
+type ApplicationStatus int32
+
+const (
+	ApplicationStatus_APPLICATION_STATUS_UNSPECIFIED ApplicationStatus = 0
+	// The application is awaiting a decision.
+	ApplicationStatus_APPLICATION_STATUS_PENDING ApplicationStatus = 1
+	// The application was accepted, and the applicant made a member.
+	ApplicationStatus_APPLICATION_STATUS_ACCEPTED ApplicationStatus = 2
+	// The application was rejected.
+	ApplicationStatus_APPLICATION_STATUS_REJECTED ApplicationStatus = 3
+)
+
+// Enum value maps for ApplicationStatus.
+var (
+	ApplicationStatus_name = map[int32]string{
+		0: "APPLICATION_STATUS_UNSPECIFIED",
+		1: "APPLICATION_STATUS_PENDING",
+		2: "APPLICATION_STATUS_ACCEPTED",
+		3: "APPLICATION_STATUS_REJECTED",
+	}
+	ApplicationStatus_value = map[string]int32{
+		"APPLICATION_STATUS_UNSPECIFIED": 0,
+		"APPLICATION_STATUS_PENDING":     1,
+		"APPLICATION_STATUS_ACCEPTED":    2,
+		"APPLICATION_STATUS_REJECTED":    3,
+	}
+)
+
+func (x ApplicationStatus) Enum() *ApplicationStatus {
+	p := new(ApplicationStatus)
+	*p = x
+	return p
+}
+
+func (x ApplicationStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ApplicationStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_milsimtools_applications_v1_applications_proto_enumTypes[1].Descriptor()
+}
+
+func (ApplicationStatus) Type() protoreflect.EnumType {
+	return &file_milsimtools_applications_v1_applications_proto_enumTypes[1]
+}
+
+func (x ApplicationStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ApplicationStatus.Descriptor instead.
+func (ApplicationStatus) EnumDescriptor() ([]byte, []int) {
+	return file_milsimtools_applications_v1_applications_proto_rawDescGZIP(), []int{1}
+}
+
+// A reviewer's vote on an application.
+type ApplicationVoteValue int32
+
+const (
+	ApplicationVoteValue_APPLICATION_VOTE_VALUE_UNSPECIFIED ApplicationVoteValue = 0
+	// The reviewer is in favour of accepting the application.
+	ApplicationVoteValue_APPLICATION_VOTE_VALUE_APPROVE ApplicationVoteValue = 1
+	// The reviewer is in favour of rejecting the application.
+	ApplicationVoteValue_APPLICATION_VOTE_VALUE_REJECT ApplicationVoteValue = 2
+)
+
+// Enum value maps for ApplicationVoteValue.
+var (
+	ApplicationVoteValue_name = map[int32]string{
+		0: "APPLICATION_VOTE_VALUE_UNSPECIFIED",
+		1: "APPLICATION_VOTE_VALUE_APPROVE",
+		2: "APPLICATION_VOTE_VALUE_REJECT",
+	}
+	ApplicationVoteValue_value = map[string]int32{
+		"APPLICATION_VOTE_VALUE_UNSPECIFIED": 0,
+		"APPLICATION_VOTE_VALUE_APPROVE":     1,
+		"APPLICATION_VOTE_VALUE_REJECT":      2,
+	}
+)
+
+func (x ApplicationVoteValue) Enum() *ApplicationVoteValue {
+	p := new(ApplicationVoteValue)
+	*p = x
+	return p
+}
+
+func (x ApplicationVoteValue) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ApplicationVoteValue) Descriptor() protoreflect.EnumDescriptor {
+	return file_milsimtools_applications_v1_applications_proto_enumTypes[2].Descriptor()
+}
+
+func (ApplicationVoteValue) Type() protoreflect.EnumType {
+	return &file_milsimtools_applications_v1_applications_proto_enumTypes[2]
+}
+
+func (x ApplicationVoteValue) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ApplicationVoteValue.Descriptor instead.
+func (ApplicationVoteValue) EnumDescriptor() ([]byte, []int) {
+	return file_milsimtools_applications_v1_applications_proto_rawDescGZIP(), []int{2}
+}
+
+// A question of an application form.
+type ApplicationQuestion struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the question, represented as a ULID. It is assigned when the
+	// question is added to the form, and must be kept when the form is updated
+	// for the question to remain the same question.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The question, as shown to applicants.
+	Prompt string `protobuf:"bytes,2,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	// Guidance on answering the question.
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// The kind of answer the question takes.
+	Type ApplicationQuestionType `protobuf:"varint,4,opt,name=type,proto3,enum=milsimtools.applications.v1.ApplicationQuestionType" json:"type,omitempty"`
+	// Whether the question must be answered.
+	Required bool `protobuf:"varint,5,opt,name=required,proto3" json:"required,omitempty"`
+	// The options of choice questions.
+	Options       []string `protobuf:"bytes,6,rep,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplicationQuestion) Reset() {
+	*x = ApplicationQuestion{}
+	mi := &file_milsimtools_applications_v1_applications_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplicationQuestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplicationQuestion) ProtoMessage() {}
+
+func (x *ApplicationQuestion) ProtoReflect() protoreflect.Message {
+	mi := &file_milsimtools_applications_v1_applications_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplicationQuestion.ProtoReflect.Descriptor instead.
+func (*ApplicationQuestion) Descriptor() ([]byte, []int) {
+	return file_milsimtools_applications_v1_applications_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ApplicationQuestion) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ApplicationQuestion) GetPrompt() string {
+	if x != nil {
+		return x.Prompt
+	}
+	return ""
+}
+
+func (x *ApplicationQuestion) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ApplicationQuestion) GetType() ApplicationQuestionType {
+	if x != nil {
+		return x.Type
+	}
+	return ApplicationQuestionType_APPLICATION_QUESTION_TYPE_UNSPECIFIED
+}
+
+func (x *ApplicationQuestion) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *ApplicationQuestion) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+// The form users fill in to apply to a unit.
+type ApplicationForm struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the unit the form belongs to, represented as a ULID.
+	UnitId string `protobuf:"bytes,1,opt,name=unit_id,json=unitId,proto3" json:"unit_id,omitempty"`
+	// An introduction to the form, shown to applicants.
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// Whether the unit accepts applications.
+	Open bool `protobuf:"varint,3,opt,name=open,proto3" json:"open,omitempty"`
+	// The questions of the form, in the order they are asked.
+	Questions []*ApplicationQuestion `protobuf:"bytes,4,rep,name=questions,proto3" json:"questions,omitempty"`
+	// The time the form was created.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// The last time the form was updated.
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplicationForm) Reset() {
+	*x = ApplicationForm{}
+	mi := &file_milsimtools_applications_v1_applications_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplicationForm) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplicationForm) ProtoMessage() {}
+
+func (x *ApplicationForm) ProtoReflect() protoreflect.Message {
+	mi := &file_milsimtools_applications_v1_applications_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplicationForm.ProtoReflect.Descriptor instead.
+func (*ApplicationForm) Descriptor() ([]byte, []int) {
+	return file_milsimtools_applications_v1_applications_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ApplicationForm) GetUnitId() string {
+	if x != nil {
+		return x.UnitId
+	}
+	return ""
+}
+
+func (x *ApplicationForm) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ApplicationForm) GetOpen() bool {
+	if x != nil {
+		return x.Open
+	}
+	return false
+}
+
+func (x *ApplicationForm) GetQuestions() []*ApplicationQuestion {
+	if x != nil {
+		return x.Questions
+	}
+	return nil
+}
+
+func (x *ApplicationForm) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ApplicationForm) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// An answer to a question of an application form.
+type ApplicationAnswer struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the question answered.
+	QuestionId string `protobuf:"bytes,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	// The question as it was asked, kept so that answers still read the same
+	// once the form changes. It is set by the service.
+	Prompt string `protobuf:"bytes,2,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	// The answer to a text question.
+	Text string `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	// The options chosen for a choice question.
+	Choices       []string `protobuf:"bytes,4,rep,name=choices,proto3" json:"choices,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplicationAnswer) Reset() {
+	*x = ApplicationAnswer{}
+	mi := &file_milsimtools_applications_v1_applications_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplicationAnswer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplicationAnswer) ProtoMessage() {}
+
+func (x *ApplicationAnswer) ProtoReflect() protoreflect.Message {
+	mi := &file_milsimtools_applications_v1_applications_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplicationAnswer.ProtoReflect.Descriptor instead.
+func (*ApplicationAnswer) Descriptor() ([]byte, []int) {
+	return file_milsimtools_applications_v1_applications_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ApplicationAnswer) GetQuestionId() string {
+	if x != nil {
+		return x.QuestionId
+	}
+	return ""
+}
+
+func (x *ApplicationAnswer) GetPrompt() string {
+	if x != nil {
+		return x.Prompt
+	}
+	return ""
+}
+
+func (x *ApplicationAnswer) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *ApplicationAnswer) GetChoices() []string {
+	if x != nil {
+		return x.Choices
+	}
+	return nil
+}
+
+// An application of a user to join a unit.
+type Application struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the application, represented as a ULID.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The ID of the unit applied to, represented as a ULID.
+	UnitId string `protobuf:"bytes,2,opt,name=unit_id,json=unitId,proto3" json:"unit_id,omitempty"`
+	// The ID of the user applying, represented as a ULID.
+	UserId string `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// The status of the application.
+	Status ApplicationStatus `protobuf:"varint,4,opt,name=status,proto3,enum=milsimtools.applications.v1.ApplicationStatus" json:"status,omitempty"`
+	// The answers to the questions of the form.
+	Answers []*ApplicationAnswer `protobuf:"bytes,5,rep,name=answers,proto3" json:"answers,omitempty"`
+	// The reason the application was rejected.
+	RejectionReason string `protobuf:"bytes,6,opt,name=rejection_reason,json=rejectionReason,proto3" json:"rejection_reason,omitempty"`
+	// The time before which the applicant may not apply again, if the
+	// application was rejected with a cooldown.
+	ReapplyAfter *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=reapply_after,json=reapplyAfter,proto3" json:"reapply_after,omitempty"`
+	// The ID of the user who accepted or rejected the application.
+	DecidedBy string `protobuf:"bytes,8,opt,name=decided_by,json=decidedBy,proto3" json:"decided_by,omitempty"`
+	// The time the application was accepted or rejected.
+	DecidedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=decided_at,json=decidedAt,proto3" json:"decided_at,omitempty"`
+	// The time the application was submitted.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// The last time the application was updated.
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Application) Reset() {
+	*x = Application{}
+	mi := &file_milsimtools_applications_v1_applications_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Application) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Application) ProtoMessage() {}
+
+func (x *Application) ProtoReflect() protoreflect.Message {
+	mi := &file_milsimtools_applications_v1_applications_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Application.ProtoReflect.Descriptor instead.
+func (*Application) Descriptor() ([]byte, []int) {
+	return file_milsimtools_applications_v1_applications_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Application) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Application) GetUnitId() string {
+	if x != nil {
+		return x.UnitId
+	}
+	return ""
+}
+
+func (x *Application) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Application) GetStatus() ApplicationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ApplicationStatus_APPLICATION_STATUS_UNSPECIFIED
+}
+
+func (x *Application) GetAnswers() []*ApplicationAnswer {
+	if x != nil {
+		return x.Answers
+	}
+	return nil
+}
+
+func (x *Application) GetRejectionReason() string {
+	if x != nil {
+		return x.RejectionReason
+	}
+	return ""
+}
+
+func (x *Application) GetReapplyAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReapplyAfter
+	}
+	return nil
+}
+
+func (x *Application) GetDecidedBy() string {
+	if x != nil {
+		return x.DecidedBy
+	}
+	return ""
+}
+
+func (x *Application) GetDecidedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DecidedAt
+	}
+	return nil
+}
+
+func (x *Application) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Application) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// A comment of a reviewer on an application. Comments are only visible to
+// reviewers.
+type ApplicationComment struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the comment, represented as a ULID.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The ID of the application commented on.
+	ApplicationId string `protobuf:"bytes,2,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	// The ID of the user who wrote the comment.
+	AuthorId string `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	// The comment.
+	Body string `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	// The time the comment was written.
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplicationComment) Reset() {
+	*x = ApplicationComment{}
+	mi := &file_milsimtools_applications_v1_applications_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplicationComment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplicationComment) ProtoMessage() {}
+
+func (x *ApplicationComment) ProtoReflect() protoreflect.Message {
+	mi := &file_milsimtools_applications_v1_applications_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplicationComment.ProtoReflect.Descriptor instead.
+func (*ApplicationComment) Descriptor() ([]byte, []int) {
+	return file_milsimtools_applications_v1_applications_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ApplicationComment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ApplicationComment) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+func (x *ApplicationComment) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *ApplicationComment) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *ApplicationComment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// The vote of a reviewer on an application.
+type ApplicationVote struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the user who voted.
+	ReviewerId string `protobuf:"bytes,1,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`
+	// The vote.
+	Value ApplicationVoteValue `protobuf:"varint,2,opt,name=value,proto3,enum=milsimtools.applications.v1.ApplicationVoteValue" json:"value,omitempty"`
+	// The last time the reviewer voted.
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplicationVote) Reset() {
+	*x = ApplicationVote{}
+	mi := &file_milsimtools_applications_v1_applications_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplicationVote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplicationVote) ProtoMessage() {}
+
+func (x *ApplicationVote) ProtoReflect() protoreflect.Message {
+	mi := &file_milsimtools_applications_v1_applications_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplicationVote.ProtoReflect.Descriptor instead.
+func (*ApplicationVote) Descriptor() ([]byte, []int) {
+	return file_milsimtools_applications_v1_applications_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ApplicationVote) GetReviewerId() string {
+	if x != nil {
+		return x.ReviewerId
+	}
+	return ""
+}
+
+func (x *ApplicationVote) GetValue() ApplicationVoteValue {
+	if x != nil {
+		return x.Value
+	}
+	return ApplicationVoteValue_APPLICATION_VOTE_VALUE_UNSPECIFIED
+}
+
+func (x *ApplicationVote) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// The reviewers' comments and votes on an application.
+type ApplicationReview struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the application.
+	ApplicationId string `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	// The comments, oldest first.
+	Comments []*ApplicationComment `protobuf:"bytes,2,rep,name=comments,proto3" json:"comments,omitempty"`
+	// The vote of each reviewer who voted.
+	Votes []*ApplicationVote `protobuf:"bytes,3,rep,name=votes,proto3" json:"votes,omitempty"`
+	// The number of votes to approve the application.
+	Approvals int32 `protobuf:"varint,4,opt,name=approvals,proto3" json:"approvals,omitempty"`
+	// The number of votes to reject the application.
+	Rejections    int32 `protobuf:"varint,5,opt,name=rejections,proto3" json:"rejections,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplicationReview) Reset() {
+	*x = ApplicationReview{}
+	mi := &file_milsimtools_applications_v1_applications_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplicationReview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplicationReview) ProtoMessage() {}
+
+func (x *ApplicationReview) ProtoReflect() protoreflect.Message {
+	mi := &file_milsimtools_applications_v1_applications_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplicationReview.ProtoReflect.Descriptor instead.
+func (*ApplicationReview) Descriptor() ([]byte, []int) {
+	return file_milsimtools_applications_v1_applications_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ApplicationReview) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+func (x *ApplicationReview) GetComments() []*ApplicationComment {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+func (x *ApplicationReview) GetVotes() []*ApplicationVote {
+	if x != nil {
+		return x.Votes
+	}
+	return nil
+}
+
+func (x *ApplicationReview) GetApprovals() int32 {
+	if x != nil {
+		return x.Approvals
+	}
+	return 0
+}
+
+func (x *ApplicationReview) GetRejections() int32 {
+	if x != nil {
+		return x.Rejections
+	}
+	return 0
+}
+
+var File_milsimtools_applications_v1_applications_proto protoreflect.FileDescriptor
+
+const file_milsimtools_applications_v1_applications_proto_rawDesc = "" +
+	"\n" +
+	".milsimtools/applications/v1/applications.proto\x12\x1bmilsimtools.applications.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x96\x02\n" +
+	"\x13ApplicationQuestion\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\"\n" +
+	"\x06prompt\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xf4\x03R\x06prompt\x12*\n" +
+	"\vdescription\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\xd0\x0fR\vdescription\x12T\n" +
+	"\x04type\x18\x04 \x01(\x0e24.milsimtools.applications.v1.ApplicationQuestionTypeB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\x04type\x12\x1a\n" +
+	"\brequired\x18\x05 \x01(\bR\brequired\x12-\n" +
+	"\aoptions\x18\x06 \x03(\tB\x13\xbaH\x10\x92\x01\r\x102\x18\x01\"\ar\x05\x10\x01\x18\xc8\x01R\aoptions\"\xc2\x02\n" +
+	"\x0fApplicationForm\x12\x1f\n" +
+	"\aunit_id\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x06unitId\x12*\n" +
+	"\vdescription\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\x88'R\vdescription\x12\x12\n" +
+	"\x04open\x18\x03 \x01(\bR\x04open\x12X\n" +
+	"\tquestions\x18\x04 \x03(\v20.milsimtools.applications.v1.ApplicationQuestionB\b\xbaH\x05\x92\x01\x02\x102R\tquestions\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x96\x01\n" +
+	"\x11ApplicationAnswer\x12'\n" +
+	"\vquestion_id\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\n" +
+	"questionId\x12\x16\n" +
+	"\x06prompt\x18\x02 \x01(\tR\x06prompt\x12\x1c\n" +
+	"\x04text\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\x90NR\x04text\x12\"\n" +
+	"\achoices\x18\x04 \x03(\tB\b\xbaH\x05\x92\x01\x02\x102R\achoices\"\x9d\x04\n" +
+	"\vApplication\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\aunit_id\x18\x02 \x01(\tR\x06unitId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12F\n" +
+	"\x06status\x18\x04 \x01(\x0e2..milsimtools.applications.v1.ApplicationStatusR\x06status\x12H\n" +
+	"\aanswers\x18\x05 \x03(\v2..milsimtools.applications.v1.ApplicationAnswerR\aanswers\x12)\n" +
+	"\x10rejection_reason\x18\x06 \x01(\tR\x0frejectionReason\x12?\n" +
+	"\rreapply_after\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\freapplyAfter\x12\x1d\n" +
+	"\n" +
+	"decided_by\x18\b \x01(\tR\tdecidedBy\x129\n" +
+	"\n" +
+	"decided_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tdecidedAt\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xb7\x01\n" +
+	"\x12ApplicationComment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12%\n" +
+	"\x0eapplication_id\x18\x02 \x01(\tR\rapplicationId\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\tR\bauthorId\x12\x12\n" +
+	"\x04body\x18\x04 \x01(\tR\x04body\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xb6\x01\n" +
+	"\x0fApplicationVote\x12\x1f\n" +
+	"\vreviewer_id\x18\x01 \x01(\tR\n" +
+	"reviewerId\x12G\n" +
+	"\x05value\x18\x02 \x01(\x0e21.milsimtools.applications.v1.ApplicationVoteValueR\x05value\x129\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x89\x02\n" +
+	"\x11ApplicationReview\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\x12K\n" +
+	"\bcomments\x18\x02 \x03(\v2/.milsimtools.applications.v1.ApplicationCommentR\bcomments\x12B\n" +
+	"\x05votes\x18\x03 \x03(\v2,.milsimtools.applications.v1.ApplicationVoteR\x05votes\x12\x1c\n" +
+	"\tapprovals\x18\x04 \x01(\x05R\tapprovals\x12\x1e\n" +
+	"\n" +
+	"rejections\x18\x05 \x01(\x05R\n" +
+	"rejections*\xf3\x01\n" +
+	"\x17ApplicationQuestionType\x12)\n" +
+	"%APPLICATION_QUESTION_TYPE_UNSPECIFIED\x10\x00\x12(\n" +
+	"$APPLICATION_QUESTION_TYPE_SHORT_TEXT\x10\x01\x12'\n" +
+	"#APPLICATION_QUESTION_TYPE_LONG_TEXT\x10\x02\x12+\n" +
+	"'APPLICATION_QUESTION_TYPE_SINGLE_CHOICE\x10\x03\x12-\n" +
+	")APPLICATION_QUESTION_TYPE_MULTIPLE_CHOICE\x10\x04*\x99\x01\n" +
+	"\x11ApplicationStatus\x12\"\n" +
+	"\x1eAPPLICATION_STATUS_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aAPPLICATION_STATUS_PENDING\x10\x01\x12\x1f\n" +
+	"\x1bAPPLICATION_STATUS_ACCEPTED\x10\x02\x12\x1f\n" +
+	"\x1bAPPLICATION_STATUS_REJECTED\x10\x03*\x85\x01\n" +
+	"\x14ApplicationVoteValue\x12&\n" +
+	"\"APPLICATION_VOTE_VALUE_UNSPECIFIED\x10\x00\x12\"\n" +
+	"\x1eAPPLICATION_VOTE_VALUE_APPROVE\x10\x01\x12!\n" +
+	"\x1dAPPLICATION_VOTE_VALUE_REJECT\x10\x02B\x99\x02\n" +
+	"\x1fcom.milsimtools.applications.v1B\x11ApplicationsProtoP\x01ZUgithub.com/milsim-tools/pincer/pkg/api/gen/milsimtools/applications/v1;applicationsv1\xa2\x02\x03MAX\xaa\x02\x1bMilsimtools.Applications.V1\xca\x02\x1bMilsimtools\\Applications\\V1\xe2\x02'Milsimtools\\Applications\\V1\\GPBMetadata\xea\x02\x1dMilsimtools::Applications::V1b\x06proto3"
+
+var (
+	file_milsimtools_applications_v1_applications_proto_rawDescOnce sync.Once
+	file_milsimtools_applications_v1_applications_proto_rawDescData []byte
+)
+
+func file_milsimtools_applications_v1_applications_proto_rawDescGZIP() []byte {
+	file_milsimtools_applications_v1_applications_proto_rawDescOnce.Do(func() {
+		file_milsimtools_applications_v1_applications_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_milsimtools_applications_v1_applications_proto_rawDesc), len(file_milsimtools_applications_v1_applications_proto_rawDesc)))
+	})
+	return file_milsimtools_applications_v1_applications_proto_rawDescData
+}
+
+var file_milsimtools_applications_v1_applications_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_milsimtools_applications_v1_applications_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_milsimtools_applications_v1_applications_proto_goTypes = []any{
+	(ApplicationQuestionType)(0),  // 0: milsimtools.applications.v1.ApplicationQuestionType
+	(ApplicationStatus)(0),        // 1: milsimtools.applications.v1.ApplicationStatus
+	(ApplicationVoteValue)(0),     // 2: milsimtools.applications.v1.ApplicationVoteValue
+	(*ApplicationQuestion)(nil),   // 3: milsimtools.applications.v1.ApplicationQuestion
+	(*ApplicationForm)(nil),       // 4: milsimtools.applications.v1.ApplicationForm
+	(*ApplicationAnswer)(nil),     // 5: milsimtools.applications.v1.ApplicationAnswer
+	(*Application)(nil),           // 6: milsimtools.applications.v1.Application
+	(*ApplicationComment)(nil),    // 7: milsimtools.applications.v1.ApplicationComment
+	(*ApplicationVote)(nil),       // 8: milsimtools.applications.v1.ApplicationVote
+	(*ApplicationReview)(nil),     // 9: milsimtools.applications.v1.ApplicationReview
+	(*timestamppb.Timestamp)(nil), // 10: google.protobuf.Timestamp
+}
+var file_milsimtools_applications_v1_applications_proto_depIdxs = []int32{
+	0,  // 0: milsimtools.applications.v1.ApplicationQuestion.type:type_name -> milsimtools.applications.v1.ApplicationQuestionType
+	3,  // 1: milsimtools.applications.v1.ApplicationForm.questions:type_name -> milsimtools.applications.v1.ApplicationQuestion
+	10, // 2: milsimtools.applications.v1.ApplicationForm.created_at:type_name -> google.protobuf.Timestamp
+	10, // 3: milsimtools.applications.v1.ApplicationForm.updated_at:type_name -> google.protobuf.Timestamp
+	1,  // 4: milsimtools.applications.v1.Application.status:type_name -> milsimtools.applications.v1.ApplicationStatus
+	5,  // 5: milsimtools.applications.v1.Application.answers:type_name -> milsimtools.applications.v1.ApplicationAnswer
+	10, // 6: milsimtools.applications.v1.Application.reapply_after:type_name -> google.protobuf.Timestamp
+	10, // 7: milsimtools.applications.v1.Application.decided_at:type_name -> google.protobuf.Timestamp
+	10, // 8: milsimtools.applications.v1.Application.created_at:type_name -> google.protobuf.Timestamp
+	10, // 9: milsimtools.applications.v1.Application.updated_at:type_name -> google.protobuf.Timestamp
+	10, // 10: milsimtools.applications.v1.ApplicationComment.created_at:type_name -> google.protobuf.Timestamp
+	2,  // 11: milsimtools.applications.v1.ApplicationVote.value:type_name -> milsimtools.applications.v1.ApplicationVoteValue
+	10, // 12: milsimtools.applications.v1.ApplicationVote.updated_at:type_name -> google.protobuf.Timestamp
+	7,  // 13: milsimtools.applications.v1.ApplicationReview.comments:type_name -> milsimtools.applications.v1.ApplicationComment
+	8,  // 14: milsimtools.applications.v1.ApplicationReview.votes:type_name -> milsimtools.applications.v1.ApplicationVote
+	15, // [15:15] is the sub-list for method output_type
+	15, // [15:15] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
+}
+
+func init() { file_milsimtools_applications_v1_applications_proto_init() }
+func file_milsimtools_applications_v1_applications_proto_init() {
+	if File_milsimtools_applications_v1_applications_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_milsimtools_applications_v1_applications_proto_rawDesc), len(file_milsimtools_applications_v1_applications_proto_rawDesc)),
+			NumEnums:      3,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_milsimtools_applications_v1_applications_proto_goTypes,
+		DependencyIndexes: file_milsimtools_applications_v1_applications_proto_depIdxs,
+		EnumInfos:         file_milsimtools_applications_v1_applications_proto_enumTypes,
+		MessageInfos:      file_milsimtools_applications_v1_applications_proto_msgTypes,
+	}.Build()
+	File_milsimtools_applications_v1_applications_proto = out.File
+	file_milsimtools_applications_v1_applications_proto_goTypes = nil
+	file_milsimtools_applications_v1_applications_proto_depIdxs = nil
+}
@@ -0,0 +1,917 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: milsimtools/applications/v1/service.proto
+
+package applicationsv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	_ "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/authz/v1"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetApplicationFormRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the unit to get the application form of.
+	UnitId        string `protobuf:"bytes,1,opt,name=unit_id,json=unitId,proto3" json:"unit_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetApplicationFormRequest) Reset() {
+	*x = GetApplicationFormRequest{}
+	mi := &file_milsimtools_applications_v1_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetApplicationFormRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetApplicationFormRequest) ProtoMessage() {}
+
+func (x *GetApplicationFormRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_milsimtools_applications_v1_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetApplicationFormRequest.ProtoReflect.Descriptor instead.
+func (*GetApplicationFormRequest) Descriptor() ([]byte, []int) {
+	return file_milsimtools_applications_v1_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetApplicationFormRequest) GetUnitId() string {
+	if x != nil {
+		return x.UnitId
+	}
+	return ""
+}
+
+type UpdateApplicationFormRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The form to update, which is created if the unit has none yet.
+	//
+	// The form's `unit_id` field is used to identify the form to update.
+	Form *ApplicationForm `protobuf:"bytes,1,opt,name=form,proto3" json:"form,omitempty"`
+	// The list of fields to update, `description`, `open` and `questions`.
+	//
+	// Questions are replaced as a whole. Questions without an ID are added to
+	// the form, and questions whose ID is left out are removed from it.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateApplicationFormRequest) Reset() {
+	*x = UpdateApplicationFormRequest{}
+	mi := &file_milsimtools_applications_v1_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateApplicationFormRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateApplicationFormRequest) ProtoMessage() {}
+
+func (x *UpdateApplicationFormRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_milsimtools_applications_v1_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateApplicationFormRequest.ProtoReflect.Descriptor instead.
+func (*UpdateApplicationFormRequest) Descriptor() ([]byte, []int) {
+	return file_milsimtools_applications_v1_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UpdateApplicationFormRequest) GetForm() *ApplicationForm {
+	if x != nil {
+		return x.Form
+	}
+	return nil
+}
+
+func (x *UpdateApplicationFormRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type SubmitApplicationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the unit to apply to.
+	UnitId string `protobuf:"bytes,1,opt,name=unit_id,json=unitId,proto3" json:"unit_id,omitempty"`
+	// The ID of the user applying, who must be the caller.
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// The answers to the questions of the unit's application form.
+	Answers       []*ApplicationAnswer `protobuf:"bytes,3,rep,name=answers,proto3" json:"answers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitApplicationRequest) Reset() {
+	*x = SubmitApplicationRequest{}
+	mi := &file_milsimtools_applications_v1_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitApplicationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitApplicationRequest) ProtoMessage() {}
+
+func (x *SubmitApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_milsimtools_applications_v1_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitApplicationRequest.ProtoReflect.Descriptor instead.
+func (*SubmitApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_milsimtools_applications_v1_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SubmitApplicationRequest) GetUnitId() string {
+	if x != nil {
+		return x.UnitId
+	}
+	return ""
+}
+
+func (x *SubmitApplicationRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SubmitApplicationRequest) GetAnswers() []*ApplicationAnswer {
+	if x != nil {
+		return x.Answers
+	}
+	return nil
+}
+
+type GetApplicationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the unit the application was made to.
+	UnitId string `protobuf:"bytes,1,opt,name=unit_id,json=unitId,proto3" json:"unit_id,omitempty"`
+	// The ID of the application to get.
+	Id            string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetApplicationRequest) Reset() {
+	*x = GetApplicationRequest{}
+	mi := &file_milsimtools_applications_v1_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetApplicationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetApplicationRequest) ProtoMessage() {}
+
+func (x *GetApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_milsimtools_applications_v1_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetApplicationRequest.ProtoReflect.Descriptor instead.
+func (*GetApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_milsimtools_applications_v1_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetApplicationRequest) GetUnitId() string {
+	if x != nil {
+		return x.UnitId
+	}
+	return ""
+}
+
+func (x *GetApplicationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListApplicationsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the unit to filter by.
+	UnitId string `protobuf:"bytes,1,opt,name=unit_id,json=unitId,proto3" json:"unit_id,omitempty"`
+	// The ID of the user to filter by. Users can always list their own
+	// applications.
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// The maximum number of applications to return. Default is 50, maximum is
+	// 100.
+	PageSize int32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// A page token, received from a previous `ListApplications` call.
+	PageToken string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// A CEL expression the applications must match, over the `unit_id`,
+	// `user_id`, `status`, `decided_at` and `created_at` fields of the
+	// application, such as `status == PENDING`.
+	Filter        string `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListApplicationsRequest) Reset() {
+	*x = ListApplicationsRequest{}
+	mi := &file_milsimtools_applications_v1_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApplicationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApplicationsRequest) ProtoMessage() {}
+
+func (x *ListApplicationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_milsimtools_applications_v1_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApplicationsRequest.ProtoReflect.Descriptor instead.
+func (*ListApplicationsRequest) Descriptor() ([]byte, []int) {
+	return file_milsimtools_applications_v1_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListApplicationsRequest) GetUnitId() string {
+	if x != nil {
+		return x.UnitId
+	}
+	return ""
+}
+
+func (x *ListApplicationsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListApplicationsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListApplicationsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListApplicationsRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+type ListApplicationsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The applications, newest first.
+	Applications []*Application `protobuf:"bytes,1,rep,name=applications,proto3" json:"applications,omitempty"`
+	// A token, which can be sent as `page_token` to retrieve the next page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListApplicationsResponse) Reset() {
+	*x = ListApplicationsResponse{}
+	mi := &file_milsimtools_applications_v1_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApplicationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApplicationsResponse) ProtoMessage() {}
+
+func (x *ListApplicationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_milsimtools_applications_v1_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApplicationsResponse.ProtoReflect.Descriptor instead.
+func (*ListApplicationsResponse) Descriptor() ([]byte, []int) {
+	return file_milsimtools_applications_v1_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListApplicationsResponse) GetApplications() []*Application {
+	if x != nil {
+		return x.Applications
+	}
+	return nil
+}
+
+func (x *ListApplicationsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetApplicationReviewRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the unit the application was made to.
+	UnitId string `protobuf:"bytes,1,opt,name=unit_id,json=unitId,proto3" json:"unit_id,omitempty"`
+	// The ID of the application to get the review of.
+	ApplicationId string `protobuf:"bytes,2,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetApplicationReviewRequest) Reset() {
+	*x = GetApplicationReviewRequest{}
+	mi := &file_milsimtools_applications_v1_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetApplicationReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetApplicationReviewRequest) ProtoMessage() {}
+
+func (x *GetApplicationReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_milsimtools_applications_v1_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetApplicationReviewRequest.ProtoReflect.Descriptor instead.
+func (*GetApplicationReviewRequest) Descriptor() ([]byte, []int) {
+	return file_milsimtools_applications_v1_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetApplicationReviewRequest) GetUnitId() string {
+	if x != nil {
+		return x.UnitId
+	}
+	return ""
+}
+
+func (x *GetApplicationReviewRequest) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+type CreateApplicationCommentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the unit the application was made to.
+	UnitId string `protobuf:"bytes,1,opt,name=unit_id,json=unitId,proto3" json:"unit_id,omitempty"`
+	// The ID of the application to comment on.
+	ApplicationId string `protobuf:"bytes,2,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	// The comment.
+	Body          string `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateApplicationCommentRequest) Reset() {
+	*x = CreateApplicationCommentRequest{}
+	mi := &file_milsimtools_applications_v1_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateApplicationCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateApplicationCommentRequest) ProtoMessage() {}
+
+func (x *CreateApplicationCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_milsimtools_applications_v1_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateApplicationCommentRequest.ProtoReflect.Descriptor instead.
+func (*CreateApplicationCommentRequest) Descriptor() ([]byte, []int) {
+	return file_milsimtools_applications_v1_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CreateApplicationCommentRequest) GetUnitId() string {
+	if x != nil {
+		return x.UnitId
+	}
+	return ""
+}
+
+func (x *CreateApplicationCommentRequest) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+func (x *CreateApplicationCommentRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+type VoteApplicationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the unit the application was made to.
+	UnitId string `protobuf:"bytes,1,opt,name=unit_id,json=unitId,proto3" json:"unit_id,omitempty"`
+	// The ID of the application to vote on.
+	ApplicationId string `protobuf:"bytes,2,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	// The vote, which replaces any previous vote of the caller.
+	Value         ApplicationVoteValue `protobuf:"varint,3,opt,name=value,proto3,enum=milsimtools.applications.v1.ApplicationVoteValue" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VoteApplicationRequest) Reset() {
+	*x = VoteApplicationRequest{}
+	mi := &file_milsimtools_applications_v1_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VoteApplicationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoteApplicationRequest) ProtoMessage() {}
+
+func (x *VoteApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_milsimtools_applications_v1_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoteApplicationRequest.ProtoReflect.Descriptor instead.
+func (*VoteApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_milsimtools_applications_v1_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *VoteApplicationRequest) GetUnitId() string {
+	if x != nil {
+		return x.UnitId
+	}
+	return ""
+}
+
+func (x *VoteApplicationRequest) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+func (x *VoteApplicationRequest) GetValue() ApplicationVoteValue {
+	if x != nil {
+		return x.Value
+	}
+	return ApplicationVoteValue_APPLICATION_VOTE_VALUE_UNSPECIFIED
+}
+
+type AcceptApplicationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the unit the application was made to.
+	UnitId string `protobuf:"bytes,1,opt,name=unit_id,json=unitId,proto3" json:"unit_id,omitempty"`
+	// The ID of the application to accept.
+	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// The permissions the applicant is given as a member of the unit,
+	// represented as a bitmask of `milsimtools.members.v1.UnitMemberPermission`.
+	// Reviewers only grant permissions they hold, and cannot make the applicant
+	// an administrator.
+	Permissions   int32 `protobuf:"varint,3,opt,name=permissions,proto3" json:"permissions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptApplicationRequest) Reset() {
+	*x = AcceptApplicationRequest{}
+	mi := &file_milsimtools_applications_v1_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptApplicationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptApplicationRequest) ProtoMessage() {}
+
+func (x *AcceptApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_milsimtools_applications_v1_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptApplicationRequest.ProtoReflect.Descriptor instead.
+func (*AcceptApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_milsimtools_applications_v1_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *AcceptApplicationRequest) GetUnitId() string {
+	if x != nil {
+		return x.UnitId
+	}
+	return ""
+}
+
+func (x *AcceptApplicationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AcceptApplicationRequest) GetPermissions() int32 {
+	if x != nil {
+		return x.Permissions
+	}
+	return 0
+}
+
+type RejectApplicationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the unit the application was made to.
+	UnitId string `protobuf:"bytes,1,opt,name=unit_id,json=unitId,proto3" json:"unit_id,omitempty"`
+	// The ID of the application to reject.
+	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// The reason the application is rejected, shown to the applicant.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// How long the applicant must wait before applying again. The applicant can
+	// apply again straight away if unset.
+	ReapplyCooldown *durationpb.Duration `protobuf:"bytes,4,opt,name=reapply_cooldown,json=reapplyCooldown,proto3" json:"reapply_cooldown,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RejectApplicationRequest) Reset() {
+	*x = RejectApplicationRequest{}
+	mi := &file_milsimtools_applications_v1_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectApplicationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectApplicationRequest) ProtoMessage() {}
+
+func (x *RejectApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_milsimtools_applications_v1_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectApplicationRequest.ProtoReflect.Descriptor instead.
+func (*RejectApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_milsimtools_applications_v1_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RejectApplicationRequest) GetUnitId() string {
+	if x != nil {
+		return x.UnitId
+	}
+	return ""
+}
+
+func (x *RejectApplicationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RejectApplicationRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RejectApplicationRequest) GetReapplyCooldown() *durationpb.Duration {
+	if x != nil {
+		return x.ReapplyCooldown
+	}
+	return nil
+}
+
+type DeleteUnitApplicationsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the unit to delete the application form and applications of.
+	UnitId        string `protobuf:"bytes,1,opt,name=unit_id,json=unitId,proto3" json:"unit_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUnitApplicationsRequest) Reset() {
+	*x = DeleteUnitApplicationsRequest{}
+	mi := &file_milsimtools_applications_v1_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUnitApplicationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUnitApplicationsRequest) ProtoMessage() {}
+
+func (x *DeleteUnitApplicationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_milsimtools_applications_v1_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUnitApplicationsRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUnitApplicationsRequest) Descriptor() ([]byte, []int) {
+	return file_milsimtools_applications_v1_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DeleteUnitApplicationsRequest) GetUnitId() string {
+	if x != nil {
+		return x.UnitId
+	}
+	return ""
+}
+
+var File_milsimtools_applications_v1_service_proto protoreflect.FileDescriptor
+
+const file_milsimtools_applications_v1_service_proto_rawDesc = "" +
+	"\n" +
+	")milsimtools/applications/v1/service.proto\x12\x1bmilsimtools.applications.v1\x1a.milsimtools/applications/v1/applications.proto\x1a milsimtools/authz/v1/authz.proto\x1a\x1bbuf/validate/validate.proto\x1a\x1egoogle/protobuf/duration.proto\x1a google/protobuf/field_mask.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/api/annotations.proto\"<\n" +
+	"\x19GetApplicationFormRequest\x12\x1f\n" +
+	"\aunit_id\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x06unitId\"\xa5\x01\n" +
+	"\x1cUpdateApplicationFormRequest\x12H\n" +
+	"\x04form\x18\x01 \x01(\v2,.milsimtools.applications.v1.ApplicationFormB\x06\xbaH\x03\xc8\x01\x01R\x04form\x12;\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"\xb0\x01\n" +
+	"\x18SubmitApplicationRequest\x12\x1f\n" +
+	"\aunit_id\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x06unitId\x12\x1f\n" +
+	"\auser_id\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x06userId\x12R\n" +
+	"\aanswers\x18\x03 \x03(\v2..milsimtools.applications.v1.ApplicationAnswerB\b\xbaH\x05\x92\x01\x02\x102R\aanswers\"P\n" +
+	"\x15GetApplicationRequest\x12\x1f\n" +
+	"\aunit_id\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x06unitId\x12\x16\n" +
+	"\x02id\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x02id\"\xa8\x01\n" +
+	"\x17ListApplicationsRequest\x12\x17\n" +
+	"\aunit_id\x18\x01 \x01(\tR\x06unitId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12$\n" +
+	"\tpage_size\x18\x03 \x01(\x05B\a\xbaH\x04\x1a\x02\x18dR\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\x12\x16\n" +
+	"\x06filter\x18\x05 \x01(\tR\x06filter\"\x90\x01\n" +
+	"\x18ListApplicationsResponse\x12L\n" +
+	"\fapplications\x18\x01 \x03(\v2(.milsimtools.applications.v1.ApplicationR\fapplications\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"m\n" +
+	"\x1bGetApplicationReviewRequest\x12\x1f\n" +
+	"\aunit_id\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x06unitId\x12-\n" +
+	"\x0eapplication_id\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\rapplicationId\"\x91\x01\n" +
+	"\x1fCreateApplicationCommentRequest\x12\x1f\n" +
+	"\aunit_id\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x06unitId\x12-\n" +
+	"\x0eapplication_id\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\rapplicationId\x12\x1e\n" +
+	"\x04body\x18\x03 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\x88'R\x04body\"\xbd\x01\n" +
+	"\x16VoteApplicationRequest\x12\x1f\n" +
+	"\aunit_id\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x06unitId\x12-\n" +
+	"\x0eapplication_id\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\rapplicationId\x12S\n" +
+	"\x05value\x18\x03 \x01(\x0e21.milsimtools.applications.v1.ApplicationVoteValueB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\x05value\"u\n" +
+	"\x18AcceptApplicationRequest\x12\x1f\n" +
+	"\aunit_id\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x06unitId\x12\x16\n" +
+	"\x02id\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x02id\x12 \n" +
+	"\vpermissions\x18\x03 \x01(\x05R\vpermissions\"\xc7\x01\n" +
+	"\x18RejectApplicationRequest\x12\x1f\n" +
+	"\aunit_id\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x06unitId\x12\x16\n" +
+	"\x02id\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x02id\x12\"\n" +
+	"\x06reason\x18\x03 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xd0\x0fR\x06reason\x12N\n" +
+	"\x10reapply_cooldown\x18\x04 \x01(\v2\x19.google.protobuf.DurationB\b\xbaH\x05\xaa\x01\x022\x00R\x0freapplyCooldown\"@\n" +
+	"\x1dDeleteUnitApplicationsRequest\x12\x1f\n" +
+	"\aunit_id\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x06unitId2\xaa\x11\n" +
+	"\x13ApplicationsService\x12\xb2\x01\n" +
+	"\x12GetApplicationForm\x126.milsimtools.applications.v1.GetApplicationFormRequest\x1a,.milsimtools.applications.v1.ApplicationForm\"6\xc2\xf3\x18\x00\x82\xd3\xe4\x93\x02)\x12'/v1/applications/by-unit/{unit_id}/form\x90\x02\x01\x12\xd0\x01\n" +
+	"\x15UpdateApplicationForm\x129.milsimtools.applications.v1.UpdateApplicationFormRequest\x1a,.milsimtools.applications.v1.ApplicationForm\"N\xc2\xf3\x18\x10\b \x12\fform.unit_id\x82\xd3\xe4\x93\x024:\x04form2,/v1/applications/by-unit/{form.unit_id}/form\x12\xb0\x01\n" +
+	"\x11SubmitApplication\x125.milsimtools.applications.v1.SubmitApplicationRequest\x1a(.milsimtools.applications.v1.Application\":\xc2\xf3\x18\t\x1a\auser_id\x82\xd3\xe4\x93\x02':\x01*\"\"/v1/applications/by-unit/{unit_id}\x12\xb1\x01\n" +
+	"\x0eGetApplication\x122.milsimtools.applications.v1.GetApplicationRequest\x1a(.milsimtools.applications.v1.Application\"A\xc2\xf3\x18\v\b\x10\x12\aunit_id\x82\xd3\xe4\x93\x02)\x12'/v1/applications/by-unit/{unit_id}/{id}\x90\x02\x01\x12\xec\x01\n" +
+	"\x10ListApplications\x124.milsimtools.applications.v1.ListApplicationsRequest\x1a5.milsimtools.applications.v1.ListApplicationsResponse\"k\xc2\xf3\x18\x14\b\x10\x12\aunit_id\x1a\auser_id\x82\xd3\xe4\x93\x02JZ$\x12\"/v1/applications/by-user/{user_id}\x12\"/v1/applications/by-unit/{unit_id}\x90\x02\x01\x12\xd6\x01\n" +
+	"\x14GetApplicationReview\x128.milsimtools.applications.v1.GetApplicationReviewRequest\x1a..milsimtools.applications.v1.ApplicationReview\"T\xc2\xf3\x18\v\b\x10\x12\aunit_id\x82\xd3\xe4\x93\x02<\x12:/v1/applications/by-unit/{unit_id}/{application_id}/review\x90\x02\x01\x12\xe1\x01\n" +
+	"\x18CreateApplicationComment\x12<.milsimtools.applications.v1.CreateApplicationCommentRequest\x1a/.milsimtools.applications.v1.ApplicationComment\"V\xc2\xf3\x18\v\b\x10\x12\aunit_id\x82\xd3\xe4\x93\x02A:\x01*\"</v1/applications/by-unit/{unit_id}/{application_id}/comments\x12\xcb\x01\n" +
+	"\x0fVoteApplication\x123.milsimtools.applications.v1.VoteApplicationRequest\x1a,.milsimtools.applications.v1.ApplicationVote\"U\xc2\xf3\x18\v\b\x10\x12\aunit_id\x82\xd3\xe4\x93\x02=:\x01*\x1a8/v1/applications/by-unit/{unit_id}/{application_id}/vote\x90\x02\x02\x12\xbe\x01\n" +
+	"\x11AcceptApplication\x125.milsimtools.applications.v1.AcceptApplicationRequest\x1a(.milsimtools.applications.v1.Application\"H\xc2\xf3\x18\v\b \x12\aunit_id\x82\xd3\xe4\x93\x023:\x01*\"./v1/applications/by-unit/{unit_id}/{id}:accept\x12\xbe\x01\n" +
+	"\x11RejectApplication\x125.milsimtools.applications.v1.RejectApplicationRequest\x1a(.milsimtools.applications.v1.Application\"H\xc2\xf3\x18\v\b \x12\aunit_id\x82\xd3\xe4\x93\x023:\x01*\"./v1/applications/by-unit/{unit_id}/{id}:reject\x12\xa7\x01\n" +
+	"\x16DeleteUnitApplications\x12:.milsimtools.applications.v1.DeleteUnitApplicationsRequest\x1a\x16.google.protobuf.Empty\"9\xc2\xf3\x18\v\b\x01\x12\aunit_id\x82\xd3\xe4\x93\x02$*\"/v1/applications/by-unit/{unit_id}B\x94\x02\n" +
+	"\x1fcom.milsimtools.applications.v1B\fServiceProtoP\x01ZUgithub.com/milsim-tools/pincer/pkg/api/gen/milsimtools/applications/v1;applicationsv1\xa2\x02\x03MAX\xaa\x02\x1bMilsimtools.Applications.V1\xca\x02\x1bMilsimtools\\Applications\\V1\xe2\x02'Milsimtools\\Applications\\V1\\GPBMetadata\xea\x02\x1dMilsimtools::Applications::V1b\x06proto3"
+
+var (
+	file_milsimtools_applications_v1_service_proto_rawDescOnce sync.Once
+	file_milsimtools_applications_v1_service_proto_rawDescData []byte
+)
+
+func file_milsimtools_applications_v1_service_proto_rawDescGZIP() []byte {
+	file_milsimtools_applications_v1_service_proto_rawDescOnce.Do(func() {
+		file_milsimtools_applications_v1_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_milsimtools_applications_v1_service_proto_rawDesc), len(file_milsimtools_applications_v1_service_proto_rawDesc)))
+	})
+	return file_milsimtools_applications_v1_service_proto_rawDescData
+}
+
+var file_milsimtools_applications_v1_service_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_milsimtools_applications_v1_service_proto_goTypes = []any{
+	(*GetApplicationFormRequest)(nil),       // 0: milsimtools.applications.v1.GetApplicationFormRequest
+	(*UpdateApplicationFormRequest)(nil),    // 1: milsimtools.applications.v1.UpdateApplicationFormRequest
+	(*SubmitApplicationRequest)(nil),        // 2: milsimtools.applications.v1.SubmitApplicationRequest
+	(*GetApplicationRequest)(nil),           // 3: milsimtools.applications.v1.GetApplicationRequest
+	(*ListApplicationsRequest)(nil),         // 4: milsimtools.applications.v1.ListApplicationsRequest
+	(*ListApplicationsResponse)(nil),        // 5: milsimtools.applications.v1.ListApplicationsResponse
+	(*GetApplicationReviewRequest)(nil),     // 6: milsimtools.applications.v1.GetApplicationReviewRequest
+	(*CreateApplicationCommentRequest)(nil), // 7: milsimtools.applications.v1.CreateApplicationCommentRequest
+	(*VoteApplicationRequest)(nil),          // 8: milsimtools.applications.v1.VoteApplicationRequest
+	(*AcceptApplicationRequest)(nil),        // 9: milsimtools.applications.v1.AcceptApplicationRequest
+	(*RejectApplicationRequest)(nil),        // 10: milsimtools.applications.v1.RejectApplicationRequest
+	(*DeleteUnitApplicationsRequest)(nil),   // 11: milsimtools.applications.v1.DeleteUnitApplicationsRequest
+	(*ApplicationForm)(nil),                 // 12: milsimtools.applications.v1.ApplicationForm
+	(*fieldmaskpb.FieldMask)(nil),           // 13: google.protobuf.FieldMask
+	(*ApplicationAnswer)(nil),               // 14: milsimtools.applications.v1.ApplicationAnswer
+	(*Application)(nil),                     // 15: milsimtools.applications.v1.Application
+	(ApplicationVoteValue)(0),               // 16: milsimtools.applications.v1.ApplicationVoteValue
+	(*durationpb.Duration)(nil),             // 17: google.protobuf.Duration
+	(*ApplicationReview)(nil),               // 18: milsimtools.applications.v1.ApplicationReview
+	(*ApplicationComment)(nil),              // 19: milsimtools.applications.v1.ApplicationComment
+	(*ApplicationVote)(nil),                 // 20: milsimtools.applications.v1.ApplicationVote
+	(*emptypb.Empty)(nil),                   // 21: google.protobuf.Empty
+}
+var file_milsimtools_applications_v1_service_proto_depIdxs = []int32{
+	12, // 0: milsimtools.applications.v1.UpdateApplicationFormRequest.form:type_name -> milsimtools.applications.v1.ApplicationForm
+	13, // 1: milsimtools.applications.v1.UpdateApplicationFormRequest.update_mask:type_name -> google.protobuf.FieldMask
+	14, // 2: milsimtools.applications.v1.SubmitApplicationRequest.answers:type_name -> milsimtools.applications.v1.ApplicationAnswer
+	15, // 3: milsimtools.applications.v1.ListApplicationsResponse.applications:type_name -> milsimtools.applications.v1.Application
+	16, // 4: milsimtools.applications.v1.VoteApplicationRequest.value:type_name -> milsimtools.applications.v1.ApplicationVoteValue
+	17, // 5: milsimtools.applications.v1.RejectApplicationRequest.reapply_cooldown:type_name -> google.protobuf.Duration
+	0,  // 6: milsimtools.applications.v1.ApplicationsService.GetApplicationForm:input_type -> milsimtools.applications.v1.GetApplicationFormRequest
+	1,  // 7: milsimtools.applications.v1.ApplicationsService.UpdateApplicationForm:input_type -> milsimtools.applications.v1.UpdateApplicationFormRequest
+	2,  // 8: milsimtools.applications.v1.ApplicationsService.SubmitApplication:input_type -> milsimtools.applications.v1.SubmitApplicationRequest
+	3,  // 9: milsimtools.applications.v1.ApplicationsService.GetApplication:input_type -> milsimtools.applications.v1.GetApplicationRequest
+	4,  // 10: milsimtools.applications.v1.ApplicationsService.ListApplications:input_type -> milsimtools.applications.v1.ListApplicationsRequest
+	6,  // 11: milsimtools.applications.v1.ApplicationsService.GetApplicationReview:input_type -> milsimtools.applications.v1.GetApplicationReviewRequest
+	7,  // 12: milsimtools.applications.v1.ApplicationsService.CreateApplicationComment:input_type -> milsimtools.applications.v1.CreateApplicationCommentRequest
+	8,  // 13: milsimtools.applications.v1.ApplicationsService.VoteApplication:input_type -> milsimtools.applications.v1.VoteApplicationRequest
+	9,  // 14: milsimtools.applications.v1.ApplicationsService.AcceptApplication:input_type -> milsimtools.applications.v1.AcceptApplicationRequest
+	10, // 15: milsimtools.applications.v1.ApplicationsService.RejectApplication:input_type -> milsimtools.applications.v1.RejectApplicationRequest
+	11, // 16: milsimtools.applications.v1.ApplicationsService.DeleteUnitApplications:input_type -> milsimtools.applications.v1.DeleteUnitApplicationsRequest
+	12, // 17: milsimtools.applications.v1.ApplicationsService.GetApplicationForm:output_type -> milsimtools.applications.v1.ApplicationForm
+	12, // 18: milsimtools.applications.v1.ApplicationsService.UpdateApplicationForm:output_type -> milsimtools.applications.v1.ApplicationForm
+	15, // 19: milsimtools.applications.v1.ApplicationsService.SubmitApplication:output_type -> milsimtools.applications.v1.Application
+	15, // 20: milsimtools.applications.v1.ApplicationsService.GetApplication:output_type -> milsimtools.applications.v1.Application
+	5,  // 21: milsimtools.applications.v1.ApplicationsService.ListApplications:output_type -> milsimtools.applications.v1.ListApplicationsResponse
+	18, // 22: milsimtools.applications.v1.ApplicationsService.GetApplicationReview:output_type -> milsimtools.applications.v1.ApplicationReview
+	19, // 23: milsimtools.applications.v1.ApplicationsService.CreateApplicationComment:output_type -> milsimtools.applications.v1.ApplicationComment
+	20, // 24: milsimtools.applications.v1.ApplicationsService.VoteApplication:output_type -> milsimtools.applications.v1.ApplicationVote
+	15, // 25: milsimtools.applications.v1.ApplicationsService.AcceptApplication:output_type -> milsimtools.applications.v1.Application
+	15, // 26: milsimtools.applications.v1.ApplicationsService.RejectApplication:output_type -> milsimtools.applications.v1.Application
+	21, // 27: milsimtools.applications.v1.ApplicationsService.DeleteUnitApplications:output_type -> google.protobuf.Empty
+	17, // [17:28] is the sub-list for method output_type
+	6,  // [6:17] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_milsimtools_applications_v1_service_proto_init() }
+func file_milsimtools_applications_v1_service_proto_init() {
+	if File_milsimtools_applications_v1_service_proto != nil {
+		return
+	}
+	file_milsimtools_applications_v1_applications_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_milsimtools_applications_v1_service_proto_rawDesc), len(file_milsimtools_applications_v1_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_milsimtools_applications_v1_service_proto_goTypes,
+		DependencyIndexes: file_milsimtools_applications_v1_service_proto_depIdxs,
+		MessageInfos:      file_milsimtools_applications_v1_service_proto_msgTypes,
+	}.Build()
+	File_milsimtools_applications_v1_service_proto = out.File
+	file_milsimtools_applications_v1_service_proto_goTypes = nil
+	file_milsimtools_applications_v1_service_proto_depIdxs = nil
+}
@@ -0,0 +1,528 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: milsimtools/applications/v1/service.proto
+
+package applicationsv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ApplicationsService_GetApplicationForm_FullMethodName       = "/milsimtools.applications.v1.ApplicationsService/GetApplicationForm"
+	ApplicationsService_UpdateApplicationForm_FullMethodName    = "/milsimtools.applications.v1.ApplicationsService/UpdateApplicationForm"
+	ApplicationsService_SubmitApplication_FullMethodName        = "/milsimtools.applications.v1.ApplicationsService/SubmitApplication"
+	ApplicationsService_GetApplication_FullMethodName           = "/milsimtools.applications.v1.ApplicationsService/GetApplication"
+	ApplicationsService_ListApplications_FullMethodName         = "/milsimtools.applications.v1.ApplicationsService/ListApplications"
+	ApplicationsService_GetApplicationReview_FullMethodName     = "/milsimtools.applications.v1.ApplicationsService/GetApplicationReview"
+	ApplicationsService_CreateApplicationComment_FullMethodName = "/milsimtools.applications.v1.ApplicationsService/CreateApplicationComment"
+	ApplicationsService_VoteApplication_FullMethodName          = "/milsimtools.applications.v1.ApplicationsService/VoteApplication"
+	ApplicationsService_AcceptApplication_FullMethodName        = "/milsimtools.applications.v1.ApplicationsService/AcceptApplication"
+	ApplicationsService_RejectApplication_FullMethodName        = "/milsimtools.applications.v1.ApplicationsService/RejectApplication"
+	ApplicationsService_DeleteUnitApplications_FullMethodName   = "/milsimtools.applications.v1.ApplicationsService/DeleteUnitApplications"
+)
+
+// ApplicationsServiceClient is the client API for ApplicationsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ApplicationsServiceClient interface {
+	// Gets the application form of a unit.
+	GetApplicationForm(ctx context.Context, in *GetApplicationFormRequest, opts ...grpc.CallOption) (*ApplicationForm, error)
+	// Updates the application form of a unit, creating it if needed.
+	UpdateApplicationForm(ctx context.Context, in *UpdateApplicationFormRequest, opts ...grpc.CallOption) (*ApplicationForm, error)
+	// Applies to a unit, answering its application form.
+	SubmitApplication(ctx context.Context, in *SubmitApplicationRequest, opts ...grpc.CallOption) (*Application, error)
+	// Gets an application.
+	GetApplication(ctx context.Context, in *GetApplicationRequest, opts ...grpc.CallOption) (*Application, error)
+	// Lists applications, to a unit or of a user.
+	ListApplications(ctx context.Context, in *ListApplicationsRequest, opts ...grpc.CallOption) (*ListApplicationsResponse, error)
+	// Gets the reviewers' comments and votes on an application.
+	GetApplicationReview(ctx context.Context, in *GetApplicationReviewRequest, opts ...grpc.CallOption) (*ApplicationReview, error)
+	// Comments on an application, as the caller.
+	CreateApplicationComment(ctx context.Context, in *CreateApplicationCommentRequest, opts ...grpc.CallOption) (*ApplicationComment, error)
+	// Votes on a pending application, as the caller.
+	VoteApplication(ctx context.Context, in *VoteApplicationRequest, opts ...grpc.CallOption) (*ApplicationVote, error)
+	// Accepts a pending application, making the applicant an approved member
+	// of the unit.
+	AcceptApplication(ctx context.Context, in *AcceptApplicationRequest, opts ...grpc.CallOption) (*Application, error)
+	// Rejects a pending application.
+	RejectApplication(ctx context.Context, in *RejectApplicationRequest, opts ...grpc.CallOption) (*Application, error)
+	// Deletes the application form and applications of a unit, when the unit
+	// is deleted.
+	DeleteUnitApplications(ctx context.Context, in *DeleteUnitApplicationsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type applicationsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewApplicationsServiceClient(cc grpc.ClientConnInterface) ApplicationsServiceClient {
+	return &applicationsServiceClient{cc}
+}
+
+func (c *applicationsServiceClient) GetApplicationForm(ctx context.Context, in *GetApplicationFormRequest, opts ...grpc.CallOption) (*ApplicationForm, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApplicationForm)
+	err := c.cc.Invoke(ctx, ApplicationsService_GetApplicationForm_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationsServiceClient) UpdateApplicationForm(ctx context.Context, in *UpdateApplicationFormRequest, opts ...grpc.CallOption) (*ApplicationForm, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApplicationForm)
+	err := c.cc.Invoke(ctx, ApplicationsService_UpdateApplicationForm_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationsServiceClient) SubmitApplication(ctx context.Context, in *SubmitApplicationRequest, opts ...grpc.CallOption) (*Application, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Application)
+	err := c.cc.Invoke(ctx, ApplicationsService_SubmitApplication_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationsServiceClient) GetApplication(ctx context.Context, in *GetApplicationRequest, opts ...grpc.CallOption) (*Application, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Application)
+	err := c.cc.Invoke(ctx, ApplicationsService_GetApplication_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationsServiceClient) ListApplications(ctx context.Context, in *ListApplicationsRequest, opts ...grpc.CallOption) (*ListApplicationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListApplicationsResponse)
+	err := c.cc.Invoke(ctx, ApplicationsService_ListApplications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationsServiceClient) GetApplicationReview(ctx context.Context, in *GetApplicationReviewRequest, opts ...grpc.CallOption) (*ApplicationReview, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApplicationReview)
+	err := c.cc.Invoke(ctx, ApplicationsService_GetApplicationReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationsServiceClient) CreateApplicationComment(ctx context.Context, in *CreateApplicationCommentRequest, opts ...grpc.CallOption) (*ApplicationComment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApplicationComment)
+	err := c.cc.Invoke(ctx, ApplicationsService_CreateApplicationComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationsServiceClient) VoteApplication(ctx context.Context, in *VoteApplicationRequest, opts ...grpc.CallOption) (*ApplicationVote, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApplicationVote)
+	err := c.cc.Invoke(ctx, ApplicationsService_VoteApplication_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationsServiceClient) AcceptApplication(ctx context.Context, in *AcceptApplicationRequest, opts ...grpc.CallOption) (*Application, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Application)
+	err := c.cc.Invoke(ctx, ApplicationsService_AcceptApplication_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationsServiceClient) RejectApplication(ctx context.Context, in *RejectApplicationRequest, opts ...grpc.CallOption) (*Application, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Application)
+	err := c.cc.Invoke(ctx, ApplicationsService_RejectApplication_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationsServiceClient) DeleteUnitApplications(ctx context.Context, in *DeleteUnitApplicationsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ApplicationsService_DeleteUnitApplications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ApplicationsServiceServer is the server API for ApplicationsService service.
+// All implementations must embed UnimplementedApplicationsServiceServer
+// for forward compatibility.
+type ApplicationsServiceServer interface {
+	// Gets the application form of a unit.
+	GetApplicationForm(context.Context, *GetApplicationFormRequest) (*ApplicationForm, error)
+	// Updates the application form of a unit, creating it if needed.
+	UpdateApplicationForm(context.Context, *UpdateApplicationFormRequest) (*ApplicationForm, error)
+	// Applies to a unit, answering its application form.
+	SubmitApplication(context.Context, *SubmitApplicationRequest) (*Application, error)
+	// Gets an application.
+	GetApplication(context.Context, *GetApplicationRequest) (*Application, error)
+	// Lists applications, to a unit or of a user.
+	ListApplications(context.Context, *ListApplicationsRequest) (*ListApplicationsResponse, error)
+	// Gets the reviewers' comments and votes on an application.
+	GetApplicationReview(context.Context, *GetApplicationReviewRequest) (*ApplicationReview, error)
+	// Comments on an application, as the caller.
+	CreateApplicationComment(context.Context, *CreateApplicationCommentRequest) (*ApplicationComment, error)
+	// Votes on a pending application, as the caller.
+	VoteApplication(context.Context, *VoteApplicationRequest) (*ApplicationVote, error)
+	// Accepts a pending application, making the applicant an approved member
+	// of the unit.
+	AcceptApplication(context.Context, *AcceptApplicationRequest) (*Application, error)
+	// Rejects a pending application.
+	RejectApplication(context.Context, *RejectApplicationRequest) (*Application, error)
+	// Deletes the application form and applications of a unit, when the unit
+	// is deleted.
+	DeleteUnitApplications(context.Context, *DeleteUnitApplicationsRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedApplicationsServiceServer()
+}
+
+// UnimplementedApplicationsServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedApplicationsServiceServer struct{}
+
+func (UnimplementedApplicationsServiceServer) GetApplicationForm(context.Context, *GetApplicationFormRequest) (*ApplicationForm, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetApplicationForm not implemented")
+}
+func (UnimplementedApplicationsServiceServer) UpdateApplicationForm(context.Context, *UpdateApplicationFormRequest) (*ApplicationForm, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateApplicationForm not implemented")
+}
+func (UnimplementedApplicationsServiceServer) SubmitApplication(context.Context, *SubmitApplicationRequest) (*Application, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitApplication not implemented")
+}
+func (UnimplementedApplicationsServiceServer) GetApplication(context.Context, *GetApplicationRequest) (*Application, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetApplication not implemented")
+}
+func (UnimplementedApplicationsServiceServer) ListApplications(context.Context, *ListApplicationsRequest) (*ListApplicationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListApplications not implemented")
+}
+func (UnimplementedApplicationsServiceServer) GetApplicationReview(context.Context, *GetApplicationReviewRequest) (*ApplicationReview, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetApplicationReview not implemented")
+}
+func (UnimplementedApplicationsServiceServer) CreateApplicationComment(context.Context, *CreateApplicationCommentRequest) (*ApplicationComment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateApplicationComment not implemented")
+}
+func (UnimplementedApplicationsServiceServer) VoteApplication(context.Context, *VoteApplicationRequest) (*ApplicationVote, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteApplication not implemented")
+}
+func (UnimplementedApplicationsServiceServer) AcceptApplication(context.Context, *AcceptApplicationRequest) (*Application, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptApplication not implemented")
+}
+func (UnimplementedApplicationsServiceServer) RejectApplication(context.Context, *RejectApplicationRequest) (*Application, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectApplication not implemented")
+}
+func (UnimplementedApplicationsServiceServer) DeleteUnitApplications(context.Context, *DeleteUnitApplicationsRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUnitApplications not implemented")
+}
+func (UnimplementedApplicationsServiceServer) mustEmbedUnimplementedApplicationsServiceServer() {}
+func (UnimplementedApplicationsServiceServer) testEmbeddedByValue()                             {}
+
+// UnsafeApplicationsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ApplicationsServiceServer will
+// result in compilation errors.
+type UnsafeApplicationsServiceServer interface {
+	mustEmbedUnimplementedApplicationsServiceServer()
+}
+
+func RegisterApplicationsServiceServer(s grpc.ServiceRegistrar, srv ApplicationsServiceServer) {
+	// If the following call pancis, it indicates UnimplementedApplicationsServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ApplicationsService_ServiceDesc, srv)
+}
+
+func _ApplicationsService_GetApplicationForm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetApplicationFormRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationsServiceServer).GetApplicationForm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApplicationsService_GetApplicationForm_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationsServiceServer).GetApplicationForm(ctx, req.(*GetApplicationFormRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationsService_UpdateApplicationForm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateApplicationFormRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationsServiceServer).UpdateApplicationForm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApplicationsService_UpdateApplicationForm_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationsServiceServer).UpdateApplicationForm(ctx, req.(*UpdateApplicationFormRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationsService_SubmitApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationsServiceServer).SubmitApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApplicationsService_SubmitApplication_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationsServiceServer).SubmitApplication(ctx, req.(*SubmitApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationsService_GetApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationsServiceServer).GetApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApplicationsService_GetApplication_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationsServiceServer).GetApplication(ctx, req.(*GetApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationsService_ListApplications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListApplicationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationsServiceServer).ListApplications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApplicationsService_ListApplications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationsServiceServer).ListApplications(ctx, req.(*ListApplicationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationsService_GetApplicationReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetApplicationReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationsServiceServer).GetApplicationReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApplicationsService_GetApplicationReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationsServiceServer).GetApplicationReview(ctx, req.(*GetApplicationReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationsService_CreateApplicationComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateApplicationCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationsServiceServer).CreateApplicationComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApplicationsService_CreateApplicationComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationsServiceServer).CreateApplicationComment(ctx, req.(*CreateApplicationCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationsService_VoteApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoteApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationsServiceServer).VoteApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApplicationsService_VoteApplication_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationsServiceServer).VoteApplication(ctx, req.(*VoteApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationsService_AcceptApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationsServiceServer).AcceptApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApplicationsService_AcceptApplication_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationsServiceServer).AcceptApplication(ctx, req.(*AcceptApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationsService_RejectApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationsServiceServer).RejectApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApplicationsService_RejectApplication_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationsServiceServer).RejectApplication(ctx, req.(*RejectApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationsService_DeleteUnitApplications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUnitApplicationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationsServiceServer).DeleteUnitApplications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApplicationsService_DeleteUnitApplications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationsServiceServer).DeleteUnitApplications(ctx, req.(*DeleteUnitApplicationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ApplicationsService_ServiceDesc is the grpc.ServiceDesc for ApplicationsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ApplicationsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "milsimtools.applications.v1.ApplicationsService",
+	HandlerType: (*ApplicationsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetApplicationForm",
+			Handler:    _ApplicationsService_GetApplicationForm_Handler,
+		},
+		{
+			MethodName: "UpdateApplicationForm",
+			Handler:    _ApplicationsService_UpdateApplicationForm_Handler,
+		},
+		{
+			MethodName: "SubmitApplication",
+			Handler:    _ApplicationsService_SubmitApplication_Handler,
+		},
+		{
+			MethodName: "GetApplication",
+			Handler:    _ApplicationsService_GetApplication_Handler,
+		},
+		{
+			MethodName: "ListApplications",
+			Handler:    _ApplicationsService_ListApplications_Handler,
+		},
+		{
+			MethodName: "GetApplicationReview",
+			Handler:    _ApplicationsService_GetApplicationReview_Handler,
+		},
+		{
+			MethodName: "CreateApplicationComment",
+			Handler:    _ApplicationsService_CreateApplicationComment_Handler,
+		},
+		{
+			MethodName: "VoteApplication",
+			Handler:    _ApplicationsService_VoteApplication_Handler,
+		},
+		{
+			MethodName: "AcceptApplication",
+			Handler:    _ApplicationsService_AcceptApplication_Handler,
+		},
+		{
+			MethodName: "RejectApplication",
+			Handler:    _ApplicationsService_RejectApplication_Handler,
+		},
+		{
+			MethodName: "DeleteUnitApplications",
+			Handler:    _ApplicationsService_DeleteUnitApplications_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "milsimtools/applications/v1/service.proto",
+}
@@ -0,0 +1,296 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: milsimtools/authz/v1/authz.proto
+
+package authzv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	descriptorpb "google.golang.org/protobuf/types/descriptorpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// A permission a member can hold in a unit. The values match the bits of
+// `milsimtools.members.v1.UnitMemberPermission`.
+type Permission int32
+
+const (
+	Permission_PERMISSION_UNSPECIFIED Permission = 0
+	// Full access to the unit, including managing members and settings.
+	Permission_PERMISSION_ADMINISTRATOR Permission = 1
+	// Can view the member list.
+	Permission_PERMISSION_VIEW_MEMBERS Permission = 4
+	// Can manage members, including inviting, removing, and changing permissions.
+	Permission_PERMISSION_MANAGE_MEMBERS Permission = 8
+	// Can view and manage applications to join the unit.
+	Permission_PERMISSION_VIEW_APPLICATIONS Permission = 16
+	// Can manage applications to join the unit.
+	Permission_PERMISSION_MANAGE_APPLICATIONS Permission = 32
+	// Can view events.
+	Permission_PERMISSION_VIEW_EVENTS Permission = 64
+	// Can respond to event invitations.
+	Permission_PERMISSION_RESPOND_EVENTS Permission = 128
+	// Can manage events, including creating, updating, and deleting events.
+	Permission_PERMISSION_MANAGE_EVENTS Permission = 256
+	// Can view sections.
+	Permission_PERMISSION_VIEW_SECTIONS Permission = 512
+	// Can manage sections, including creating, updating, and deleting sections.
+	Permission_PERMISSION_MANAGE_SECTIONS Permission = 1024
+)
+
+// Enum value maps for Permission.
+var (
+	Permission_name = map[int32]string{
+		0:    "PERMISSION_UNSPECIFIED",
+		1:    "PERMISSION_ADMINISTRATOR",
+		4:    "PERMISSION_VIEW_MEMBERS",
+		8:    "PERMISSION_MANAGE_MEMBERS",
+		16:   "PERMISSION_VIEW_APPLICATIONS",
+		32:   "PERMISSION_MANAGE_APPLICATIONS",
+		64:   "PERMISSION_VIEW_EVENTS",
+		128:  "PERMISSION_RESPOND_EVENTS",
+		256:  "PERMISSION_MANAGE_EVENTS",
+		512:  "PERMISSION_VIEW_SECTIONS",
+		1024: "PERMISSION_MANAGE_SECTIONS",
+	}
+	Permission_value = map[string]int32{
+		"PERMISSION_UNSPECIFIED":         0,
+		"PERMISSION_ADMINISTRATOR":       1,
+		"PERMISSION_VIEW_MEMBERS":        4,
+		"PERMISSION_MANAGE_MEMBERS":      8,
+		"PERMISSION_VIEW_APPLICATIONS":   16,
+		"PERMISSION_MANAGE_APPLICATIONS": 32,
+		"PERMISSION_VIEW_EVENTS":         64,
+		"PERMISSION_RESPOND_EVENTS":      128,
+		"PERMISSION_MANAGE_EVENTS":       256,
+		"PERMISSION_VIEW_SECTIONS":       512,
+		"PERMISSION_MANAGE_SECTIONS":     1024,
+	}
+)
+
+func (x Permission) Enum() *Permission {
+	p := new(Permission)
+	*p = x
+	return p
+}
+
+func (x Permission) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Permission) Descriptor() protoreflect.EnumDescriptor {
+	return file_milsimtools_authz_v1_authz_proto_enumTypes[0].Descriptor()
+}
+
+func (Permission) Type() protoreflect.EnumType {
+	return &file_milsimtools_authz_v1_authz_proto_enumTypes[0]
+}
+
+func (x Permission) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Permission.Descriptor instead.
+func (Permission) EnumDescriptor() ([]byte, []int) {
+	return file_milsimtools_authz_v1_authz_proto_rawDescGZIP(), []int{0}
+}
+
+// The authorization rule for an RPC.
+//
+// An empty rule allows any authenticated caller. Every RPC of a Pincer service
+// must declare a rule, calls to RPCs without one are denied.
+type Rule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The permission the caller must hold in the unit identified by
+	// `unit_field`. Unit owners and administrators hold every permission, banned
+	// members hold none.
+	Permission Permission `protobuf:"varint,1,opt,name=permission,proto3,enum=milsimtools.authz.v1.Permission" json:"permission,omitempty"`
+	// The path of the request field holding the ID of the unit the RPC acts on,
+	// e.g. `member.unit_id`.
+	UnitField string `protobuf:"bytes,2,opt,name=unit_field,json=unitField,proto3" json:"unit_field,omitempty"`
+	// The path of a request field holding a user ID. Callers acting on their
+	// own user are allowed regardless of `permission`. If `permission` is not
+	// set, only that user is allowed.
+	SelfField string `protobuf:"bytes,3,opt,name=self_field,json=selfField,proto3" json:"self_field,omitempty"`
+	// The path of a request field holding a token subject. Only callers who
+	// authenticated as that subject are allowed.
+	SubjectField  string `protobuf:"bytes,4,opt,name=subject_field,json=subjectField,proto3" json:"subject_field,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Rule) Reset() {
+	*x = Rule{}
+	mi := &file_milsimtools_authz_v1_authz_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Rule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rule) ProtoMessage() {}
+
+func (x *Rule) ProtoReflect() protoreflect.Message {
+	mi := &file_milsimtools_authz_v1_authz_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rule.ProtoReflect.Descriptor instead.
+func (*Rule) Descriptor() ([]byte, []int) {
+	return file_milsimtools_authz_v1_authz_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Rule) GetPermission() Permission {
+	if x != nil {
+		return x.Permission
+	}
+	return Permission_PERMISSION_UNSPECIFIED
+}
+
+func (x *Rule) GetUnitField() string {
+	if x != nil {
+		return x.UnitField
+	}
+	return ""
+}
+
+func (x *Rule) GetSelfField() string {
+	if x != nil {
+		return x.SelfField
+	}
+	return ""
+}
+
+func (x *Rule) GetSubjectField() string {
+	if x != nil {
+		return x.SubjectField
+	}
+	return ""
+}
+
+var file_milsimtools_authz_v1_authz_proto_extTypes = []protoimpl.ExtensionInfo{
+	{
+		ExtendedType:  (*descriptorpb.MethodOptions)(nil),
+		ExtensionType: (*Rule)(nil),
+		Field:         51000,
+		Name:          "milsimtools.authz.v1.rule",
+		Tag:           "bytes,51000,opt,name=rule",
+		Filename:      "milsimtools/authz/v1/authz.proto",
+	},
+}
+
+// Extension fields to descriptorpb.MethodOptions.
+var (
+	// The authorization rule for the RPC.
+	//
+	// optional milsimtools.authz.v1.Rule rule = 51000;
+	E_Rule = &file_milsimtools_authz_v1_authz_proto_extTypes[0]
+)
+
+var File_milsimtools_authz_v1_authz_proto protoreflect.FileDescriptor
+
+const file_milsimtools_authz_v1_authz_proto_rawDesc = "" +
+	"\n" +
+	" milsimtools/authz/v1/authz.proto\x12\x14milsimtools.authz.v1\x1a google/protobuf/descriptor.proto\"\xab\x01\n" +
+	"\x04Rule\x12@\n" +
+	"\n" +
+	"permission\x18\x01 \x01(\x0e2 .milsimtools.authz.v1.PermissionR\n" +
+	"permission\x12\x1d\n" +
+	"\n" +
+	"unit_field\x18\x02 \x01(\tR\tunitField\x12\x1d\n" +
+	"\n" +
+	"self_field\x18\x03 \x01(\tR\tselfField\x12#\n" +
+	"\rsubject_field\x18\x04 \x01(\tR\fsubjectField*\xe3\x02\n" +
+	"\n" +
+	"Permission\x12\x1a\n" +
+	"\x16PERMISSION_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18PERMISSION_ADMINISTRATOR\x10\x01\x12\x1b\n" +
+	"\x17PERMISSION_VIEW_MEMBERS\x10\x04\x12\x1d\n" +
+	"\x19PERMISSION_MANAGE_MEMBERS\x10\b\x12 \n" +
+	"\x1cPERMISSION_VIEW_APPLICATIONS\x10\x10\x12\"\n" +
+	"\x1ePERMISSION_MANAGE_APPLICATIONS\x10 \x12\x1a\n" +
+	"\x16PERMISSION_VIEW_EVENTS\x10@\x12\x1e\n" +
+	"\x19PERMISSION_RESPOND_EVENTS\x10\x80\x01\x12\x1d\n" +
+	"\x18PERMISSION_MANAGE_EVENTS\x10\x80\x02\x12\x1d\n" +
+	"\x18PERMISSION_VIEW_SECTIONS\x10\x80\x04\x12\x1f\n" +
+	"\x1aPERMISSION_MANAGE_SECTIONS\x10\x80\b:P\n" +
+	"\x04rule\x12\x1e.google.protobuf.MethodOptions\x18\xb8\x8e\x03 \x01(\v2\x1a.milsimtools.authz.v1.RuleR\x04ruleB\xe1\x01\n" +
+	"\x18com.milsimtools.authz.v1B\n" +
+	"AuthzProtoP\x01ZGgithub.com/milsim-tools/pincer/pkg/api/gen/milsimtools/authz/v1;authzv1\xa2\x02\x03MAX\xaa\x02\x14Milsimtools.Authz.V1\xca\x02\x14Milsimtools\\Authz\\V1\xe2\x02 Milsimtools\\Authz\\V1\\GPBMetadata\xea\x02\x16Milsimtools::Authz::V1b\x06proto3"
+
+var (
+	file_milsimtools_authz_v1_authz_proto_rawDescOnce sync.Once
+	file_milsimtools_authz_v1_authz_proto_rawDescData []byte
+)
+
+func file_milsimtools_authz_v1_authz_proto_rawDescGZIP() []byte {
+	file_milsimtools_authz_v1_authz_proto_rawDescOnce.Do(func() {
+		file_milsimtools_authz_v1_authz_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_milsimtools_authz_v1_authz_proto_rawDesc), len(file_milsimtools_authz_v1_authz_proto_rawDesc)))
+	})
+	return file_milsimtools_authz_v1_authz_proto_rawDescData
+}
+
+var file_milsimtools_authz_v1_authz_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_milsimtools_authz_v1_authz_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_milsimtools_authz_v1_authz_proto_goTypes = []any{
+	(Permission)(0),                    // 0: milsimtools.authz.v1.Permission
+	(*Rule)(nil),                       // 1: milsimtools.authz.v1.Rule
+	(*descriptorpb.MethodOptions)(nil), // 2: google.protobuf.MethodOptions
+}
+var file_milsimtools_authz_v1_authz_proto_depIdxs = []int32{
+	0, // 0: milsimtools.authz.v1.Rule.permission:type_name -> milsimtools.authz.v1.Permission
+	2, // 1: milsimtools.authz.v1.rule:extendee -> google.protobuf.MethodOptions
+	1, // 2: milsimtools.authz.v1.rule:type_name -> milsimtools.authz.v1.Rule
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	2, // [2:3] is the sub-list for extension type_name
+	1, // [1:2] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_milsimtools_authz_v1_authz_proto_init() }
+func file_milsimtools_authz_v1_authz_proto_init() {
+	if File_milsimtools_authz_v1_authz_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_milsimtools_authz_v1_authz_proto_rawDesc), len(file_milsimtools_authz_v1_authz_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 1,
+			NumServices:   0,
+		},
+		GoTypes:           file_milsimtools_authz_v1_authz_proto_goTypes,
+		DependencyIndexes: file_milsimtools_authz_v1_authz_proto_depIdxs,
+		EnumInfos:         file_milsimtools_authz_v1_authz_proto_enumTypes,
+		MessageInfos:      file_milsimtools_authz_v1_authz_proto_msgTypes,
+		ExtensionInfos:    file_milsimtools_authz_v1_authz_proto_extTypes,
+	}.Build()
+	File_milsimtools_authz_v1_authz_proto = out.File
+	file_milsimtools_authz_v1_authz_proto_goTypes = nil
+	file_milsimtools_authz_v1_authz_proto_depIdxs = nil
+}
@@ -0,0 +1,1325 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: milsimtools/events/v1/events.proto
+
+package eventsv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Who can see an event.
+type EventVisibility int32
+
+const (
+	EventVisibility_EVENT_VISIBILITY_UNSPECIFIED EventVisibility = 0
+	// Only members of the unit who can view events see the event.
+	EventVisibility_EVENT_VISIBILITY_UNIT EventVisibility = 1
+	// Anyone signed in sees the event, without its game server details.
+	EventVisibility_EVENT_VISIBILITY_PUBLIC EventVisibility = 2
+)
+
+// Enum value maps for EventVisibility.
+var (
+	EventVisibility_name = map[int32]string{
+		0: "EVENT_VISIBILITY_UNSPECIFIED",
+		1: "EVENT_VISIBILITY_UNIT",
+		2: "EVENT_VISIBILITY_PUBLIC",
+	}
+	EventVisibility_value = map[string]int32{
+		"EVENT_VISIBILITY_UNSPECIFIED": 0,
+		"EVENT_VISIBILITY_UNIT":        1,
+		"EVENT_VISIBILITY_PUBLIC":      2,
+	}
+)
+
+func (x EventVisibility) Enum() *EventVisibility {
+	p := new(EventVisibility)
+	*p = x
+	return p
+}
+
+func (x EventVisibility) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EventVisibility) Descriptor() protoreflect.EnumDescriptor {
+	return file_milsimtools_events_v1_events_proto_enumTypes[0].Descriptor()
+}
+
+func (EventVisibility) Type() protoreflect.EnumType {
+	return &file_milsimtools_events_v1_events_proto_enumTypes[0]
+}
+
+func (x EventVisibility) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EventVisibility.Descriptor instead.
+func (EventVisibility) EnumDescriptor() ([]byte, []int) {
+	return file_milsimtools_events_v1_events_proto_rawDescGZIP(), []int{0}
+}
+
+// A member's response to an event.
+type EventResponseStatus int32
+
+const (
+	EventResponseStatus_EVENT_RESPONSE_STATUS_UNSPECIFIED EventResponseStatus = 0
+	// The member will attend.
+	EventResponseStatus_EVENT_RESPONSE_STATUS_ATTENDING EventResponseStatus = 1
+	// The member might attend.
+	EventResponseStatus_EVENT_RESPONSE_STATUS_MAYBE EventResponseStatus = 2
+	// The member will not attend.
+	EventResponseStatus_EVENT_RESPONSE_STATUS_DECLINED EventResponseStatus = 3
+)
+
+// Enum value maps for EventResponseStatus.
+var (
+	EventResponseStatus_name = map[int32]string{
+		0: "EVENT_RESPONSE_STATUS_UNSPECIFIED",
+		1: "EVENT_RESPONSE_STATUS_ATTENDING",
+		2: "EVENT_RESPONSE_STATUS_MAYBE",
+		3: "EVENT_RESPONSE_STATUS_DECLINED",
+	}
+	EventResponseStatus_value = map[string]int32{
+		"EVENT_RESPONSE_STATUS_UNSPECIFIED": 0,
+		"EVENT_RESPONSE_STATUS_ATTENDING":   1,
+		"EVENT_RESPONSE_STATUS_MAYBE":       2,
+		"EVENT_RESPONSE_STATUS_DECLINED":    3,
+	}
+)
+
+func (x EventResponseStatus) Enum() *EventResponseStatus {
+	p := new(EventResponseStatus)
+	*p = x
+	return p
+}
+
+func (x EventResponseStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EventResponseStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_milsimtools_events_v1_events_proto_enumTypes[1].Descriptor()
+}
+
+func (EventResponseStatus) Type() protoreflect.EnumType {
+	return &file_milsimtools_events_v1_events_proto_enumTypes[1]
+}
+
+func (x EventResponseStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EventResponseStatus.Descriptor instead.
+func (EventResponseStatus) EnumDescriptor() ([]byte, []int) {
+	return file_milsimtools_events_v1_events_proto_rawDescGZIP(), []int{1}
+}
+
+// Whether a member showed up to an event.
+type AttendanceStatus int32
+
+const (
+	AttendanceStatus_ATTENDANCE_STATUS_UNSPECIFIED AttendanceStatus = 0
+	// The member attended.
+	AttendanceStatus_ATTENDANCE_STATUS_PRESENT AttendanceStatus = 1
+	// The member attended, but arrived late.
+	AttendanceStatus_ATTENDANCE_STATUS_LATE AttendanceStatus = 2
+	// The member did not attend, with leave.
+	AttendanceStatus_ATTENDANCE_STATUS_EXCUSED AttendanceStatus = 3
+	// The member did not attend, without leave.
+	AttendanceStatus_ATTENDANCE_STATUS_AWOL AttendanceStatus = 4
+)
+
+// Enum value maps for AttendanceStatus.
+var (
+	AttendanceStatus_name = map[int32]string{
+		0: "ATTENDANCE_STATUS_UNSPECIFIED",
+		1: "ATTENDANCE_STATUS_PRESENT",
+		2: "ATTENDANCE_STATUS_LATE",
+		3: "ATTENDANCE_STATUS_EXCUSED",
+		4: "ATTENDANCE_STATUS_AWOL",
+	}
+	AttendanceStatus_value = map[string]int32{
+		"ATTENDANCE_STATUS_UNSPECIFIED": 0,
+		"ATTENDANCE_STATUS_PRESENT":     1,
+		"ATTENDANCE_STATUS_LATE":        2,
+		"ATTENDANCE_STATUS_EXCUSED":     3,
+		"ATTENDANCE_STATUS_AWOL":        4,
+	}
+)
+
+func (x AttendanceStatus) Enum() *AttendanceStatus {
+	p := new(AttendanceStatus)
+	*p = x
+	return p
+}
+
+func (x AttendanceStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AttendanceStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_milsimtools_events_v1_events_proto_enumTypes[2].Descriptor()
+}
+
+func (AttendanceStatus) Type() protoreflect.EnumType {
+	return &file_milsimtools_events_v1_events_proto_enumTypes[2]
+}
+
+func (x AttendanceStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AttendanceStatus.Descriptor instead.
+func (AttendanceStatus) EnumDescriptor() ([]byte, []int) {
+	return file_milsimtools_events_v1_events_proto_rawDescGZIP(), []int{2}
+}
+
+// The game server an event is played on.
+type EventServer struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The game played, such as `Arma 3`.
+	Game string `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`
+	// The host name or IP address of the server.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// The port of the server.
+	Port uint32 `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
+	// The password of the server.
+	Password string `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	// The mods to load, such as a link to a mod preset.
+	Modset        string `protobuf:"bytes,5,opt,name=modset,proto3" json:"modset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventServer) Reset() {
+	*x = EventServer{}
+	mi := &file_milsimtools_events_v1_events_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventServer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventServer) ProtoMessage() {}
+
+func (x *EventServer) ProtoReflect() protoreflect.Message {
+	mi := &file_milsimtools_events_v1_events_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventServer.ProtoReflect.Descriptor instead.
+func (*EventServer) Descriptor() ([]byte, []int) {
+	return file_milsimtools_events_v1_events_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EventServer) GetGame() string {
+	if x != nil {
+		return x.Game
+	}
+	return ""
+}
+
+func (x *EventServer) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *EventServer) GetPort() uint32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *EventServer) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *EventServer) GetModset() string {
+	if x != nil {
+		return x.Modset
+	}
+	return ""
+}
+
+// A scheduled event of a unit, such as an operation or a training.
+type Event struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the event, represented as a ULID.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The ID of the unit holding the event, represented as a ULID.
+	UnitId string `protobuf:"bytes,2,opt,name=unit_id,json=unitId,proto3" json:"unit_id,omitempty"`
+	// The title of the event.
+	Title string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	// The briefing of the event, in Markdown.
+	Briefing string `protobuf:"bytes,4,opt,name=briefing,proto3" json:"briefing,omitempty"`
+	// The time the event starts.
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// The time the event ends, after it starts.
+	EndTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// The IANA time zone the event is scheduled in, such as `Europe/London`.
+	// UTC if unset.
+	TimeZone string `protobuf:"bytes,7,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	// Who can see the event. Events are only visible to the unit if unset.
+	Visibility EventVisibility `protobuf:"varint,8,opt,name=visibility,proto3,enum=milsimtools.events.v1.EventVisibility" json:"visibility,omitempty"`
+	// The game server the event is played on. It is left out for callers who
+	// cannot view the unit's events.
+	Server *EventServer `protobuf:"bytes,9,opt,name=server,proto3" json:"server,omitempty"`
+	// The ID of the user who created the event.
+	CreatedBy string `protobuf:"bytes,10,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	// The time the event was created.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// The last time the event was updated.
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// How the event repeats. Unset for events that take place once.
+	Recurrence    *EventRecurrence `protobuf:"bytes,13,opt,name=recurrence,proto3" json:"recurrence,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	mi := &file_milsimtools_events_v1_events_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_milsimtools_events_v1_events_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_milsimtools_events_v1_events_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Event) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Event) GetUnitId() string {
+	if x != nil {
+		return x.UnitId
+	}
+	return ""
+}
+
+func (x *Event) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Event) GetBriefing() string {
+	if x != nil {
+		return x.Briefing
+	}
+	return ""
+}
+
+func (x *Event) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *Event) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *Event) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *Event) GetVisibility() EventVisibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return EventVisibility_EVENT_VISIBILITY_UNSPECIFIED
+}
+
+func (x *Event) GetServer() *EventServer {
+	if x != nil {
+		return x.Server
+	}
+	return nil
+}
+
+func (x *Event) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Event) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Event) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Event) GetRecurrence() *EventRecurrence {
+	if x != nil {
+		return x.Recurrence
+	}
+	return nil
+}
+
+// How an event repeats.
+type EventRecurrence struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// An RFC 5545 recurrence rule without DTSTART, such as
+	// `FREQ=WEEKLY;BYDAY=SA`. Occurrences start at the local time of the
+	// event's `start_time` in its `time_zone`, so they keep their local time
+	// across daylight saving changes. Events repeat at most daily, so rules
+	// cannot have BYHOUR, BYMINUTE or BYSECOND, and rules with a COUNT or UNTIL
+	// have at most 1000 occurrences.
+	Rule string `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	// The start times of occurrences that are cancelled, as given by the rule.
+	Exceptions    []*timestamppb.Timestamp `protobuf:"bytes,2,rep,name=exceptions,proto3" json:"exceptions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventRecurrence) Reset() {
+	*x = EventRecurrence{}
+	mi := &file_milsimtools_events_v1_events_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventRecurrence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventRecurrence) ProtoMessage() {}
+
+func (x *EventRecurrence) ProtoReflect() protoreflect.Message {
+	mi := &file_milsimtools_events_v1_events_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventRecurrence.ProtoReflect.Descriptor instead.
+func (*EventRecurrence) Descriptor() ([]byte, []int) {
+	return file_milsimtools_events_v1_events_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EventRecurrence) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *EventRecurrence) GetExceptions() []*timestamppb.Timestamp {
+	if x != nil {
+		return x.Exceptions
+	}
+	return nil
+}
+
+// Changes to a single occurrence of a recurring event. Fields that are not
+// set are those of the event.
+type EventOccurrenceOverride struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the event the occurrence belongs to.
+	EventId string `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// The start time of the occurrence as given by the event's recurrence,
+	// which identifies the occurrence even once it is moved.
+	OccurrenceTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=occurrence_time,json=occurrenceTime,proto3" json:"occurrence_time,omitempty"`
+	// The title of the occurrence.
+	Title *string `protobuf:"bytes,3,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	// The briefing of the occurrence, in Markdown.
+	Briefing *string `protobuf:"bytes,4,opt,name=briefing,proto3,oneof" json:"briefing,omitempty"`
+	// The time the occurrence starts. If only `start_time` is set, the
+	// occurrence keeps the duration of the event.
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// The time the occurrence ends.
+	EndTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// The time the occurrence was first changed.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// The last time the occurrence was changed.
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventOccurrenceOverride) Reset() {
+	*x = EventOccurrenceOverride{}
+	mi := &file_milsimtools_events_v1_events_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventOccurrenceOverride) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventOccurrenceOverride) ProtoMessage() {}
+
+func (x *EventOccurrenceOverride) ProtoReflect() protoreflect.Message {
+	mi := &file_milsimtools_events_v1_events_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventOccurrenceOverride.ProtoReflect.Descriptor instead.
+func (*EventOccurrenceOverride) Descriptor() ([]byte, []int) {
+	return file_milsimtools_events_v1_events_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *EventOccurrenceOverride) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *EventOccurrenceOverride) GetOccurrenceTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurrenceTime
+	}
+	return nil
+}
+
+func (x *EventOccurrenceOverride) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *EventOccurrenceOverride) GetBriefing() string {
+	if x != nil && x.Briefing != nil {
+		return *x.Briefing
+	}
+	return ""
+}
+
+func (x *EventOccurrenceOverride) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *EventOccurrenceOverride) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *EventOccurrenceOverride) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *EventOccurrenceOverride) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// A single occurrence of an event, for one-off events the event itself.
+type EventOccurrence struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The event, with the title, briefing, start time and end time of the
+	// occurrence.
+	Event *Event `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	// The start time of the occurrence as given by the event's recurrence.
+	OccurrenceTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=occurrence_time,json=occurrenceTime,proto3" json:"occurrence_time,omitempty"`
+	// Whether the occurrence was changed from the event.
+	Overridden    bool `protobuf:"varint,3,opt,name=overridden,proto3" json:"overridden,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventOccurrence) Reset() {
+	*x = EventOccurrence{}
+	mi := &file_milsimtools_events_v1_events_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventOccurrence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventOccurrence) ProtoMessage() {}
+
+func (x *EventOccurrence) ProtoReflect() protoreflect.Message {
+	mi := &file_milsimtools_events_v1_events_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventOccurrence.ProtoReflect.Descriptor instead.
+func (*EventOccurrence) Descriptor() ([]byte, []int) {
+	return file_milsimtools_events_v1_events_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *EventOccurrence) GetEvent() *Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *EventOccurrence) GetOccurrenceTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurrenceTime
+	}
+	return nil
+}
+
+func (x *EventOccurrence) GetOverridden() bool {
+	if x != nil {
+		return x.Overridden
+	}
+	return false
+}
+
+// A calendar feed of events, served in iCalendar format from the HTTP
+// listener to calendar apps, which cannot authenticate.
+type EventFeed struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the feed, represented as a ULID.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The ID of the user the feed belongs to. The feed has the events the user
+	// can view.
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// The ID of the unit whose events the feed has. Feeds without a unit have
+	// the events of every unit the user can view the events of.
+	UnitId string `protobuf:"bytes,3,opt,name=unit_id,json=unitId,proto3" json:"unit_id,omitempty"`
+	// The path of the feed on the HTTP listener, holding the secret token that
+	// grants access to it. It is only returned when the feed is created.
+	Path string `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`
+	// The time the feed was created.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// The last time a calendar app fetched the feed.
+	LastUsedAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventFeed) Reset() {
+	*x = EventFeed{}
+	mi := &file_milsimtools_events_v1_events_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventFeed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventFeed) ProtoMessage() {}
+
+func (x *EventFeed) ProtoReflect() protoreflect.Message {
+	mi := &file_milsimtools_events_v1_events_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventFeed.ProtoReflect.Descriptor instead.
+func (*EventFeed) Descriptor() ([]byte, []int) {
+	return file_milsimtools_events_v1_events_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *EventFeed) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EventFeed) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *EventFeed) GetUnitId() string {
+	if x != nil {
+		return x.UnitId
+	}
+	return ""
+}
+
+func (x *EventFeed) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *EventFeed) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *EventFeed) GetLastUsedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return nil
+}
+
+// The response of a member to an event.
+type EventResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the event responded to.
+	EventId string `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// The ID of the user who responded.
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// The response.
+	Status EventResponseStatus `protobuf:"varint,3,opt,name=status,proto3,enum=milsimtools.events.v1.EventResponseStatus" json:"status,omitempty"`
+	// A comment on the response, such as the reason for declining.
+	Comment string `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
+	// The time the user first responded.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// The last time the user responded.
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// The start time of the occurrence responded to, as given by the event's
+	// recurrence. For events that take place once, their start time.
+	OccurrenceTime *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=occurrence_time,json=occurrenceTime,proto3" json:"occurrence_time,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *EventResponse) Reset() {
+	*x = EventResponse{}
+	mi := &file_milsimtools_events_v1_events_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventResponse) ProtoMessage() {}
+
+func (x *EventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_milsimtools_events_v1_events_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventResponse.ProtoReflect.Descriptor instead.
+func (*EventResponse) Descriptor() ([]byte, []int) {
+	return file_milsimtools_events_v1_events_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *EventResponse) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *EventResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *EventResponse) GetStatus() EventResponseStatus {
+	if x != nil {
+		return x.Status
+	}
+	return EventResponseStatus_EVENT_RESPONSE_STATUS_UNSPECIFIED
+}
+
+func (x *EventResponse) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *EventResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *EventResponse) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *EventResponse) GetOccurrenceTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurrenceTime
+	}
+	return nil
+}
+
+// The attendance of a member at an occurrence of an event, as recorded by
+// staff.
+type EventAttendance struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the event.
+	EventId string `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// The start time of the occurrence, as given by the event's recurrence. For
+	// events that take place once, their start time.
+	OccurrenceTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=occurrence_time,json=occurrenceTime,proto3" json:"occurrence_time,omitempty"`
+	// The ID of the user whose attendance is recorded.
+	UserId string `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Whether the member showed up.
+	Status AttendanceStatus `protobuf:"varint,4,opt,name=status,proto3,enum=milsimtools.events.v1.AttendanceStatus" json:"status,omitempty"`
+	// A note on the attendance, such as the reason for leave.
+	Note string `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"`
+	// The ID of the user who last recorded the attendance.
+	RecordedBy string `protobuf:"bytes,6,opt,name=recorded_by,json=recordedBy,proto3" json:"recorded_by,omitempty"`
+	// The time the attendance was first recorded.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// The last time the attendance was recorded.
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventAttendance) Reset() {
+	*x = EventAttendance{}
+	mi := &file_milsimtools_events_v1_events_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventAttendance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventAttendance) ProtoMessage() {}
+
+func (x *EventAttendance) ProtoReflect() protoreflect.Message {
+	mi := &file_milsimtools_events_v1_events_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventAttendance.ProtoReflect.Descriptor instead.
+func (*EventAttendance) Descriptor() ([]byte, []int) {
+	return file_milsimtools_events_v1_events_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *EventAttendance) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *EventAttendance) GetOccurrenceTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurrenceTime
+	}
+	return nil
+}
+
+func (x *EventAttendance) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *EventAttendance) GetStatus() AttendanceStatus {
+	if x != nil {
+		return x.Status
+	}
+	return AttendanceStatus_ATTENDANCE_STATUS_UNSPECIFIED
+}
+
+func (x *EventAttendance) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *EventAttendance) GetRecordedBy() string {
+	if x != nil {
+		return x.RecordedBy
+	}
+	return ""
+}
+
+func (x *EventAttendance) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *EventAttendance) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// The attendance records of a member, or of a whole unit, summed up.
+type AttendanceTotals struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The number of occurrences attended.
+	Present int32 `protobuf:"varint,1,opt,name=present,proto3" json:"present,omitempty"`
+	// The number of occurrences attended late.
+	Late int32 `protobuf:"varint,2,opt,name=late,proto3" json:"late,omitempty"`
+	// The number of occurrences missed with leave.
+	Excused int32 `protobuf:"varint,3,opt,name=excused,proto3" json:"excused,omitempty"`
+	// The number of occurrences missed without leave.
+	Awol int32 `protobuf:"varint,4,opt,name=awol,proto3" json:"awol,omitempty"`
+	// The share of occurrences attended, on time or late, from 0 to 1.
+	// Excused absences do not count towards it, so it is unset when every
+	// record is excused.
+	Rate          *float64 `protobuf:"fixed64,5,opt,name=rate,proto3,oneof" json:"rate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttendanceTotals) Reset() {
+	*x = AttendanceTotals{}
+	mi := &file_milsimtools_events_v1_events_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttendanceTotals) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttendanceTotals) ProtoMessage() {}
+
+func (x *AttendanceTotals) ProtoReflect() protoreflect.Message {
+	mi := &file_milsimtools_events_v1_events_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttendanceTotals.ProtoReflect.Descriptor instead.
+func (*AttendanceTotals) Descriptor() ([]byte, []int) {
+	return file_milsimtools_events_v1_events_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AttendanceTotals) GetPresent() int32 {
+	if x != nil {
+		return x.Present
+	}
+	return 0
+}
+
+func (x *AttendanceTotals) GetLate() int32 {
+	if x != nil {
+		return x.Late
+	}
+	return 0
+}
+
+func (x *AttendanceTotals) GetExcused() int32 {
+	if x != nil {
+		return x.Excused
+	}
+	return 0
+}
+
+func (x *AttendanceTotals) GetAwol() int32 {
+	if x != nil {
+		return x.Awol
+	}
+	return 0
+}
+
+func (x *AttendanceTotals) GetRate() float64 {
+	if x != nil && x.Rate != nil {
+		return *x.Rate
+	}
+	return 0
+}
+
+// The attendance of a member of a unit within a window.
+type MemberAttendance struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the user.
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// The member's attendance records, summed up.
+	Totals        *AttendanceTotals `protobuf:"bytes,2,opt,name=totals,proto3" json:"totals,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemberAttendance) Reset() {
+	*x = MemberAttendance{}
+	mi := &file_milsimtools_events_v1_events_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemberAttendance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemberAttendance) ProtoMessage() {}
+
+func (x *MemberAttendance) ProtoReflect() protoreflect.Message {
+	mi := &file_milsimtools_events_v1_events_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemberAttendance.ProtoReflect.Descriptor instead.
+func (*MemberAttendance) Descriptor() ([]byte, []int) {
+	return file_milsimtools_events_v1_events_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *MemberAttendance) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *MemberAttendance) GetTotals() *AttendanceTotals {
+	if x != nil {
+		return x.Totals
+	}
+	return nil
+}
+
+var File_milsimtools_events_v1_events_proto protoreflect.FileDescriptor
+
+const file_milsimtools_events_v1_events_proto_rawDesc = "" +
+	"\n" +
+	"\"milsimtools/events/v1/events.proto\x12\x15milsimtools.events.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb5\x01\n" +
+	"\vEventServer\x12\x1b\n" +
+	"\x04game\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x18dR\x04game\x12\"\n" +
+	"\aaddress\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\xfd\x01R\aaddress\x12\x1d\n" +
+	"\x04port\x18\x03 \x01(\rB\t\xbaH\x06*\x04\x18\xff\xff\x03R\x04port\x12$\n" +
+	"\bpassword\x18\x04 \x01(\tB\b\xbaH\x05r\x03\x18\xc8\x01R\bpassword\x12 \n" +
+	"\x06modset\x18\x05 \x01(\tB\b\xbaH\x05r\x03\x18\xd0\x0fR\x06modset\"\xa2\x06\n" +
+	"\x05Event\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\aunit_id\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x06unitId\x12 \n" +
+	"\x05title\x18\x03 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xc8\x01R\x05title\x12%\n" +
+	"\bbriefing\x18\x04 \x01(\tB\t\xbaH\x06r\x04\x18\xa0\x9c\x01R\bbriefing\x12A\n" +
+	"\n" +
+	"start_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\tstartTime\x12=\n" +
+	"\bend_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\aendTime\x12$\n" +
+	"\ttime_zone\x18\a \x01(\tB\a\xbaH\x04r\x02\x18@R\btimeZone\x12P\n" +
+	"\n" +
+	"visibility\x18\b \x01(\x0e2&.milsimtools.events.v1.EventVisibilityB\b\xbaH\x05\x82\x01\x02\x10\x01R\n" +
+	"visibility\x12:\n" +
+	"\x06server\x18\t \x01(\v2\".milsimtools.events.v1.EventServerR\x06server\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\n" +
+	" \x01(\tR\tcreatedBy\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12F\n" +
+	"\n" +
+	"recurrence\x18\r \x01(\v2&.milsimtools.events.v1.EventRecurrenceR\n" +
+	"recurrence:\x8b\x01\xbaH\x87\x01\x1a\x84\x01\n" +
+	"\x0eevent.end_time\x12!end_time must be after start_time\x1aO!has(this.start_time) || !has(this.end_time) || this.end_time > this.start_time\"x\n" +
+	"\x0fEventRecurrence\x12\x1e\n" +
+	"\x04rule\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xf4\x03R\x04rule\x12E\n" +
+	"\n" +
+	"exceptions\x18\x02 \x03(\v2\x1a.google.protobuf.TimestampB\t\xbaH\x06\x92\x01\x03\x10\xf4\x03R\n" +
+	"exceptions\"\xd3\x03\n" +
+	"\x17EventOccurrenceOverride\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12K\n" +
+	"\x0foccurrence_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\x0eoccurrenceTime\x12%\n" +
+	"\x05title\x18\x03 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xc8\x01H\x00R\x05title\x88\x01\x01\x12*\n" +
+	"\bbriefing\x18\x04 \x01(\tB\t\xbaH\x06r\x04\x18\xa0\x9c\x01H\x01R\bbriefing\x88\x01\x01\x129\n" +
+	"\n" +
+	"start_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\b\n" +
+	"\x06_titleB\v\n" +
+	"\t_briefing\"\xaa\x01\n" +
+	"\x0fEventOccurrence\x122\n" +
+	"\x05event\x18\x01 \x01(\v2\x1c.milsimtools.events.v1.EventR\x05event\x12C\n" +
+	"\x0foccurrence_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x0eoccurrenceTime\x12\x1e\n" +
+	"\n" +
+	"overridden\x18\x03 \x01(\bR\n" +
+	"overridden\"\xda\x01\n" +
+	"\tEventFeed\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x17\n" +
+	"\aunit_id\x18\x03 \x01(\tR\x06unitId\x12\x12\n" +
+	"\x04path\x18\x04 \x01(\tR\x04path\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12<\n" +
+	"\flast_used_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastUsedAt\"\xdc\x02\n" +
+	"\rEventResponse\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12B\n" +
+	"\x06status\x18\x03 \x01(\x0e2*.milsimtools.events.v1.EventResponseStatusR\x06status\x12\x18\n" +
+	"\acomment\x18\x04 \x01(\tR\acomment\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12C\n" +
+	"\x0foccurrence_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x0eoccurrenceTime\"\xf6\x02\n" +
+	"\x0fEventAttendance\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12C\n" +
+	"\x0foccurrence_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x0eoccurrenceTime\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12?\n" +
+	"\x06status\x18\x04 \x01(\x0e2'.milsimtools.events.v1.AttendanceStatusR\x06status\x12\x12\n" +
+	"\x04note\x18\x05 \x01(\tR\x04note\x12\x1f\n" +
+	"\vrecorded_by\x18\x06 \x01(\tR\n" +
+	"recordedBy\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x90\x01\n" +
+	"\x10AttendanceTotals\x12\x18\n" +
+	"\apresent\x18\x01 \x01(\x05R\apresent\x12\x12\n" +
+	"\x04late\x18\x02 \x01(\x05R\x04late\x12\x18\n" +
+	"\aexcused\x18\x03 \x01(\x05R\aexcused\x12\x12\n" +
+	"\x04awol\x18\x04 \x01(\x05R\x04awol\x12\x17\n" +
+	"\x04rate\x18\x05 \x01(\x01H\x00R\x04rate\x88\x01\x01B\a\n" +
+	"\x05_rate\"l\n" +
+	"\x10MemberAttendance\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12?\n" +
+	"\x06totals\x18\x02 \x01(\v2'.milsimtools.events.v1.AttendanceTotalsR\x06totals*k\n" +
+	"\x0fEventVisibility\x12 \n" +
+	"\x1cEVENT_VISIBILITY_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15EVENT_VISIBILITY_UNIT\x10\x01\x12\x1b\n" +
+	"\x17EVENT_VISIBILITY_PUBLIC\x10\x02*\xa6\x01\n" +
+	"\x13EventResponseStatus\x12%\n" +
+	"!EVENT_RESPONSE_STATUS_UNSPECIFIED\x10\x00\x12#\n" +
+	"\x1fEVENT_RESPONSE_STATUS_ATTENDING\x10\x01\x12\x1f\n" +
+	"\x1bEVENT_RESPONSE_STATUS_MAYBE\x10\x02\x12\"\n" +
+	"\x1eEVENT_RESPONSE_STATUS_DECLINED\x10\x03*\xab\x01\n" +
+	"\x10AttendanceStatus\x12!\n" +
+	"\x1dATTENDANCE_STATUS_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19ATTENDANCE_STATUS_PRESENT\x10\x01\x12\x1a\n" +
+	"\x16ATTENDANCE_STATUS_LATE\x10\x02\x12\x1d\n" +
+	"\x19ATTENDANCE_STATUS_EXCUSED\x10\x03\x12\x1a\n" +
+	"\x16ATTENDANCE_STATUS_AWOL\x10\x04B\xe9\x01\n" +
+	"\x19com.milsimtools.events.v1B\vEventsProtoP\x01ZIgithub.com/milsim-tools/pincer/pkg/api/gen/milsimtools/events/v1;eventsv1\xa2\x02\x03MEX\xaa\x02\x15Milsimtools.Events.V1\xca\x02\x15Milsimtools\\Events\\V1\xe2\x02!Milsimtools\\Events\\V1\\GPBMetadata\xea\x02\x17Milsimtools::Events::V1b\x06proto3"
+
+var (
+	file_milsimtools_events_v1_events_proto_rawDescOnce sync.Once
+	file_milsimtools_events_v1_events_proto_rawDescData []byte
+)
+
+func file_milsimtools_events_v1_events_proto_rawDescGZIP() []byte {
+	file_milsimtools_events_v1_events_proto_rawDescOnce.Do(func() {
+		file_milsimtools_events_v1_events_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_milsimtools_events_v1_events_proto_rawDesc), len(file_milsimtools_events_v1_events_proto_rawDesc)))
+	})
+	return file_milsimtools_events_v1_events_proto_rawDescData
+}
+
+var file_milsimtools_events_v1_events_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_milsimtools_events_v1_events_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_milsimtools_events_v1_events_proto_goTypes = []any{
+	(EventVisibility)(0),            // 0: milsimtools.events.v1.EventVisibility
+	(EventResponseStatus)(0),        // 1: milsimtools.events.v1.EventResponseStatus
+	(AttendanceStatus)(0),           // 2: milsimtools.events.v1.AttendanceStatus
+	(*EventServer)(nil),             // 3: milsimtools.events.v1.EventServer
+	(*Event)(nil),                   // 4: milsimtools.events.v1.Event
+	(*EventRecurrence)(nil),         // 5: milsimtools.events.v1.EventRecurrence
+	(*EventOccurrenceOverride)(nil), // 6: milsimtools.events.v1.EventOccurrenceOverride
+	(*EventOccurrence)(nil),         // 7: milsimtools.events.v1.EventOccurrence
+	(*EventFeed)(nil),               // 8: milsimtools.events.v1.EventFeed
+	(*EventResponse)(nil),           // 9: milsimtools.events.v1.EventResponse
+	(*EventAttendance)(nil),         // 10: milsimtools.events.v1.EventAttendance
+	(*AttendanceTotals)(nil),        // 11: milsimtools.events.v1.AttendanceTotals
+	(*MemberAttendance)(nil),        // 12: milsimtools.events.v1.MemberAttendance
+	(*timestamppb.Timestamp)(nil),   // 13: google.protobuf.Timestamp
+}
+var file_milsimtools_events_v1_events_proto_depIdxs = []int32{
+	13, // 0: milsimtools.events.v1.Event.start_time:type_name -> google.protobuf.Timestamp
+	13, // 1: milsimtools.events.v1.Event.end_time:type_name -> google.protobuf.Timestamp
+	0,  // 2: milsimtools.events.v1.Event.visibility:type_name -> milsimtools.events.v1.EventVisibility
+	3,  // 3: milsimtools.events.v1.Event.server:type_name -> milsimtools.events.v1.EventServer
+	13, // 4: milsimtools.events.v1.Event.created_at:type_name -> google.protobuf.Timestamp
+	13, // 5: milsimtools.events.v1.Event.updated_at:type_name -> google.protobuf.Timestamp
+	5,  // 6: milsimtools.events.v1.Event.recurrence:type_name -> milsimtools.events.v1.EventRecurrence
+	13, // 7: milsimtools.events.v1.EventRecurrence.exceptions:type_name -> google.protobuf.Timestamp
+	13, // 8: milsimtools.events.v1.EventOccurrenceOverride.occurrence_time:type_name -> google.protobuf.Timestamp
+	13, // 9: milsimtools.events.v1.EventOccurrenceOverride.start_time:type_name -> google.protobuf.Timestamp
+	13, // 10: milsimtools.events.v1.EventOccurrenceOverride.end_time:type_name -> google.protobuf.Timestamp
+	13, // 11: milsimtools.events.v1.EventOccurrenceOverride.created_at:type_name -> google.protobuf.Timestamp
+	13, // 12: milsimtools.events.v1.EventOccurrenceOverride.updated_at:type_name -> google.protobuf.Timestamp
+	4,  // 13: milsimtools.events.v1.EventOccurrence.event:type_name -> milsimtools.events.v1.Event
+	13, // 14: milsimtools.events.v1.EventOccurrence.occurrence_time:type_name -> google.protobuf.Timestamp
+	13, // 15: milsimtools.events.v1.EventFeed.created_at:type_name -> google.protobuf.Timestamp
+	13, // 16: milsimtools.events.v1.EventFeed.last_used_at:type_name -> google.protobuf.Timestamp
+	1,  // 17: milsimtools.events.v1.EventResponse.status:type_name -> milsimtools.events.v1.EventResponseStatus
+	13, // 18: milsimtools.events.v1.EventResponse.created_at:type_name -> google.protobuf.Timestamp
+	13, // 19: milsimtools.events.v1.EventResponse.updated_at:type_name -> google.protobuf.Timestamp
+	13, // 20: milsimtools.events.v1.EventResponse.occurrence_time:type_name -> google.protobuf.Timestamp
+	13, // 21: milsimtools.events.v1.EventAttendance.occurrence_time:type_name -> google.protobuf.Timestamp
+	2,  // 22: milsimtools.events.v1.EventAttendance.status:type_name -> milsimtools.events.v1.AttendanceStatus
+	13, // 23: milsimtools.events.v1.EventAttendance.created_at:type_name -> google.protobuf.Timestamp
+	13, // 24: milsimtools.events.v1.EventAttendance.updated_at:type_name -> google.protobuf.Timestamp
+	11, // 25: milsimtools.events.v1.MemberAttendance.totals:type_name -> milsimtools.events.v1.AttendanceTotals
+	26, // [26:26] is the sub-list for method output_type
+	26, // [26:26] is the sub-list for method input_type
+	26, // [26:26] is the sub-list for extension type_name
+	26, // [26:26] is the sub-list for extension extendee
+	0,  // [0:26] is the sub-list for field type_name
+}
+
+func init() { file_milsimtools_events_v1_events_proto_init() }
+func file_milsimtools_events_v1_events_proto_init() {
+	if File_milsimtools_events_v1_events_proto != nil {
+		return
+	}
+	file_milsimtools_events_v1_events_proto_msgTypes[3].OneofWrappers = []any{}
+	file_milsimtools_events_v1_events_proto_msgTypes[8].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_milsimtools_events_v1_events_proto_rawDesc), len(file_milsimtools_events_v1_events_proto_rawDesc)),
+			NumEnums:      3,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_milsimtools_events_v1_events_proto_goTypes,
+		DependencyIndexes: file_milsimtools_events_v1_events_proto_depIdxs,
+		EnumInfos:         file_milsimtools_events_v1_events_proto_enumTypes,
+		MessageInfos:      file_milsimtools_events_v1_events_proto_msgTypes,
+	}.Build()
+	File_milsimtools_events_v1_events_proto = out.File
+	file_milsimtools_events_v1_events_proto_goTypes = nil
+	file_milsimtools_events_v1_events_proto_depIdxs = nil
+}
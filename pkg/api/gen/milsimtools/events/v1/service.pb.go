@@ -0,0 +1,2039 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: milsimtools/events/v1/service.proto
+
+package eventsv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	_ "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/authz/v1"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CreateEventRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The event to create.
+	Event         *Event `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateEventRequest) Reset() {
+	*x = CreateEventRequest{}
+	mi := &file_milsimtools_events_v1_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEventRequest) ProtoMessage() {}
+
+func (x *CreateEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_milsimtools_events_v1_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEventRequest.ProtoReflect.Descriptor instead.
+func (*CreateEventRequest) Descriptor() ([]byte, []int) {
+	return file_milsimtools_events_v1_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateEventRequest) GetEvent() *Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+type GetEventRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the unit holding the event.
+	UnitId string `protobuf:"bytes,1,opt,name=unit_id,json=unitId,proto3" json:"unit_id,omitempty"`
+	// The ID of the event to get.
+	Id            string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEventRequest) Reset() {
+	*x = GetEventRequest{}
+	mi := &file_milsimtools_events_v1_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventRequest) ProtoMessage() {}
+
+func (x *GetEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_milsimtools_events_v1_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventRequest.ProtoReflect.Descriptor instead.
+func (*GetEventRequest) Descriptor() ([]byte, []int) {
+	return file_milsimtools_events_v1_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetEventRequest) GetUnitId() string {
+	if x != nil {
+		return x.UnitId
+	}
+	return ""
+}
+
+func (x *GetEventRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListEventsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the unit to list the events of. Callers who cannot view the
+	// unit's events only see its public events.
+	UnitId string `protobuf:"bytes,1,opt,name=unit_id,json=unitId,proto3" json:"unit_id,omitempty"`
+	// The maximum number of events to return. Default is 50, maximum is 100.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// A page token, received from a previous `ListEvents` call.
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// The order of the events, a comma separated list of fields each
+	// optionally followed by `desc`, such as `start_time desc`. Events can be
+	// ordered by `start_time`, `end_time`, `title` and `created_at`, and are
+	// ordered by `start_time` by default.
+	OrderBy string `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	// A CEL expression the events must match, over the `title`, `visibility`,
+	// `start_time`, `end_time`, `created_by` and `created_at` fields of the
+	// event, such as `end_time > timestamp("2025-01-01T00:00:00Z")`.
+	Filter        string `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEventsRequest) Reset() {
+	*x = ListEventsRequest{}
+	mi := &file_milsimtools_events_v1_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEventsRequest) ProtoMessage() {}
+
+func (x *ListEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_milsimtools_events_v1_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListEventsRequest) Descriptor() ([]byte, []int) {
+	return file_milsimtools_events_v1_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListEventsRequest) GetUnitId() string {
+	if x != nil {
+		return x.UnitId
+	}
+	return ""
+}
+
+func (x *ListEventsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListEventsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListEventsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListEventsRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+type ListEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*Event               `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEventsResponse) Reset() {
+	*x = ListEventsResponse{}
+	mi := &file_milsimtools_events_v1_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEventsResponse) ProtoMessage() {}
+
+func (x *ListEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_milsimtools_events_v1_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListEventsResponse) Descriptor() ([]byte, []int) {
+	return file_milsimtools_events_v1_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListEventsResponse) GetEvents() []*Event {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *ListEventsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type UpdateEventRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The event to update.
+	//
+	// The event's `unit_id` and `id` fields are used to identify the event to
+	// update. Only the fields in `update_mask` are read, and validated by the
+	// service.
+	Event *Event `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	// The list of fields to update.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateEventRequest) Reset() {
+	*x = UpdateEventRequest{}
+	mi := &file_milsimtools_events_v1_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEventRequest) ProtoMessage() {}
+
+func (x *UpdateEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_milsimtools_events_v1_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEventRequest.ProtoReflect.Descriptor instead.
+func (*UpdateEventRequest) Descriptor() ([]byte, []int) {
+	return file_milsimtools_events_v1_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpdateEventRequest) GetEvent() *Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *UpdateEventRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type DeleteEventRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the unit holding the event.
+	UnitId string `protobuf:"bytes,1,opt,name=unit_id,json=unitId,proto3" json:"unit_id,omitempty"`
+	// The ID of the event to delete.
+	Id            string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteEventRequest) Reset() {
+	*x = DeleteEventRequest{}
+	mi := &file_milsimtools_events_v1_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEventRequest) ProtoMessage() {}
+
+func (x *DeleteEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_milsimtools_events_v1_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEventRequest.ProtoReflect.Descriptor instead.
+func (*DeleteEventRequest) Descriptor() ([]byte, []int) {
+	return file_milsimtools_events_v1_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeleteEventRequest) GetUnitId() string {
+	if x != nil {
+		return x.UnitId
+	}
+	return ""
+}
+
+func (x *DeleteEventRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RespondEventRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the unit holding the event.
+	UnitId string `protobuf:"bytes,1,opt,name=unit_id,json=unitId,proto3" json:"unit_id,omitempty"`
+	// The ID of the event to respond to.
+	EventId string `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// The ID of the user responding. Members respond for themselves, members
+	// who can manage events also on behalf of other members.
+	UserId string `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// The response, which replaces any previous response of the user.
+	Status EventResponseStatus `protobuf:"varint,4,opt,name=status,proto3,enum=milsimtools.events.v1.EventResponseStatus" json:"status,omitempty"`
+	// A comment on the response.
+	Comment string `protobuf:"bytes,5,opt,name=comment,proto3" json:"comment,omitempty"`
+	// The start time of the occurrence to respond to, as given by the event's
+	// recurrence. Members respond to each occurrence of recurring events, so
+	// it is required for them.
+	OccurrenceTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=occurrence_time,json=occurrenceTime,proto3" json:"occurrence_time,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RespondEventRequest) Reset() {
+	*x = RespondEventRequest{}
+	mi := &file_milsimtools_events_v1_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RespondEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespondEventRequest) ProtoMessage() {}
+
+func (x *RespondEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_milsimtools_events_v1_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespondEventRequest.ProtoReflect.Descriptor instead.
+func (*RespondEventRequest) Descriptor() ([]byte, []int) {
+	return file_milsimtools_events_v1_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RespondEventRequest) GetUnitId() string {
+	if x != nil {
+		return x.UnitId
+	}
+	return ""
+}
+
+func (x *RespondEventRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *RespondEventRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RespondEventRequest) GetStatus() EventResponseStatus {
+	if x != nil {
+		return x.Status
+	}
+	return EventResponseStatus_EVENT_RESPONSE_STATUS_UNSPECIFIED
+}
+
+func (x *RespondEventRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *RespondEventRequest) GetOccurrenceTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurrenceTime
+	}
+	return nil
+}
+
+type ListEventResponsesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the unit holding the event.
+	UnitId string `protobuf:"bytes,1,opt,name=unit_id,json=unitId,proto3" json:"unit_id,omitempty"`
+	// The ID of the event to list the responses to.
+	EventId string `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// The maximum number of responses to return. Default is 50, maximum is
+	// 100.
+	PageSize int32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// A page token, received from a previous `ListEventResponses` call.
+	PageToken string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// A CEL expression the responses must match, over the `user_id`, `status`
+	// and `updated_at` fields of the response, such as `status == ATTENDING`.
+	Filter string `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
+	// The start time of the occurrence to list the responses to, as given by
+	// the event's recurrence. It is required for recurring events.
+	OccurrenceTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=occurrence_time,json=occurrenceTime,proto3" json:"occurrence_time,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListEventResponsesRequest) Reset() {
+	*x = ListEventResponsesRequest{}
+	mi := &file_milsimtools_events_v1_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEventResponsesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEventResponsesRequest) ProtoMessage() {}
+
+func (x *ListEventResponsesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_milsimtools_events_v1_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEventResponsesRequest.ProtoReflect.Descriptor instead.
+func (*ListEventResponsesRequest) Descriptor() ([]byte, []int) {
+	return file_milsimtools_events_v1_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListEventResponsesRequest) GetUnitId() string {
+	if x != nil {
+		return x.UnitId
+	}
+	return ""
+}
+
+func (x *ListEventResponsesRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *ListEventResponsesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListEventResponsesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListEventResponsesRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *ListEventResponsesRequest) GetOccurrenceTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurrenceTime
+	}
+	return nil
+}
+
+type ListEventResponsesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The responses, newest first.
+	Responses []*EventResponse `protobuf:"bytes,1,rep,name=responses,proto3" json:"responses,omitempty"`
+	// A token, which can be sent as `page_token` to retrieve the next page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// The number of members attending the event, regardless of the filter.
+	Attending int32 `protobuf:"varint,3,opt,name=attending,proto3" json:"attending,omitempty"`
+	// The number of members who might attend the event, regardless of the
+	// filter.
+	Maybe int32 `protobuf:"varint,4,opt,name=maybe,proto3" json:"maybe,omitempty"`
+	// The number of members who declined the event, regardless of the filter.
+	Declined      int32 `protobuf:"varint,5,opt,name=declined,proto3" json:"declined,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEventResponsesResponse) Reset() {
+	*x = ListEventResponsesResponse{}
+	mi := &file_milsimtools_events_v1_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEventResponsesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEventResponsesResponse) ProtoMessage() {}
+
+func (x *ListEventResponsesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_milsimtools_events_v1_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEventResponsesResponse.ProtoReflect.Descriptor instead.
+func (*ListEventResponsesResponse) Descriptor() ([]byte, []int) {
+	return file_milsimtools_events_v1_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListEventResponsesResponse) GetResponses() []*EventResponse {
+	if x != nil {
+		return x.Responses
+	}
+	return nil
+}
+
+func (x *ListEventResponsesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListEventResponsesResponse) GetAttending() int32 {
+	if x != nil {
+		return x.Attending
+	}
+	return 0
+}
+
+func (x *ListEventResponsesResponse) GetMaybe() int32 {
+	if x != nil {
+		return x.Maybe
+	}
+	return 0
+}
+
+func (x *ListEventResponsesResponse) GetDeclined() int32 {
+	if x != nil {
+		return x.Declined
+	}
+	return 0
+}
+
+type ListEventOccurrencesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the unit to list the event occurrences of. Callers who cannot
+	// view the unit's events only see the occurrences of its public events.
+	UnitId string `protobuf:"bytes,1,opt,name=unit_id,json=unitId,proto3" json:"unit_id,omitempty"`
+	// The start of the window, occurrences ending after it are listed.
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// The end of the window, occurrences starting before it are listed. The
+	// window spans at most 366 days.
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEventOccurrencesRequest) Reset() {
+	*x = ListEventOccurrencesRequest{}
+	mi := &file_milsimtools_events_v1_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEventOccurrencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEventOccurrencesRequest) ProtoMessage() {}
+
+func (x *ListEventOccurrencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_milsimtools_events_v1_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEventOccurrencesRequest.ProtoReflect.Descriptor instead.
+func (*ListEventOccurrencesRequest) Descriptor() ([]byte, []int) {
+	return file_milsimtools_events_v1_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListEventOccurrencesRequest) GetUnitId() string {
+	if x != nil {
+		return x.UnitId
+	}
+	return ""
+}
+
+func (x *ListEventOccurrencesRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ListEventOccurrencesRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+type ListEventOccurrencesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The occurrences, in the order they start.
+	Occurrences   []*EventOccurrence `protobuf:"bytes,1,rep,name=occurrences,proto3" json:"occurrences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEventOccurrencesResponse) Reset() {
+	*x = ListEventOccurrencesResponse{}
+	mi := &file_milsimtools_events_v1_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEventOccurrencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEventOccurrencesResponse) ProtoMessage() {}
+
+func (x *ListEventOccurrencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_milsimtools_events_v1_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEventOccurrencesResponse.ProtoReflect.Descriptor instead.
+func (*ListEventOccurrencesResponse) Descriptor() ([]byte, []int) {
+	return file_milsimtools_events_v1_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListEventOccurrencesResponse) GetOccurrences() []*EventOccurrence {
+	if x != nil {
+		return x.Occurrences
+	}
+	return nil
+}
+
+type OverrideEventOccurrenceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the unit holding the event.
+	UnitId string `protobuf:"bytes,1,opt,name=unit_id,json=unitId,proto3" json:"unit_id,omitempty"`
+	// The changes to the occurrence, which replace any previous changes to it.
+	// The override's `event_id` field identifies the recurring event.
+	Override      *EventOccurrenceOverride `protobuf:"bytes,2,opt,name=override,proto3" json:"override,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OverrideEventOccurrenceRequest) Reset() {
+	*x = OverrideEventOccurrenceRequest{}
+	mi := &file_milsimtools_events_v1_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OverrideEventOccurrenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OverrideEventOccurrenceRequest) ProtoMessage() {}
+
+func (x *OverrideEventOccurrenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_milsimtools_events_v1_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OverrideEventOccurrenceRequest.ProtoReflect.Descriptor instead.
+func (*OverrideEventOccurrenceRequest) Descriptor() ([]byte, []int) {
+	return file_milsimtools_events_v1_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *OverrideEventOccurrenceRequest) GetUnitId() string {
+	if x != nil {
+		return x.UnitId
+	}
+	return ""
+}
+
+func (x *OverrideEventOccurrenceRequest) GetOverride() *EventOccurrenceOverride {
+	if x != nil {
+		return x.Override
+	}
+	return nil
+}
+
+type ResetEventOccurrenceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the unit holding the event.
+	UnitId string `protobuf:"bytes,1,opt,name=unit_id,json=unitId,proto3" json:"unit_id,omitempty"`
+	// The ID of the recurring event.
+	EventId string `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// The start time of the occurrence as given by the event's recurrence.
+	OccurrenceTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=occurrence_time,json=occurrenceTime,proto3" json:"occurrence_time,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ResetEventOccurrenceRequest) Reset() {
+	*x = ResetEventOccurrenceRequest{}
+	mi := &file_milsimtools_events_v1_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetEventOccurrenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetEventOccurrenceRequest) ProtoMessage() {}
+
+func (x *ResetEventOccurrenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_milsimtools_events_v1_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetEventOccurrenceRequest.ProtoReflect.Descriptor instead.
+func (*ResetEventOccurrenceRequest) Descriptor() ([]byte, []int) {
+	return file_milsimtools_events_v1_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ResetEventOccurrenceRequest) GetUnitId() string {
+	if x != nil {
+		return x.UnitId
+	}
+	return ""
+}
+
+func (x *ResetEventOccurrenceRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *ResetEventOccurrenceRequest) GetOccurrenceTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurrenceTime
+	}
+	return nil
+}
+
+type CreateEventFeedRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the user to create the feed for, who must be the caller.
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// The ID of the unit whose events the feed has, or empty for a feed of the
+	// events of every unit the user can view the events of.
+	UnitId        string `protobuf:"bytes,2,opt,name=unit_id,json=unitId,proto3" json:"unit_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateEventFeedRequest) Reset() {
+	*x = CreateEventFeedRequest{}
+	mi := &file_milsimtools_events_v1_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateEventFeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEventFeedRequest) ProtoMessage() {}
+
+func (x *CreateEventFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_milsimtools_events_v1_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEventFeedRequest.ProtoReflect.Descriptor instead.
+func (*CreateEventFeedRequest) Descriptor() ([]byte, []int) {
+	return file_milsimtools_events_v1_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CreateEventFeedRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateEventFeedRequest) GetUnitId() string {
+	if x != nil {
+		return x.UnitId
+	}
+	return ""
+}
+
+type ListEventFeedsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the user to list the feeds of, who must be the caller.
+	UserId        string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEventFeedsRequest) Reset() {
+	*x = ListEventFeedsRequest{}
+	mi := &file_milsimtools_events_v1_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEventFeedsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEventFeedsRequest) ProtoMessage() {}
+
+func (x *ListEventFeedsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_milsimtools_events_v1_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEventFeedsRequest.ProtoReflect.Descriptor instead.
+func (*ListEventFeedsRequest) Descriptor() ([]byte, []int) {
+	return file_milsimtools_events_v1_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListEventFeedsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListEventFeedsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The feeds, newest first.
+	Feeds         []*EventFeed `protobuf:"bytes,1,rep,name=feeds,proto3" json:"feeds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEventFeedsResponse) Reset() {
+	*x = ListEventFeedsResponse{}
+	mi := &file_milsimtools_events_v1_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEventFeedsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEventFeedsResponse) ProtoMessage() {}
+
+func (x *ListEventFeedsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_milsimtools_events_v1_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEventFeedsResponse.ProtoReflect.Descriptor instead.
+func (*ListEventFeedsResponse) Descriptor() ([]byte, []int) {
+	return file_milsimtools_events_v1_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListEventFeedsResponse) GetFeeds() []*EventFeed {
+	if x != nil {
+		return x.Feeds
+	}
+	return nil
+}
+
+type DeleteEventFeedRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the user the feed belongs to, who must be the caller.
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// The ID of the feed to delete, which revokes its token.
+	Id            string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteEventFeedRequest) Reset() {
+	*x = DeleteEventFeedRequest{}
+	mi := &file_milsimtools_events_v1_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteEventFeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEventFeedRequest) ProtoMessage() {}
+
+func (x *DeleteEventFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_milsimtools_events_v1_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEventFeedRequest.ProtoReflect.Descriptor instead.
+func (*DeleteEventFeedRequest) Descriptor() ([]byte, []int) {
+	return file_milsimtools_events_v1_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DeleteEventFeedRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DeleteEventFeedRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RecordEventAttendanceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the unit holding the event.
+	UnitId string `protobuf:"bytes,1,opt,name=unit_id,json=unitId,proto3" json:"unit_id,omitempty"`
+	// The ID of the event to record the attendance at.
+	EventId string `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// The start time of the occurrence to record the attendance at, as given by
+	// the event's recurrence. It is required for recurring events.
+	OccurrenceTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=occurrence_time,json=occurrenceTime,proto3" json:"occurrence_time,omitempty"`
+	// The attendance of each member, which replaces any attendance recorded
+	// for them before.
+	Records       []*AttendanceRecord `protobuf:"bytes,4,rep,name=records,proto3" json:"records,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordEventAttendanceRequest) Reset() {
+	*x = RecordEventAttendanceRequest{}
+	mi := &file_milsimtools_events_v1_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordEventAttendanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordEventAttendanceRequest) ProtoMessage() {}
+
+func (x *RecordEventAttendanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_milsimtools_events_v1_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordEventAttendanceRequest.ProtoReflect.Descriptor instead.
+func (*RecordEventAttendanceRequest) Descriptor() ([]byte, []int) {
+	return file_milsimtools_events_v1_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RecordEventAttendanceRequest) GetUnitId() string {
+	if x != nil {
+		return x.UnitId
+	}
+	return ""
+}
+
+func (x *RecordEventAttendanceRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *RecordEventAttendanceRequest) GetOccurrenceTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurrenceTime
+	}
+	return nil
+}
+
+func (x *RecordEventAttendanceRequest) GetRecords() []*AttendanceRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+// The attendance of a single member, as recorded by RecordEventAttendance.
+type AttendanceRecord struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the user, who must be able to respond to the unit's events.
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Whether the member showed up.
+	Status AttendanceStatus `protobuf:"varint,2,opt,name=status,proto3,enum=milsimtools.events.v1.AttendanceStatus" json:"status,omitempty"`
+	// A note on the attendance, such as the reason for leave.
+	Note          string `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttendanceRecord) Reset() {
+	*x = AttendanceRecord{}
+	mi := &file_milsimtools_events_v1_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttendanceRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttendanceRecord) ProtoMessage() {}
+
+func (x *AttendanceRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_milsimtools_events_v1_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttendanceRecord.ProtoReflect.Descriptor instead.
+func (*AttendanceRecord) Descriptor() ([]byte, []int) {
+	return file_milsimtools_events_v1_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *AttendanceRecord) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AttendanceRecord) GetStatus() AttendanceStatus {
+	if x != nil {
+		return x.Status
+	}
+	return AttendanceStatus_ATTENDANCE_STATUS_UNSPECIFIED
+}
+
+func (x *AttendanceRecord) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type RecordEventAttendanceResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The recorded attendance, in the order of the records.
+	Attendance    []*EventAttendance `protobuf:"bytes,1,rep,name=attendance,proto3" json:"attendance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordEventAttendanceResponse) Reset() {
+	*x = RecordEventAttendanceResponse{}
+	mi := &file_milsimtools_events_v1_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordEventAttendanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordEventAttendanceResponse) ProtoMessage() {}
+
+func (x *RecordEventAttendanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_milsimtools_events_v1_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordEventAttendanceResponse.ProtoReflect.Descriptor instead.
+func (*RecordEventAttendanceResponse) Descriptor() ([]byte, []int) {
+	return file_milsimtools_events_v1_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RecordEventAttendanceResponse) GetAttendance() []*EventAttendance {
+	if x != nil {
+		return x.Attendance
+	}
+	return nil
+}
+
+type ListEventAttendanceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the unit holding the event.
+	UnitId string `protobuf:"bytes,1,opt,name=unit_id,json=unitId,proto3" json:"unit_id,omitempty"`
+	// The ID of the event to list the attendance at.
+	EventId string `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// The start time of the occurrence to list the attendance at, as given by
+	// the event's recurrence. It is required for recurring events.
+	OccurrenceTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=occurrence_time,json=occurrenceTime,proto3" json:"occurrence_time,omitempty"`
+	// The maximum number of records to return. Default is 50, maximum is 100.
+	PageSize int32 `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// A page token, received from a previous `ListEventAttendance` call.
+	PageToken string `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// A CEL expression the records must match, over the `user_id`, `status`,
+	// `recorded_by` and `updated_at` fields of the record, such as
+	// `status == AWOL`.
+	Filter        string `protobuf:"bytes,6,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEventAttendanceRequest) Reset() {
+	*x = ListEventAttendanceRequest{}
+	mi := &file_milsimtools_events_v1_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEventAttendanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEventAttendanceRequest) ProtoMessage() {}
+
+func (x *ListEventAttendanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_milsimtools_events_v1_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEventAttendanceRequest.ProtoReflect.Descriptor instead.
+func (*ListEventAttendanceRequest) Descriptor() ([]byte, []int) {
+	return file_milsimtools_events_v1_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListEventAttendanceRequest) GetUnitId() string {
+	if x != nil {
+		return x.UnitId
+	}
+	return ""
+}
+
+func (x *ListEventAttendanceRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *ListEventAttendanceRequest) GetOccurrenceTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurrenceTime
+	}
+	return nil
+}
+
+func (x *ListEventAttendanceRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListEventAttendanceRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListEventAttendanceRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+type ListEventAttendanceResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The attendance records, newest first.
+	Attendance []*EventAttendance `protobuf:"bytes,1,rep,name=attendance,proto3" json:"attendance,omitempty"`
+	// A token, which can be sent as `page_token` to retrieve the next page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// The attendance records of the occurrence summed up, regardless of the
+	// filter.
+	Totals        *AttendanceTotals `protobuf:"bytes,3,opt,name=totals,proto3" json:"totals,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEventAttendanceResponse) Reset() {
+	*x = ListEventAttendanceResponse{}
+	mi := &file_milsimtools_events_v1_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEventAttendanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEventAttendanceResponse) ProtoMessage() {}
+
+func (x *ListEventAttendanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_milsimtools_events_v1_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEventAttendanceResponse.ProtoReflect.Descriptor instead.
+func (*ListEventAttendanceResponse) Descriptor() ([]byte, []int) {
+	return file_milsimtools_events_v1_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListEventAttendanceResponse) GetAttendance() []*EventAttendance {
+	if x != nil {
+		return x.Attendance
+	}
+	return nil
+}
+
+func (x *ListEventAttendanceResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListEventAttendanceResponse) GetTotals() *AttendanceTotals {
+	if x != nil {
+		return x.Totals
+	}
+	return nil
+}
+
+type DeleteEventAttendanceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the unit holding the event.
+	UnitId string `protobuf:"bytes,1,opt,name=unit_id,json=unitId,proto3" json:"unit_id,omitempty"`
+	// The ID of the event.
+	EventId string `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// The start time of the occurrence, as given by the event's recurrence. It
+	// is required for recurring events.
+	OccurrenceTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=occurrence_time,json=occurrenceTime,proto3" json:"occurrence_time,omitempty"`
+	// The ID of the user whose attendance to delete.
+	UserId        string `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteEventAttendanceRequest) Reset() {
+	*x = DeleteEventAttendanceRequest{}
+	mi := &file_milsimtools_events_v1_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteEventAttendanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEventAttendanceRequest) ProtoMessage() {}
+
+func (x *DeleteEventAttendanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_milsimtools_events_v1_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEventAttendanceRequest.ProtoReflect.Descriptor instead.
+func (*DeleteEventAttendanceRequest) Descriptor() ([]byte, []int) {
+	return file_milsimtools_events_v1_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *DeleteEventAttendanceRequest) GetUnitId() string {
+	if x != nil {
+		return x.UnitId
+	}
+	return ""
+}
+
+func (x *DeleteEventAttendanceRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *DeleteEventAttendanceRequest) GetOccurrenceTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurrenceTime
+	}
+	return nil
+}
+
+func (x *DeleteEventAttendanceRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetAttendanceReportRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the unit to report on.
+	UnitId string `protobuf:"bytes,1,opt,name=unit_id,json=unitId,proto3" json:"unit_id,omitempty"`
+	// The start of the window, occurrences starting at or after it count.
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// The end of the window, occurrences starting before it count. The window
+	// spans at most 366 days.
+	EndTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// If set, only members whose attendance rate is below it are reported,
+	// such as `0.5` for members who missed more than half of the occurrences.
+	Below         *float64 `protobuf:"fixed64,4,opt,name=below,proto3,oneof" json:"below,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAttendanceReportRequest) Reset() {
+	*x = GetAttendanceReportRequest{}
+	mi := &file_milsimtools_events_v1_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAttendanceReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttendanceReportRequest) ProtoMessage() {}
+
+func (x *GetAttendanceReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_milsimtools_events_v1_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttendanceReportRequest.ProtoReflect.Descriptor instead.
+func (*GetAttendanceReportRequest) Descriptor() ([]byte, []int) {
+	return file_milsimtools_events_v1_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetAttendanceReportRequest) GetUnitId() string {
+	if x != nil {
+		return x.UnitId
+	}
+	return ""
+}
+
+func (x *GetAttendanceReportRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *GetAttendanceReportRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *GetAttendanceReportRequest) GetBelow() float64 {
+	if x != nil && x.Below != nil {
+		return *x.Below
+	}
+	return 0
+}
+
+type GetAttendanceReportResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The attendance of each member with attendance recorded in the window,
+	// lowest attendance rate first.
+	Members []*MemberAttendance `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	// The attendance records of the whole unit in the window summed up,
+	// regardless of `below`.
+	//
+	// Reports have no per-section totals yet, since units are not divided
+	// into sections. They will be added as another field once they are.
+	Totals        *AttendanceTotals `protobuf:"bytes,2,opt,name=totals,proto3" json:"totals,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAttendanceReportResponse) Reset() {
+	*x = GetAttendanceReportResponse{}
+	mi := &file_milsimtools_events_v1_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAttendanceReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttendanceReportResponse) ProtoMessage() {}
+
+func (x *GetAttendanceReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_milsimtools_events_v1_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttendanceReportResponse.ProtoReflect.Descriptor instead.
+func (*GetAttendanceReportResponse) Descriptor() ([]byte, []int) {
+	return file_milsimtools_events_v1_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetAttendanceReportResponse) GetMembers() []*MemberAttendance {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *GetAttendanceReportResponse) GetTotals() *AttendanceTotals {
+	if x != nil {
+		return x.Totals
+	}
+	return nil
+}
+
+type GetMemberAttendanceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the unit to report on.
+	UnitId string `protobuf:"bytes,1,opt,name=unit_id,json=unitId,proto3" json:"unit_id,omitempty"`
+	// The ID of the user to report on.
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// The start of the window, occurrences starting at or after it count.
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// The end of the window, occurrences starting before it count. The window
+	// spans at most 366 days.
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMemberAttendanceRequest) Reset() {
+	*x = GetMemberAttendanceRequest{}
+	mi := &file_milsimtools_events_v1_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMemberAttendanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMemberAttendanceRequest) ProtoMessage() {}
+
+func (x *GetMemberAttendanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_milsimtools_events_v1_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMemberAttendanceRequest.ProtoReflect.Descriptor instead.
+func (*GetMemberAttendanceRequest) Descriptor() ([]byte, []int) {
+	return file_milsimtools_events_v1_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetMemberAttendanceRequest) GetUnitId() string {
+	if x != nil {
+		return x.UnitId
+	}
+	return ""
+}
+
+func (x *GetMemberAttendanceRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetMemberAttendanceRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *GetMemberAttendanceRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+type DeleteUnitEventsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the unit to delete the events of.
+	UnitId        string `protobuf:"bytes,1,opt,name=unit_id,json=unitId,proto3" json:"unit_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUnitEventsRequest) Reset() {
+	*x = DeleteUnitEventsRequest{}
+	mi := &file_milsimtools_events_v1_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUnitEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUnitEventsRequest) ProtoMessage() {}
+
+func (x *DeleteUnitEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_milsimtools_events_v1_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUnitEventsRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUnitEventsRequest) Descriptor() ([]byte, []int) {
+	return file_milsimtools_events_v1_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *DeleteUnitEventsRequest) GetUnitId() string {
+	if x != nil {
+		return x.UnitId
+	}
+	return ""
+}
+
+var File_milsimtools_events_v1_service_proto protoreflect.FileDescriptor
+
+const file_milsimtools_events_v1_service_proto_rawDesc = "" +
+	"\n" +
+	"#milsimtools/events/v1/service.proto\x12\x15milsimtools.events.v1\x1a\"milsimtools/events/v1/events.proto\x1a milsimtools/authz/v1/authz.proto\x1a\x1bbuf/validate/validate.proto\x1a google/protobuf/field_mask.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/api/annotations.proto\"P\n" +
+	"\x12CreateEventRequest\x12:\n" +
+	"\x05event\x18\x01 \x01(\v2\x1c.milsimtools.events.v1.EventB\x06\xbaH\x03\xc8\x01\x01R\x05event\"J\n" +
+	"\x0fGetEventRequest\x12\x1f\n" +
+	"\aunit_id\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x06unitId\x12\x16\n" +
+	"\x02id\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x02id\"\xac\x01\n" +
+	"\x11ListEventsRequest\x12\x1f\n" +
+	"\aunit_id\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x06unitId\x12$\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\a\xbaH\x04\x1a\x02\x18dR\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12\x19\n" +
+	"\border_by\x18\x04 \x01(\tR\aorderBy\x12\x16\n" +
+	"\x06filter\x18\x05 \x01(\tR\x06filter\"r\n" +
+	"\x12ListEventsResponse\x124\n" +
+	"\x06events\x18\x01 \x03(\v2\x1c.milsimtools.events.v1.EventR\x06events\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x8d\x01\n" +
+	"\x12UpdateEventRequest\x12:\n" +
+	"\x05event\x18\x01 \x01(\v2\x1c.milsimtools.events.v1.EventB\x06\xbaH\x03\xd8\x01\x03R\x05event\x12;\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"M\n" +
+	"\x12DeleteEventRequest\x12\x1f\n" +
+	"\aunit_id\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x06unitId\x12\x16\n" +
+	"\x02id\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x02id\"\xb3\x02\n" +
+	"\x13RespondEventRequest\x12\x1f\n" +
+	"\aunit_id\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x06unitId\x12!\n" +
+	"\bevent_id\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\aeventId\x12\x1f\n" +
+	"\auser_id\x18\x03 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x06userId\x12N\n" +
+	"\x06status\x18\x04 \x01(\x0e2*.milsimtools.events.v1.EventResponseStatusB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\x06status\x12\"\n" +
+	"\acomment\x18\x05 \x01(\tB\b\xbaH\x05r\x03\x18\xf4\x03R\acomment\x12C\n" +
+	"\x0foccurrence_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x0eoccurrenceTime\"\x81\x02\n" +
+	"\x19ListEventResponsesRequest\x12\x1f\n" +
+	"\aunit_id\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x06unitId\x12!\n" +
+	"\bevent_id\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\aeventId\x12$\n" +
+	"\tpage_size\x18\x03 \x01(\x05B\a\xbaH\x04\x1a\x02\x18dR\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\x12\x16\n" +
+	"\x06filter\x18\x05 \x01(\tR\x06filter\x12C\n" +
+	"\x0foccurrence_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x0eoccurrenceTime\"\xd8\x01\n" +
+	"\x1aListEventResponsesResponse\x12B\n" +
+	"\tresponses\x18\x01 \x03(\v2$.milsimtools.events.v1.EventResponseR\tresponses\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1c\n" +
+	"\tattending\x18\x03 \x01(\x05R\tattending\x12\x14\n" +
+	"\x05maybe\x18\x04 \x01(\x05R\x05maybe\x12\x1a\n" +
+	"\bdeclined\x18\x05 \x01(\x05R\bdeclined\"\xc0\x01\n" +
+	"\x1bListEventOccurrencesRequest\x12\x1f\n" +
+	"\aunit_id\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x06unitId\x12A\n" +
+	"\n" +
+	"start_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\tstartTime\x12=\n" +
+	"\bend_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\aendTime\"h\n" +
+	"\x1cListEventOccurrencesResponse\x12H\n" +
+	"\voccurrences\x18\x01 \x03(\v2&.milsimtools.events.v1.EventOccurrenceR\voccurrences\"\x95\x01\n" +
+	"\x1eOverrideEventOccurrenceRequest\x12\x1f\n" +
+	"\aunit_id\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x06unitId\x12R\n" +
+	"\boverride\x18\x02 \x01(\v2..milsimtools.events.v1.EventOccurrenceOverrideB\x06\xbaH\x03\xc8\x01\x01R\boverride\"\xae\x01\n" +
+	"\x1bResetEventOccurrenceRequest\x12\x1f\n" +
+	"\aunit_id\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x06unitId\x12!\n" +
+	"\bevent_id\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\aeventId\x12K\n" +
+	"\x0foccurrence_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\x0eoccurrenceTime\"R\n" +
+	"\x16CreateEventFeedRequest\x12\x1f\n" +
+	"\auser_id\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x06userId\x12\x17\n" +
+	"\aunit_id\x18\x02 \x01(\tR\x06unitId\"8\n" +
+	"\x15ListEventFeedsRequest\x12\x1f\n" +
+	"\auser_id\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x06userId\"P\n" +
+	"\x16ListEventFeedsResponse\x126\n" +
+	"\x05feeds\x18\x01 \x03(\v2 .milsimtools.events.v1.EventFeedR\x05feeds\"Q\n" +
+	"\x16DeleteEventFeedRequest\x12\x1f\n" +
+	"\auser_id\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x06userId\x12\x16\n" +
+	"\x02id\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x02id\"\xf7\x01\n" +
+	"\x1cRecordEventAttendanceRequest\x12\x1f\n" +
+	"\aunit_id\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x06unitId\x12!\n" +
+	"\bevent_id\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\aeventId\x12C\n" +
+	"\x0foccurrence_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x0eoccurrenceTime\x12N\n" +
+	"\arecords\x18\x04 \x03(\v2'.milsimtools.events.v1.AttendanceRecordB\v\xbaH\b\x92\x01\x05\b\x01\x10\xf4\x03R\arecords\"\x9e\x01\n" +
+	"\x10AttendanceRecord\x12\x1f\n" +
+	"\auser_id\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x06userId\x12K\n" +
+	"\x06status\x18\x02 \x01(\x0e2'.milsimtools.events.v1.AttendanceStatusB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\x06status\x12\x1c\n" +
+	"\x04note\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\xf4\x03R\x04note\"g\n" +
+	"\x1dRecordEventAttendanceResponse\x12F\n" +
+	"\n" +
+	"attendance\x18\x01 \x03(\v2&.milsimtools.events.v1.EventAttendanceR\n" +
+	"attendance\"\x82\x02\n" +
+	"\x1aListEventAttendanceRequest\x12\x1f\n" +
+	"\aunit_id\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x06unitId\x12!\n" +
+	"\bevent_id\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\aeventId\x12C\n" +
+	"\x0foccurrence_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x0eoccurrenceTime\x12$\n" +
+	"\tpage_size\x18\x04 \x01(\x05B\a\xbaH\x04\x1a\x02\x18dR\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x05 \x01(\tR\tpageToken\x12\x16\n" +
+	"\x06filter\x18\x06 \x01(\tR\x06filter\"\xce\x01\n" +
+	"\x1bListEventAttendanceResponse\x12F\n" +
+	"\n" +
+	"attendance\x18\x01 \x03(\v2&.milsimtools.events.v1.EventAttendanceR\n" +
+	"attendance\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12?\n" +
+	"\x06totals\x18\x03 \x01(\v2'.milsimtools.events.v1.AttendanceTotalsR\x06totals\"\xc8\x01\n" +
+	"\x1cDeleteEventAttendanceRequest\x12\x1f\n" +
+	"\aunit_id\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x06unitId\x12!\n" +
+	"\bevent_id\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\aeventId\x12C\n" +
+	"\x0foccurrence_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x0eoccurrenceTime\x12\x1f\n" +
+	"\auser_id\x18\x04 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x06userId\"\xfd\x01\n" +
+	"\x1aGetAttendanceReportRequest\x12\x1f\n" +
+	"\aunit_id\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x06unitId\x12A\n" +
+	"\n" +
+	"start_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\tstartTime\x12=\n" +
+	"\bend_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\aendTime\x122\n" +
+	"\x05below\x18\x04 \x01(\x01B\x17\xbaH\x14\x12\x12\x19\x00\x00\x00\x00\x00\x00\xf0?!\x00\x00\x00\x00\x00\x00\x00\x00H\x00R\x05below\x88\x01\x01B\b\n" +
+	"\x06_below\"\xa1\x01\n" +
+	"\x1bGetAttendanceReportResponse\x12A\n" +
+	"\amembers\x18\x01 \x03(\v2'.milsimtools.events.v1.MemberAttendanceR\amembers\x12?\n" +
+	"\x06totals\x18\x02 \x01(\v2'.milsimtools.events.v1.AttendanceTotalsR\x06totals\"\xe0\x01\n" +
+	"\x1aGetMemberAttendanceRequest\x12\x1f\n" +
+	"\aunit_id\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x06unitId\x12\x1f\n" +
+	"\auser_id\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x06userId\x12A\n" +
+	"\n" +
+	"start_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\tstartTime\x12=\n" +
+	"\bend_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\aendTime\":\n" +
+	"\x17DeleteUnitEventsRequest\x12\x1f\n" +
+	"\aunit_id\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x06unitId2\xd7\x1a\n" +
+	"\rEventsService\x12\x9f\x01\n" +
+	"\vCreateEvent\x12).milsimtools.events.v1.CreateEventRequest\x1a\x1c.milsimtools.events.v1.Event\"G\xc2\xf3\x18\x12\b\x80\x02\x12\revent.unit_id\x82\xd3\xe4\x93\x02+:\x05event\"\"/v1/events/by-unit/{event.unit_id}\x12\x82\x01\n" +
+	"\bGetEvent\x12&.milsimtools.events.v1.GetEventRequest\x1a\x1c.milsimtools.events.v1.Event\"0\xc2\xf3\x18\x00\x82\xd3\xe4\x93\x02#\x12!/v1/events/by-unit/{unit_id}/{id}\x90\x02\x01\x12\x8e\x01\n" +
+	"\n" +
+	"ListEvents\x12(.milsimtools.events.v1.ListEventsRequest\x1a).milsimtools.events.v1.ListEventsResponse\"+\xc2\xf3\x18\x00\x82\xd3\xe4\x93\x02\x1e\x12\x1c/v1/events/by-unit/{unit_id}\x90\x02\x01\x12\xaa\x01\n" +
+	"\vUpdateEvent\x12).milsimtools.events.v1.UpdateEventRequest\x1a\x1c.milsimtools.events.v1.Event\"R\xc2\xf3\x18\x12\b\x80\x02\x12\revent.unit_id\x82\xd3\xe4\x93\x026:\x05event2-/v1/events/by-unit/{event.unit_id}/{event.id}\x12\x8b\x01\n" +
+	"\vDeleteEvent\x12).milsimtools.events.v1.DeleteEventRequest\x1a\x16.google.protobuf.Empty\"9\xc2\xf3\x18\f\b\x80\x02\x12\aunit_id\x82\xd3\xe4\x93\x02#*!/v1/events/by-unit/{unit_id}/{id}\x12\xbb\x01\n" +
+	"\fRespondEvent\x12*.milsimtools.events.v1.RespondEventRequest\x1a$.milsimtools.events.v1.EventResponse\"Y\xc2\xf3\x18\f\b\x80\x01\x12\aunit_id\x82\xd3\xe4\x93\x02@:\x01*\x1a;/v1/events/by-unit/{unit_id}/{event_id}/responses/{user_id}\x90\x02\x02\x12\xc6\x01\n" +
+	"\x12ListEventResponses\x120.milsimtools.events.v1.ListEventResponsesRequest\x1a1.milsimtools.events.v1.ListEventResponsesResponse\"K\xc2\xf3\x18\v\b@\x12\aunit_id\x82\xd3\xe4\x93\x023\x121/v1/events/by-unit/{unit_id}/{event_id}/responses\x90\x02\x01\x12\xb8\x01\n" +
+	"\x14ListEventOccurrences\x122.milsimtools.events.v1.ListEventOccurrencesRequest\x1a3.milsimtools.events.v1.ListEventOccurrencesResponse\"7\xc2\xf3\x18\x00\x82\xd3\xe4\x93\x02*\x12(/v1/events/by-unit/{unit_id}/occurrences\x90\x02\x01\x12\xec\x01\n" +
+	"\x17OverrideEventOccurrence\x125.milsimtools.events.v1.OverrideEventOccurrenceRequest\x1a..milsimtools.events.v1.EventOccurrenceOverride\"j\xc2\xf3\x18\f\b\x80\x02\x12\aunit_id\x82\xd3\xe4\x93\x02Q:\boverride\"E/v1/events/by-unit/{unit_id}/{override.event_id}/occurrences:override\x90\x02\x02\x12\xbb\x01\n" +
+	"\x14ResetEventOccurrence\x122.milsimtools.events.v1.ResetEventOccurrenceRequest\x1a\x16.google.protobuf.Empty\"W\xc2\xf3\x18\f\b\x80\x02\x12\aunit_id\x82\xd3\xe4\x93\x02>:\x01*\"9/v1/events/by-unit/{unit_id}/{event_id}/occurrences:reset\x90\x02\x02\x12\x9e\x01\n" +
+	"\x0fCreateEventFeed\x12-.milsimtools.events.v1.CreateEventFeedRequest\x1a .milsimtools.events.v1.EventFeed\":\xc2\xf3\x18\t\x1a\auser_id\x82\xd3\xe4\x93\x02':\x01*\"\"/v1/events/feeds/by-user/{user_id}\x12\xa9\x01\n" +
+	"\x0eListEventFeeds\x12,.milsimtools.events.v1.ListEventFeedsRequest\x1a-.milsimtools.events.v1.ListEventFeedsResponse\":\xc2\xf3\x18\t\x1a\auser_id\x82\xd3\xe4\x93\x02$\x12\"/v1/events/feeds/by-user/{user_id}\x90\x02\x01\x12\x96\x01\n" +
+	"\x0fDeleteEventFeed\x12-.milsimtools.events.v1.DeleteEventFeedRequest\x1a\x16.google.protobuf.Empty\"<\xc2\xf3\x18\t\x1a\auser_id\x82\xd3\xe4\x93\x02)*'/v1/events/feeds/by-user/{user_id}/{id}\x12\xd4\x01\n" +
+	"\x15RecordEventAttendance\x123.milsimtools.events.v1.RecordEventAttendanceRequest\x1a4.milsimtools.events.v1.RecordEventAttendanceResponse\"P\xc2\xf3\x18\f\b\x80\x02\x12\aunit_id\x82\xd3\xe4\x93\x027:\x01*\"2/v1/events/by-unit/{unit_id}/{event_id}/attendance\x90\x02\x02\x12\xcb\x01\n" +
+	"\x13ListEventAttendance\x121.milsimtools.events.v1.ListEventAttendanceRequest\x1a2.milsimtools.events.v1.ListEventAttendanceResponse\"M\xc2\xf3\x18\f\b\x80\x02\x12\aunit_id\x82\xd3\xe4\x93\x024\x122/v1/events/by-unit/{unit_id}/{event_id}/attendance\x90\x02\x01\x12\xba\x01\n" +
+	"\x15DeleteEventAttendance\x123.milsimtools.events.v1.DeleteEventAttendanceRequest\x1a\x16.google.protobuf.Empty\"T\xc2\xf3\x18\f\b\x80\x02\x12\aunit_id\x82\xd3\xe4\x93\x02>*</v1/events/by-unit/{unit_id}/{event_id}/attendance/{user_id}\x12\xbf\x01\n" +
+	"\x13GetAttendanceReport\x121.milsimtools.events.v1.GetAttendanceReportRequest\x1a2.milsimtools.events.v1.GetAttendanceReportResponse\"A\xc2\xf3\x18\v\b\x04\x12\aunit_id\x82\xd3\xe4\x93\x02)\x12'/v1/events/by-unit/{unit_id}/attendance\x90\x02\x01\x12\xc7\x01\n" +
+	"\x13GetMemberAttendance\x121.milsimtools.events.v1.GetMemberAttendanceRequest\x1a'.milsimtools.events.v1.MemberAttendance\"T\xc2\xf3\x18\x14\b\x04\x12\aunit_id\x1a\auser_id\x82\xd3\xe4\x93\x023\x121/v1/events/by-unit/{unit_id}/attendance/{user_id}\x90\x02\x01\x12\x8f\x01\n" +
+	"\x10DeleteUnitEvents\x12..milsimtools.events.v1.DeleteUnitEventsRequest\x1a\x16.google.protobuf.Empty\"3\xc2\xf3\x18\v\b\x01\x12\aunit_id\x82\xd3\xe4\x93\x02\x1e*\x1c/v1/events/by-unit/{unit_id}B\xea\x01\n" +
+	"\x19com.milsimtools.events.v1B\fServiceProtoP\x01ZIgithub.com/milsim-tools/pincer/pkg/api/gen/milsimtools/events/v1;eventsv1\xa2\x02\x03MEX\xaa\x02\x15Milsimtools.Events.V1\xca\x02\x15Milsimtools\\Events\\V1\xe2\x02!Milsimtools\\Events\\V1\\GPBMetadata\xea\x02\x17Milsimtools::Events::V1b\x06proto3"
+
+var (
+	file_milsimtools_events_v1_service_proto_rawDescOnce sync.Once
+	file_milsimtools_events_v1_service_proto_rawDescData []byte
+)
+
+func file_milsimtools_events_v1_service_proto_rawDescGZIP() []byte {
+	file_milsimtools_events_v1_service_proto_rawDescOnce.Do(func() {
+		file_milsimtools_events_v1_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_milsimtools_events_v1_service_proto_rawDesc), len(file_milsimtools_events_v1_service_proto_rawDesc)))
+	})
+	return file_milsimtools_events_v1_service_proto_rawDescData
+}
+
+var file_milsimtools_events_v1_service_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
+var file_milsimtools_events_v1_service_proto_goTypes = []any{
+	(*CreateEventRequest)(nil),             // 0: milsimtools.events.v1.CreateEventRequest
+	(*GetEventRequest)(nil),                // 1: milsimtools.events.v1.GetEventRequest
+	(*ListEventsRequest)(nil),              // 2: milsimtools.events.v1.ListEventsRequest
+	(*ListEventsResponse)(nil),             // 3: milsimtools.events.v1.ListEventsResponse
+	(*UpdateEventRequest)(nil),             // 4: milsimtools.events.v1.UpdateEventRequest
+	(*DeleteEventRequest)(nil),             // 5: milsimtools.events.v1.DeleteEventRequest
+	(*RespondEventRequest)(nil),            // 6: milsimtools.events.v1.RespondEventRequest
+	(*ListEventResponsesRequest)(nil),      // 7: milsimtools.events.v1.ListEventResponsesRequest
+	(*ListEventResponsesResponse)(nil),     // 8: milsimtools.events.v1.ListEventResponsesResponse
+	(*ListEventOccurrencesRequest)(nil),    // 9: milsimtools.events.v1.ListEventOccurrencesRequest
+	(*ListEventOccurrencesResponse)(nil),   // 10: milsimtools.events.v1.ListEventOccurrencesResponse
+	(*OverrideEventOccurrenceRequest)(nil), // 11: milsimtools.events.v1.OverrideEventOccurrenceRequest
+	(*ResetEventOccurrenceRequest)(nil),    // 12: milsimtools.events.v1.ResetEventOccurrenceRequest
+	(*CreateEventFeedRequest)(nil),         // 13: milsimtools.events.v1.CreateEventFeedRequest
+	(*ListEventFeedsRequest)(nil),          // 14: milsimtools.events.v1.ListEventFeedsRequest
+	(*ListEventFeedsResponse)(nil),         // 15: milsimtools.events.v1.ListEventFeedsResponse
+	(*DeleteEventFeedRequest)(nil),         // 16: milsimtools.events.v1.DeleteEventFeedRequest
+	(*RecordEventAttendanceRequest)(nil),   // 17: milsimtools.events.v1.RecordEventAttendanceRequest
+	(*AttendanceRecord)(nil),               // 18: milsimtools.events.v1.AttendanceRecord
+	(*RecordEventAttendanceResponse)(nil),  // 19: milsimtools.events.v1.RecordEventAttendanceResponse
+	(*ListEventAttendanceRequest)(nil),     // 20: milsimtools.events.v1.ListEventAttendanceRequest
+	(*ListEventAttendanceResponse)(nil),    // 21: milsimtools.events.v1.ListEventAttendanceResponse
+	(*DeleteEventAttendanceRequest)(nil),   // 22: milsimtools.events.v1.DeleteEventAttendanceRequest
+	(*GetAttendanceReportRequest)(nil),     // 23: milsimtools.events.v1.GetAttendanceReportRequest
+	(*GetAttendanceReportResponse)(nil),    // 24: milsimtools.events.v1.GetAttendanceReportResponse
+	(*GetMemberAttendanceRequest)(nil),     // 25: milsimtools.events.v1.GetMemberAttendanceRequest
+	(*DeleteUnitEventsRequest)(nil),        // 26: milsimtools.events.v1.DeleteUnitEventsRequest
+	(*Event)(nil),                          // 27: milsimtools.events.v1.Event
+	(*fieldmaskpb.FieldMask)(nil),          // 28: google.protobuf.FieldMask
+	(EventResponseStatus)(0),               // 29: milsimtools.events.v1.EventResponseStatus
+	(*timestamppb.Timestamp)(nil),          // 30: google.protobuf.Timestamp
+	(*EventResponse)(nil),                  // 31: milsimtools.events.v1.EventResponse
+	(*EventOccurrence)(nil),                // 32: milsimtools.events.v1.EventOccurrence
+	(*EventOccurrenceOverride)(nil),        // 33: milsimtools.events.v1.EventOccurrenceOverride
+	(*EventFeed)(nil),                      // 34: milsimtools.events.v1.EventFeed
+	(AttendanceStatus)(0),                  // 35: milsimtools.events.v1.AttendanceStatus
+	(*EventAttendance)(nil),                // 36: milsimtools.events.v1.EventAttendance
+	(*AttendanceTotals)(nil),               // 37: milsimtools.events.v1.AttendanceTotals
+	(*MemberAttendance)(nil),               // 38: milsimtools.events.v1.MemberAttendance
+	(*emptypb.Empty)(nil),                  // 39: google.protobuf.Empty
+}
+var file_milsimtools_events_v1_service_proto_depIdxs = []int32{
+	27, // 0: milsimtools.events.v1.CreateEventRequest.event:type_name -> milsimtools.events.v1.Event
+	27, // 1: milsimtools.events.v1.ListEventsResponse.events:type_name -> milsimtools.events.v1.Event
+	27, // 2: milsimtools.events.v1.UpdateEventRequest.event:type_name -> milsimtools.events.v1.Event
+	28, // 3: milsimtools.events.v1.UpdateEventRequest.update_mask:type_name -> google.protobuf.FieldMask
+	29, // 4: milsimtools.events.v1.RespondEventRequest.status:type_name -> milsimtools.events.v1.EventResponseStatus
+	30, // 5: milsimtools.events.v1.RespondEventRequest.occurrence_time:type_name -> google.protobuf.Timestamp
+	30, // 6: milsimtools.events.v1.ListEventResponsesRequest.occurrence_time:type_name -> google.protobuf.Timestamp
+	31, // 7: milsimtools.events.v1.ListEventResponsesResponse.responses:type_name -> milsimtools.events.v1.EventResponse
+	30, // 8: milsimtools.events.v1.ListEventOccurrencesRequest.start_time:type_name -> google.protobuf.Timestamp
+	30, // 9: milsimtools.events.v1.ListEventOccurrencesRequest.end_time:type_name -> google.protobuf.Timestamp
+	32, // 10: milsimtools.events.v1.ListEventOccurrencesResponse.occurrences:type_name -> milsimtools.events.v1.EventOccurrence
+	33, // 11: milsimtools.events.v1.OverrideEventOccurrenceRequest.override:type_name -> milsimtools.events.v1.EventOccurrenceOverride
+	30, // 12: milsimtools.events.v1.ResetEventOccurrenceRequest.occurrence_time:type_name -> google.protobuf.Timestamp
+	34, // 13: milsimtools.events.v1.ListEventFeedsResponse.feeds:type_name -> milsimtools.events.v1.EventFeed
+	30, // 14: milsimtools.events.v1.RecordEventAttendanceRequest.occurrence_time:type_name -> google.protobuf.Timestamp
+	18, // 15: milsimtools.events.v1.RecordEventAttendanceRequest.records:type_name -> milsimtools.events.v1.AttendanceRecord
+	35, // 16: milsimtools.events.v1.AttendanceRecord.status:type_name -> milsimtools.events.v1.AttendanceStatus
+	36, // 17: milsimtools.events.v1.RecordEventAttendanceResponse.attendance:type_name -> milsimtools.events.v1.EventAttendance
+	30, // 18: milsimtools.events.v1.ListEventAttendanceRequest.occurrence_time:type_name -> google.protobuf.Timestamp
+	36, // 19: milsimtools.events.v1.ListEventAttendanceResponse.attendance:type_name -> milsimtools.events.v1.EventAttendance
+	37, // 20: milsimtools.events.v1.ListEventAttendanceResponse.totals:type_name -> milsimtools.events.v1.AttendanceTotals
+	30, // 21: milsimtools.events.v1.DeleteEventAttendanceRequest.occurrence_time:type_name -> google.protobuf.Timestamp
+	30, // 22: milsimtools.events.v1.GetAttendanceReportRequest.start_time:type_name -> google.protobuf.Timestamp
+	30, // 23: milsimtools.events.v1.GetAttendanceReportRequest.end_time:type_name -> google.protobuf.Timestamp
+	38, // 24: milsimtools.events.v1.GetAttendanceReportResponse.members:type_name -> milsimtools.events.v1.MemberAttendance
+	37, // 25: milsimtools.events.v1.GetAttendanceReportResponse.totals:type_name -> milsimtools.events.v1.AttendanceTotals
+	30, // 26: milsimtools.events.v1.GetMemberAttendanceRequest.start_time:type_name -> google.protobuf.Timestamp
+	30, // 27: milsimtools.events.v1.GetMemberAttendanceRequest.end_time:type_name -> google.protobuf.Timestamp
+	0,  // 28: milsimtools.events.v1.EventsService.CreateEvent:input_type -> milsimtools.events.v1.CreateEventRequest
+	1,  // 29: milsimtools.events.v1.EventsService.GetEvent:input_type -> milsimtools.events.v1.GetEventRequest
+	2,  // 30: milsimtools.events.v1.EventsService.ListEvents:input_type -> milsimtools.events.v1.ListEventsRequest
+	4,  // 31: milsimtools.events.v1.EventsService.UpdateEvent:input_type -> milsimtools.events.v1.UpdateEventRequest
+	5,  // 32: milsimtools.events.v1.EventsService.DeleteEvent:input_type -> milsimtools.events.v1.DeleteEventRequest
+	6,  // 33: milsimtools.events.v1.EventsService.RespondEvent:input_type -> milsimtools.events.v1.RespondEventRequest
+	7,  // 34: milsimtools.events.v1.EventsService.ListEventResponses:input_type -> milsimtools.events.v1.ListEventResponsesRequest
+	9,  // 35: milsimtools.events.v1.EventsService.ListEventOccurrences:input_type -> milsimtools.events.v1.ListEventOccurrencesRequest
+	11, // 36: milsimtools.events.v1.EventsService.OverrideEventOccurrence:input_type -> milsimtools.events.v1.OverrideEventOccurrenceRequest
+	12, // 37: milsimtools.events.v1.EventsService.ResetEventOccurrence:input_type -> milsimtools.events.v1.ResetEventOccurrenceRequest
+	13, // 38: milsimtools.events.v1.EventsService.CreateEventFeed:input_type -> milsimtools.events.v1.CreateEventFeedRequest
+	14, // 39: milsimtools.events.v1.EventsService.ListEventFeeds:input_type -> milsimtools.events.v1.ListEventFeedsRequest
+	16, // 40: milsimtools.events.v1.EventsService.DeleteEventFeed:input_type -> milsimtools.events.v1.DeleteEventFeedRequest
+	17, // 41: milsimtools.events.v1.EventsService.RecordEventAttendance:input_type -> milsimtools.events.v1.RecordEventAttendanceRequest
+	20, // 42: milsimtools.events.v1.EventsService.ListEventAttendance:input_type -> milsimtools.events.v1.ListEventAttendanceRequest
+	22, // 43: milsimtools.events.v1.EventsService.DeleteEventAttendance:input_type -> milsimtools.events.v1.DeleteEventAttendanceRequest
+	23, // 44: milsimtools.events.v1.EventsService.GetAttendanceReport:input_type -> milsimtools.events.v1.GetAttendanceReportRequest
+	25, // 45: milsimtools.events.v1.EventsService.GetMemberAttendance:input_type -> milsimtools.events.v1.GetMemberAttendanceRequest
+	26, // 46: milsimtools.events.v1.EventsService.DeleteUnitEvents:input_type -> milsimtools.events.v1.DeleteUnitEventsRequest
+	27, // 47: milsimtools.events.v1.EventsService.CreateEvent:output_type -> milsimtools.events.v1.Event
+	27, // 48: milsimtools.events.v1.EventsService.GetEvent:output_type -> milsimtools.events.v1.Event
+	3,  // 49: milsimtools.events.v1.EventsService.ListEvents:output_type -> milsimtools.events.v1.ListEventsResponse
+	27, // 50: milsimtools.events.v1.EventsService.UpdateEvent:output_type -> milsimtools.events.v1.Event
+	39, // 51: milsimtools.events.v1.EventsService.DeleteEvent:output_type -> google.protobuf.Empty
+	31, // 52: milsimtools.events.v1.EventsService.RespondEvent:output_type -> milsimtools.events.v1.EventResponse
+	8,  // 53: milsimtools.events.v1.EventsService.ListEventResponses:output_type -> milsimtools.events.v1.ListEventResponsesResponse
+	10, // 54: milsimtools.events.v1.EventsService.ListEventOccurrences:output_type -> milsimtools.events.v1.ListEventOccurrencesResponse
+	33, // 55: milsimtools.events.v1.EventsService.OverrideEventOccurrence:output_type -> milsimtools.events.v1.EventOccurrenceOverride
+	39, // 56: milsimtools.events.v1.EventsService.ResetEventOccurrence:output_type -> google.protobuf.Empty
+	34, // 57: milsimtools.events.v1.EventsService.CreateEventFeed:output_type -> milsimtools.events.v1.EventFeed
+	15, // 58: milsimtools.events.v1.EventsService.ListEventFeeds:output_type -> milsimtools.events.v1.ListEventFeedsResponse
+	39, // 59: milsimtools.events.v1.EventsService.DeleteEventFeed:output_type -> google.protobuf.Empty
+	19, // 60: milsimtools.events.v1.EventsService.RecordEventAttendance:output_type -> milsimtools.events.v1.RecordEventAttendanceResponse
+	21, // 61: milsimtools.events.v1.EventsService.ListEventAttendance:output_type -> milsimtools.events.v1.ListEventAttendanceResponse
+	39, // 62: milsimtools.events.v1.EventsService.DeleteEventAttendance:output_type -> google.protobuf.Empty
+	24, // 63: milsimtools.events.v1.EventsService.GetAttendanceReport:output_type -> milsimtools.events.v1.GetAttendanceReportResponse
+	38, // 64: milsimtools.events.v1.EventsService.GetMemberAttendance:output_type -> milsimtools.events.v1.MemberAttendance
+	39, // 65: milsimtools.events.v1.EventsService.DeleteUnitEvents:output_type -> google.protobuf.Empty
+	47, // [47:66] is the sub-list for method output_type
+	28, // [28:47] is the sub-list for method input_type
+	28, // [28:28] is the sub-list for extension type_name
+	28, // [28:28] is the sub-list for extension extendee
+	0,  // [0:28] is the sub-list for field type_name
+}
+
+func init() { file_milsimtools_events_v1_service_proto_init() }
+func file_milsimtools_events_v1_service_proto_init() {
+	if File_milsimtools_events_v1_service_proto != nil {
+		return
+	}
+	file_milsimtools_events_v1_events_proto_init()
+	file_milsimtools_events_v1_service_proto_msgTypes[23].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_milsimtools_events_v1_service_proto_rawDesc), len(file_milsimtools_events_v1_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   27,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_milsimtools_events_v1_service_proto_goTypes,
+		DependencyIndexes: file_milsimtools_events_v1_service_proto_depIdxs,
+		MessageInfos:      file_milsimtools_events_v1_service_proto_msgTypes,
+	}.Build()
+	File_milsimtools_events_v1_service_proto = out.File
+	file_milsimtools_events_v1_service_proto_goTypes = nil
+	file_milsimtools_events_v1_service_proto_depIdxs = nil
+}
@@ -0,0 +1,862 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: milsimtools/events/v1/service.proto
+
+package eventsv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	EventsService_CreateEvent_FullMethodName             = "/milsimtools.events.v1.EventsService/CreateEvent"
+	EventsService_GetEvent_FullMethodName                = "/milsimtools.events.v1.EventsService/GetEvent"
+	EventsService_ListEvents_FullMethodName              = "/milsimtools.events.v1.EventsService/ListEvents"
+	EventsService_UpdateEvent_FullMethodName             = "/milsimtools.events.v1.EventsService/UpdateEvent"
+	EventsService_DeleteEvent_FullMethodName             = "/milsimtools.events.v1.EventsService/DeleteEvent"
+	EventsService_RespondEvent_FullMethodName            = "/milsimtools.events.v1.EventsService/RespondEvent"
+	EventsService_ListEventResponses_FullMethodName      = "/milsimtools.events.v1.EventsService/ListEventResponses"
+	EventsService_ListEventOccurrences_FullMethodName    = "/milsimtools.events.v1.EventsService/ListEventOccurrences"
+	EventsService_OverrideEventOccurrence_FullMethodName = "/milsimtools.events.v1.EventsService/OverrideEventOccurrence"
+	EventsService_ResetEventOccurrence_FullMethodName    = "/milsimtools.events.v1.EventsService/ResetEventOccurrence"
+	EventsService_CreateEventFeed_FullMethodName         = "/milsimtools.events.v1.EventsService/CreateEventFeed"
+	EventsService_ListEventFeeds_FullMethodName          = "/milsimtools.events.v1.EventsService/ListEventFeeds"
+	EventsService_DeleteEventFeed_FullMethodName         = "/milsimtools.events.v1.EventsService/DeleteEventFeed"
+	EventsService_RecordEventAttendance_FullMethodName   = "/milsimtools.events.v1.EventsService/RecordEventAttendance"
+	EventsService_ListEventAttendance_FullMethodName     = "/milsimtools.events.v1.EventsService/ListEventAttendance"
+	EventsService_DeleteEventAttendance_FullMethodName   = "/milsimtools.events.v1.EventsService/DeleteEventAttendance"
+	EventsService_GetAttendanceReport_FullMethodName     = "/milsimtools.events.v1.EventsService/GetAttendanceReport"
+	EventsService_GetMemberAttendance_FullMethodName     = "/milsimtools.events.v1.EventsService/GetMemberAttendance"
+	EventsService_DeleteUnitEvents_FullMethodName        = "/milsimtools.events.v1.EventsService/DeleteUnitEvents"
+)
+
+// EventsServiceClient is the client API for EventsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type EventsServiceClient interface {
+	// Creates an event of a unit.
+	CreateEvent(ctx context.Context, in *CreateEventRequest, opts ...grpc.CallOption) (*Event, error)
+	// Gets an event. Public events can be read by anyone signed in, other
+	// events only by members who can view the unit's events.
+	GetEvent(ctx context.Context, in *GetEventRequest, opts ...grpc.CallOption) (*Event, error)
+	// Lists the events of a unit.
+	ListEvents(ctx context.Context, in *ListEventsRequest, opts ...grpc.CallOption) (*ListEventsResponse, error)
+	// Updates an event. When only the start of the event moves, the changes to,
+	// responses to and attendance at its occurrences move with them, as do its
+	// cancelled occurrences unless the recurrence is set too. Changes to and
+	// responses to occurrences the event no longer has are dropped, and the
+	// update fails with FAILED_PRECONDITION, listing the occurrences, if
+	// attendance was recorded at any of them.
+	UpdateEvent(ctx context.Context, in *UpdateEventRequest, opts ...grpc.CallOption) (*Event, error)
+	// Deletes an event, and the responses to it and attendance at it.
+	DeleteEvent(ctx context.Context, in *DeleteEventRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Responds to an event that has not ended yet.
+	RespondEvent(ctx context.Context, in *RespondEventRequest, opts ...grpc.CallOption) (*EventResponse, error)
+	// Lists the responses to an event.
+	ListEventResponses(ctx context.Context, in *ListEventResponsesRequest, opts ...grpc.CallOption) (*ListEventResponsesResponse, error)
+	// Lists the occurrences of the events of a unit within a window, with
+	// recurring events expanded.
+	ListEventOccurrences(ctx context.Context, in *ListEventOccurrencesRequest, opts ...grpc.CallOption) (*ListEventOccurrencesResponse, error)
+	// Changes a single occurrence of a recurring event.
+	OverrideEventOccurrence(ctx context.Context, in *OverrideEventOccurrenceRequest, opts ...grpc.CallOption) (*EventOccurrenceOverride, error)
+	// Reverts the changes to a single occurrence of a recurring event.
+	ResetEventOccurrence(ctx context.Context, in *ResetEventOccurrenceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Creates a calendar feed of events for a user.
+	CreateEventFeed(ctx context.Context, in *CreateEventFeedRequest, opts ...grpc.CallOption) (*EventFeed, error)
+	// Lists the calendar feeds of a user.
+	ListEventFeeds(ctx context.Context, in *ListEventFeedsRequest, opts ...grpc.CallOption) (*ListEventFeedsResponse, error)
+	// Deletes a calendar feed, revoking its token.
+	DeleteEventFeed(ctx context.Context, in *DeleteEventFeedRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Records the attendance of members at an occurrence of an event that has
+	// started.
+	RecordEventAttendance(ctx context.Context, in *RecordEventAttendanceRequest, opts ...grpc.CallOption) (*RecordEventAttendanceResponse, error)
+	// Lists the attendance at an occurrence of an event.
+	ListEventAttendance(ctx context.Context, in *ListEventAttendanceRequest, opts ...grpc.CallOption) (*ListEventAttendanceResponse, error)
+	// Deletes the attendance of a member at an occurrence of an event.
+	DeleteEventAttendance(ctx context.Context, in *DeleteEventAttendanceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Reports the attendance of the members of a unit within a window.
+	GetAttendanceReport(ctx context.Context, in *GetAttendanceReportRequest, opts ...grpc.CallOption) (*GetAttendanceReportResponse, error)
+	// Reports the attendance of a member of a unit within a window. Members
+	// can see their own attendance.
+	GetMemberAttendance(ctx context.Context, in *GetMemberAttendanceRequest, opts ...grpc.CallOption) (*MemberAttendance, error)
+	// Deletes the events of a unit, when the unit is deleted.
+	DeleteUnitEvents(ctx context.Context, in *DeleteUnitEventsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type eventsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEventsServiceClient(cc grpc.ClientConnInterface) EventsServiceClient {
+	return &eventsServiceClient{cc}
+}
+
+func (c *eventsServiceClient) CreateEvent(ctx context.Context, in *CreateEventRequest, opts ...grpc.CallOption) (*Event, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Event)
+	err := c.cc.Invoke(ctx, EventsService_CreateEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventsServiceClient) GetEvent(ctx context.Context, in *GetEventRequest, opts ...grpc.CallOption) (*Event, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Event)
+	err := c.cc.Invoke(ctx, EventsService_GetEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventsServiceClient) ListEvents(ctx context.Context, in *ListEventsRequest, opts ...grpc.CallOption) (*ListEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEventsResponse)
+	err := c.cc.Invoke(ctx, EventsService_ListEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventsServiceClient) UpdateEvent(ctx context.Context, in *UpdateEventRequest, opts ...grpc.CallOption) (*Event, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Event)
+	err := c.cc.Invoke(ctx, EventsService_UpdateEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventsServiceClient) DeleteEvent(ctx context.Context, in *DeleteEventRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, EventsService_DeleteEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventsServiceClient) RespondEvent(ctx context.Context, in *RespondEventRequest, opts ...grpc.CallOption) (*EventResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EventResponse)
+	err := c.cc.Invoke(ctx, EventsService_RespondEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventsServiceClient) ListEventResponses(ctx context.Context, in *ListEventResponsesRequest, opts ...grpc.CallOption) (*ListEventResponsesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEventResponsesResponse)
+	err := c.cc.Invoke(ctx, EventsService_ListEventResponses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventsServiceClient) ListEventOccurrences(ctx context.Context, in *ListEventOccurrencesRequest, opts ...grpc.CallOption) (*ListEventOccurrencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEventOccurrencesResponse)
+	err := c.cc.Invoke(ctx, EventsService_ListEventOccurrences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventsServiceClient) OverrideEventOccurrence(ctx context.Context, in *OverrideEventOccurrenceRequest, opts ...grpc.CallOption) (*EventOccurrenceOverride, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EventOccurrenceOverride)
+	err := c.cc.Invoke(ctx, EventsService_OverrideEventOccurrence_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventsServiceClient) ResetEventOccurrence(ctx context.Context, in *ResetEventOccurrenceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, EventsService_ResetEventOccurrence_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventsServiceClient) CreateEventFeed(ctx context.Context, in *CreateEventFeedRequest, opts ...grpc.CallOption) (*EventFeed, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EventFeed)
+	err := c.cc.Invoke(ctx, EventsService_CreateEventFeed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventsServiceClient) ListEventFeeds(ctx context.Context, in *ListEventFeedsRequest, opts ...grpc.CallOption) (*ListEventFeedsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEventFeedsResponse)
+	err := c.cc.Invoke(ctx, EventsService_ListEventFeeds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventsServiceClient) DeleteEventFeed(ctx context.Context, in *DeleteEventFeedRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, EventsService_DeleteEventFeed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventsServiceClient) RecordEventAttendance(ctx context.Context, in *RecordEventAttendanceRequest, opts ...grpc.CallOption) (*RecordEventAttendanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecordEventAttendanceResponse)
+	err := c.cc.Invoke(ctx, EventsService_RecordEventAttendance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventsServiceClient) ListEventAttendance(ctx context.Context, in *ListEventAttendanceRequest, opts ...grpc.CallOption) (*ListEventAttendanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEventAttendanceResponse)
+	err := c.cc.Invoke(ctx, EventsService_ListEventAttendance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventsServiceClient) DeleteEventAttendance(ctx context.Context, in *DeleteEventAttendanceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, EventsService_DeleteEventAttendance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventsServiceClient) GetAttendanceReport(ctx context.Context, in *GetAttendanceReportRequest, opts ...grpc.CallOption) (*GetAttendanceReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAttendanceReportResponse)
+	err := c.cc.Invoke(ctx, EventsService_GetAttendanceReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventsServiceClient) GetMemberAttendance(ctx context.Context, in *GetMemberAttendanceRequest, opts ...grpc.CallOption) (*MemberAttendance, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MemberAttendance)
+	err := c.cc.Invoke(ctx, EventsService_GetMemberAttendance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventsServiceClient) DeleteUnitEvents(ctx context.Context, in *DeleteUnitEventsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, EventsService_DeleteUnitEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EventsServiceServer is the server API for EventsService service.
+// All implementations must embed UnimplementedEventsServiceServer
+// for forward compatibility.
+type EventsServiceServer interface {
+	// Creates an event of a unit.
+	CreateEvent(context.Context, *CreateEventRequest) (*Event, error)
+	// Gets an event. Public events can be read by anyone signed in, other
+	// events only by members who can view the unit's events.
+	GetEvent(context.Context, *GetEventRequest) (*Event, error)
+	// Lists the events of a unit.
+	ListEvents(context.Context, *ListEventsRequest) (*ListEventsResponse, error)
+	// Updates an event. When only the start of the event moves, the changes to,
+	// responses to and attendance at its occurrences move with them, as do its
+	// cancelled occurrences unless the recurrence is set too. Changes to and
+	// responses to occurrences the event no longer has are dropped, and the
+	// update fails with FAILED_PRECONDITION, listing the occurrences, if
+	// attendance was recorded at any of them.
+	UpdateEvent(context.Context, *UpdateEventRequest) (*Event, error)
+	// Deletes an event, and the responses to it and attendance at it.
+	DeleteEvent(context.Context, *DeleteEventRequest) (*emptypb.Empty, error)
+	// Responds to an event that has not ended yet.
+	RespondEvent(context.Context, *RespondEventRequest) (*EventResponse, error)
+	// Lists the responses to an event.
+	ListEventResponses(context.Context, *ListEventResponsesRequest) (*ListEventResponsesResponse, error)
+	// Lists the occurrences of the events of a unit within a window, with
+	// recurring events expanded.
+	ListEventOccurrences(context.Context, *ListEventOccurrencesRequest) (*ListEventOccurrencesResponse, error)
+	// Changes a single occurrence of a recurring event.
+	OverrideEventOccurrence(context.Context, *OverrideEventOccurrenceRequest) (*EventOccurrenceOverride, error)
+	// Reverts the changes to a single occurrence of a recurring event.
+	ResetEventOccurrence(context.Context, *ResetEventOccurrenceRequest) (*emptypb.Empty, error)
+	// Creates a calendar feed of events for a user.
+	CreateEventFeed(context.Context, *CreateEventFeedRequest) (*EventFeed, error)
+	// Lists the calendar feeds of a user.
+	ListEventFeeds(context.Context, *ListEventFeedsRequest) (*ListEventFeedsResponse, error)
+	// Deletes a calendar feed, revoking its token.
+	DeleteEventFeed(context.Context, *DeleteEventFeedRequest) (*emptypb.Empty, error)
+	// Records the attendance of members at an occurrence of an event that has
+	// started.
+	RecordEventAttendance(context.Context, *RecordEventAttendanceRequest) (*RecordEventAttendanceResponse, error)
+	// Lists the attendance at an occurrence of an event.
+	ListEventAttendance(context.Context, *ListEventAttendanceRequest) (*ListEventAttendanceResponse, error)
+	// Deletes the attendance of a member at an occurrence of an event.
+	DeleteEventAttendance(context.Context, *DeleteEventAttendanceRequest) (*emptypb.Empty, error)
+	// Reports the attendance of the members of a unit within a window.
+	GetAttendanceReport(context.Context, *GetAttendanceReportRequest) (*GetAttendanceReportResponse, error)
+	// Reports the attendance of a member of a unit within a window. Members
+	// can see their own attendance.
+	GetMemberAttendance(context.Context, *GetMemberAttendanceRequest) (*MemberAttendance, error)
+	// Deletes the events of a unit, when the unit is deleted.
+	DeleteUnitEvents(context.Context, *DeleteUnitEventsRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedEventsServiceServer()
+}
+
+// UnimplementedEventsServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedEventsServiceServer struct{}
+
+func (UnimplementedEventsServiceServer) CreateEvent(context.Context, *CreateEventRequest) (*Event, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEvent not implemented")
+}
+func (UnimplementedEventsServiceServer) GetEvent(context.Context, *GetEventRequest) (*Event, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEvent not implemented")
+}
+func (UnimplementedEventsServiceServer) ListEvents(context.Context, *ListEventsRequest) (*ListEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEvents not implemented")
+}
+func (UnimplementedEventsServiceServer) UpdateEvent(context.Context, *UpdateEventRequest) (*Event, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateEvent not implemented")
+}
+func (UnimplementedEventsServiceServer) DeleteEvent(context.Context, *DeleteEventRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteEvent not implemented")
+}
+func (UnimplementedEventsServiceServer) RespondEvent(context.Context, *RespondEventRequest) (*EventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RespondEvent not implemented")
+}
+func (UnimplementedEventsServiceServer) ListEventResponses(context.Context, *ListEventResponsesRequest) (*ListEventResponsesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEventResponses not implemented")
+}
+func (UnimplementedEventsServiceServer) ListEventOccurrences(context.Context, *ListEventOccurrencesRequest) (*ListEventOccurrencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEventOccurrences not implemented")
+}
+func (UnimplementedEventsServiceServer) OverrideEventOccurrence(context.Context, *OverrideEventOccurrenceRequest) (*EventOccurrenceOverride, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OverrideEventOccurrence not implemented")
+}
+func (UnimplementedEventsServiceServer) ResetEventOccurrence(context.Context, *ResetEventOccurrenceRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetEventOccurrence not implemented")
+}
+func (UnimplementedEventsServiceServer) CreateEventFeed(context.Context, *CreateEventFeedRequest) (*EventFeed, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEventFeed not implemented")
+}
+func (UnimplementedEventsServiceServer) ListEventFeeds(context.Context, *ListEventFeedsRequest) (*ListEventFeedsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEventFeeds not implemented")
+}
+func (UnimplementedEventsServiceServer) DeleteEventFeed(context.Context, *DeleteEventFeedRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteEventFeed not implemented")
+}
+func (UnimplementedEventsServiceServer) RecordEventAttendance(context.Context, *RecordEventAttendanceRequest) (*RecordEventAttendanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordEventAttendance not implemented")
+}
+func (UnimplementedEventsServiceServer) ListEventAttendance(context.Context, *ListEventAttendanceRequest) (*ListEventAttendanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEventAttendance not implemented")
+}
+func (UnimplementedEventsServiceServer) DeleteEventAttendance(context.Context, *DeleteEventAttendanceRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteEventAttendance not implemented")
+}
+func (UnimplementedEventsServiceServer) GetAttendanceReport(context.Context, *GetAttendanceReportRequest) (*GetAttendanceReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAttendanceReport not implemented")
+}
+func (UnimplementedEventsServiceServer) GetMemberAttendance(context.Context, *GetMemberAttendanceRequest) (*MemberAttendance, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMemberAttendance not implemented")
+}
+func (UnimplementedEventsServiceServer) DeleteUnitEvents(context.Context, *DeleteUnitEventsRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUnitEvents not implemented")
+}
+func (UnimplementedEventsServiceServer) mustEmbedUnimplementedEventsServiceServer() {}
+func (UnimplementedEventsServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeEventsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to EventsServiceServer will
+// result in compilation errors.
+type UnsafeEventsServiceServer interface {
+	mustEmbedUnimplementedEventsServiceServer()
+}
+
+func RegisterEventsServiceServer(s grpc.ServiceRegistrar, srv EventsServiceServer) {
+	// If the following call pancis, it indicates UnimplementedEventsServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&EventsService_ServiceDesc, srv)
+}
+
+func _EventsService_CreateEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventsServiceServer).CreateEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventsService_CreateEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventsServiceServer).CreateEvent(ctx, req.(*CreateEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventsService_GetEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventsServiceServer).GetEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventsService_GetEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventsServiceServer).GetEvent(ctx, req.(*GetEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventsService_ListEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventsServiceServer).ListEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventsService_ListEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventsServiceServer).ListEvents(ctx, req.(*ListEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventsService_UpdateEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventsServiceServer).UpdateEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventsService_UpdateEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventsServiceServer).UpdateEvent(ctx, req.(*UpdateEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventsService_DeleteEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventsServiceServer).DeleteEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventsService_DeleteEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventsServiceServer).DeleteEvent(ctx, req.(*DeleteEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventsService_RespondEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RespondEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventsServiceServer).RespondEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventsService_RespondEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventsServiceServer).RespondEvent(ctx, req.(*RespondEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventsService_ListEventResponses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEventResponsesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventsServiceServer).ListEventResponses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventsService_ListEventResponses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventsServiceServer).ListEventResponses(ctx, req.(*ListEventResponsesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventsService_ListEventOccurrences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEventOccurrencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventsServiceServer).ListEventOccurrences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventsService_ListEventOccurrences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventsServiceServer).ListEventOccurrences(ctx, req.(*ListEventOccurrencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventsService_OverrideEventOccurrence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OverrideEventOccurrenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventsServiceServer).OverrideEventOccurrence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventsService_OverrideEventOccurrence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventsServiceServer).OverrideEventOccurrence(ctx, req.(*OverrideEventOccurrenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventsService_ResetEventOccurrence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetEventOccurrenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventsServiceServer).ResetEventOccurrence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventsService_ResetEventOccurrence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventsServiceServer).ResetEventOccurrence(ctx, req.(*ResetEventOccurrenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventsService_CreateEventFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateEventFeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventsServiceServer).CreateEventFeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventsService_CreateEventFeed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventsServiceServer).CreateEventFeed(ctx, req.(*CreateEventFeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventsService_ListEventFeeds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEventFeedsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventsServiceServer).ListEventFeeds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventsService_ListEventFeeds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventsServiceServer).ListEventFeeds(ctx, req.(*ListEventFeedsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventsService_DeleteEventFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteEventFeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventsServiceServer).DeleteEventFeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventsService_DeleteEventFeed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventsServiceServer).DeleteEventFeed(ctx, req.(*DeleteEventFeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventsService_RecordEventAttendance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordEventAttendanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventsServiceServer).RecordEventAttendance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventsService_RecordEventAttendance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventsServiceServer).RecordEventAttendance(ctx, req.(*RecordEventAttendanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventsService_ListEventAttendance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEventAttendanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventsServiceServer).ListEventAttendance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventsService_ListEventAttendance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventsServiceServer).ListEventAttendance(ctx, req.(*ListEventAttendanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventsService_DeleteEventAttendance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteEventAttendanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventsServiceServer).DeleteEventAttendance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventsService_DeleteEventAttendance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventsServiceServer).DeleteEventAttendance(ctx, req.(*DeleteEventAttendanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventsService_GetAttendanceReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAttendanceReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventsServiceServer).GetAttendanceReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventsService_GetAttendanceReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventsServiceServer).GetAttendanceReport(ctx, req.(*GetAttendanceReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventsService_GetMemberAttendance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMemberAttendanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventsServiceServer).GetMemberAttendance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventsService_GetMemberAttendance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventsServiceServer).GetMemberAttendance(ctx, req.(*GetMemberAttendanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventsService_DeleteUnitEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUnitEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventsServiceServer).DeleteUnitEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventsService_DeleteUnitEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventsServiceServer).DeleteUnitEvents(ctx, req.(*DeleteUnitEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// EventsService_ServiceDesc is the grpc.ServiceDesc for EventsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var EventsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "milsimtools.events.v1.EventsService",
+	HandlerType: (*EventsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateEvent",
+			Handler:    _EventsService_CreateEvent_Handler,
+		},
+		{
+			MethodName: "GetEvent",
+			Handler:    _EventsService_GetEvent_Handler,
+		},
+		{
+			MethodName: "ListEvents",
+			Handler:    _EventsService_ListEvents_Handler,
+		},
+		{
+			MethodName: "UpdateEvent",
+			Handler:    _EventsService_UpdateEvent_Handler,
+		},
+		{
+			MethodName: "DeleteEvent",
+			Handler:    _EventsService_DeleteEvent_Handler,
+		},
+		{
+			MethodName: "RespondEvent",
+			Handler:    _EventsService_RespondEvent_Handler,
+		},
+		{
+			MethodName: "ListEventResponses",
+			Handler:    _EventsService_ListEventResponses_Handler,
+		},
+		{
+			MethodName: "ListEventOccurrences",
+			Handler:    _EventsService_ListEventOccurrences_Handler,
+		},
+		{
+			MethodName: "OverrideEventOccurrence",
+			Handler:    _EventsService_OverrideEventOccurrence_Handler,
+		},
+		{
+			MethodName: "ResetEventOccurrence",
+			Handler:    _EventsService_ResetEventOccurrence_Handler,
+		},
+		{
+			MethodName: "CreateEventFeed",
+			Handler:    _EventsService_CreateEventFeed_Handler,
+		},
+		{
+			MethodName: "ListEventFeeds",
+			Handler:    _EventsService_ListEventFeeds_Handler,
+		},
+		{
+			MethodName: "DeleteEventFeed",
+			Handler:    _EventsService_DeleteEventFeed_Handler,
+		},
+		{
+			MethodName: "RecordEventAttendance",
+			Handler:    _EventsService_RecordEventAttendance_Handler,
+		},
+		{
+			MethodName: "ListEventAttendance",
+			Handler:    _EventsService_ListEventAttendance_Handler,
+		},
+		{
+			MethodName: "DeleteEventAttendance",
+			Handler:    _EventsService_DeleteEventAttendance_Handler,
+		},
+		{
+			MethodName: "GetAttendanceReport",
+			Handler:    _EventsService_GetAttendanceReport_Handler,
+		},
+		{
+			MethodName: "GetMemberAttendance",
+			Handler:    _EventsService_GetMemberAttendance_Handler,
+		},
+		{
+			MethodName: "DeleteUnitEvents",
+			Handler:    _EventsService_DeleteUnitEvents_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "milsimtools/events/v1/service.proto",
+}
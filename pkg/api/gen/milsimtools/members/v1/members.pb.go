@@ -176,7 +176,7 @@ type UnitMember struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The ID of the member, represented as a ULID.
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// The status of the member.
+	// The ID of the unit the member belongs to, represented as a ULID.
 	UnitId string `protobuf:"bytes,2,opt,name=unit_id,json=unitId,proto3" json:"unit_id,omitempty"`
 	// The ID of the user who is the member, represented as a ULID.
 	UserId string `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -185,9 +185,13 @@ type UnitMember struct {
 	// The time the member was created.
 	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	// The last time the member was updated.
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// The status of the member.
+	Status UnitMemberStatus `protobuf:"varint,7,opt,name=status,proto3,enum=milsimtools.members.v1.UnitMemberStatus" json:"status,omitempty"`
+	// The last time the status of the member changed.
+	StatusChangedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=status_changed_at,json=statusChangedAt,proto3" json:"status_changed_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *UnitMember) Reset() {
@@ -262,11 +266,25 @@ func (x *UnitMember) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *UnitMember) GetStatus() UnitMemberStatus {
+	if x != nil {
+		return x.Status
+	}
+	return UnitMemberStatus_UNIT_MEMBER_STATUS_UNSPECIFIED
+}
+
+func (x *UnitMember) GetStatusChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StatusChangedAt
+	}
+	return nil
+}
+
 var File_milsimtools_members_v1_members_proto protoreflect.FileDescriptor
 
 const file_milsimtools_members_v1_members_proto_rawDesc = "" +
 	"\n" +
-	"$milsimtools/members/v1/members.proto\x12\x16milsimtools.members.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xf6\x01\n" +
+	"$milsimtools/members/v1/members.proto\x12\x16milsimtools.members.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x80\x03\n" +
 	"\n" +
 	"UnitMember\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
@@ -276,7 +294,9 @@ const file_milsimtools_members_v1_members_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt*\xb7\x01\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12@\n" +
+	"\x06status\x18\a \x01(\x0e2(.milsimtools.members.v1.UnitMemberStatusR\x06status\x12F\n" +
+	"\x11status_changed_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x0fstatusChangedAt*\xb7\x01\n" +
 	"\x10UnitMemberStatus\x12\"\n" +
 	"\x1eUNIT_MEMBER_STATUS_UNSPECIFIED\x10\x00\x12\x1e\n" +
 	"\x1aUNIT_MEMBER_STATUS_PENDING\x10\x01\x12\x1f\n" +
@@ -321,11 +341,13 @@ var file_milsimtools_members_v1_members_proto_goTypes = []any{
 var file_milsimtools_members_v1_members_proto_depIdxs = []int32{
 	3, // 0: milsimtools.members.v1.UnitMember.created_at:type_name -> google.protobuf.Timestamp
 	3, // 1: milsimtools.members.v1.UnitMember.updated_at:type_name -> google.protobuf.Timestamp
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	0, // 2: milsimtools.members.v1.UnitMember.status:type_name -> milsimtools.members.v1.UnitMemberStatus
+	3, // 3: milsimtools.members.v1.UnitMember.status_changed_at:type_name -> google.protobuf.Timestamp
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_milsimtools_members_v1_members_proto_init() }
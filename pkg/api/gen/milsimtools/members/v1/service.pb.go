@@ -8,6 +8,7 @@ package membersv1
 
 import (
 	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	_ "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/authz/v1"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -87,8 +88,13 @@ type ListMembersRequest struct {
 	UnitId string `protobuf:"bytes,2,opt,name=unit_id,json=unitId,proto3" json:"unit_id,omitempty"`
 	// The maximum number of members to return. Default is 50, maximum is 100.
 	PageSize int32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	// A page token, received from a previous `ListUsers` call.
-	PageToken     string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// A page token, received from a previous `ListMembers` call.
+	PageToken string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// A CEL expression the members must match, over the `unit_id`, `user_id`,
+	// `status`, `status_changed_at`, `created_at` and `updated_at` fields of the
+	// member, such as
+	// `status == APPROVED && created_at > timestamp("2025-01-01T00:00:00Z")`.
+	Filter        string `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -151,6 +157,13 @@ func (x *ListMembersRequest) GetPageToken() string {
 	return ""
 }
 
+func (x *ListMembersRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
 type ListMembersResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The members.
@@ -207,7 +220,13 @@ func (x *ListMembersResponse) GetNextPageToken() string {
 
 type CreateMemberRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The user to create.
+	// The member to create.
+	//
+	// The member's `status` defaults to `UNIT_MEMBER_STATUS_PENDING`, and can
+	// only otherwise be `UNIT_MEMBER_STATUS_APPROVED`, for members added by the
+	// unit rather than applying to it. Only unit owners and administrators grant
+	// ADMINISTRATOR or MANAGE_MEMBERS, and other callers only grant permissions
+	// they hold.
 	Member        *UnitMember `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -252,11 +271,17 @@ func (x *CreateMemberRequest) GetMember() *UnitMember {
 
 type UpdateMemberRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The user to update.
+	// The member to update.
 	//
-	// The user's `id` field is used to identify the user to update.
+	// The member's `unit_id` and `user_id` fields are used to identify the
+	// member to update, and cannot be updated.
 	Member *UnitMember `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
-	// The list of fields to update.
+	// The list of fields to update, `permissions` and `status`.
+	//
+	// The status can only move along the membership lifecycle: a pending member
+	// is approved or rejected, a rejected member can apply again, an approved
+	// member can be banned and a ban can be lifted. Permissions are granted and
+	// taken away under the same rules as when creating a member.
 	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -360,38 +385,158 @@ func (x *DeleteMemberRequest) GetUnitId() string {
 	return ""
 }
 
+type AdmitMemberRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the unit to admit the user to.
+	UnitId string `protobuf:"bytes,1,opt,name=unit_id,json=unitId,proto3" json:"unit_id,omitempty"`
+	// The ID of the user to admit.
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// The permissions of the member, represented as a bitmask of
+	// UnitMemberPermission. Members cannot be admitted as administrators, and
+	// permissions are otherwise granted under the same rules as when creating
+	// a member.
+	Permissions   int32 `protobuf:"varint,3,opt,name=permissions,proto3" json:"permissions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdmitMemberRequest) Reset() {
+	*x = AdmitMemberRequest{}
+	mi := &file_milsimtools_members_v1_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdmitMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdmitMemberRequest) ProtoMessage() {}
+
+func (x *AdmitMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_milsimtools_members_v1_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdmitMemberRequest.ProtoReflect.Descriptor instead.
+func (*AdmitMemberRequest) Descriptor() ([]byte, []int) {
+	return file_milsimtools_members_v1_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *AdmitMemberRequest) GetUnitId() string {
+	if x != nil {
+		return x.UnitId
+	}
+	return ""
+}
+
+func (x *AdmitMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AdmitMemberRequest) GetPermissions() int32 {
+	if x != nil {
+		return x.Permissions
+	}
+	return 0
+}
+
+type DeleteUnitMembersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the unit to delete the members of.
+	UnitId        string `protobuf:"bytes,1,opt,name=unit_id,json=unitId,proto3" json:"unit_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUnitMembersRequest) Reset() {
+	*x = DeleteUnitMembersRequest{}
+	mi := &file_milsimtools_members_v1_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUnitMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUnitMembersRequest) ProtoMessage() {}
+
+func (x *DeleteUnitMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_milsimtools_members_v1_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUnitMembersRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUnitMembersRequest) Descriptor() ([]byte, []int) {
+	return file_milsimtools_members_v1_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteUnitMembersRequest) GetUnitId() string {
+	if x != nil {
+		return x.UnitId
+	}
+	return ""
+}
+
 var File_milsimtools_members_v1_service_proto protoreflect.FileDescriptor
 
 const file_milsimtools_members_v1_service_proto_rawDesc = "" +
 	"\n" +
-	"$milsimtools/members/v1/service.proto\x12\x16milsimtools.members.v1\x1a$milsimtools/members/v1/members.proto\x1a\x1bbuf/validate/validate.proto\x1a google/protobuf/field_mask.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/api/annotations.proto\"D\n" +
-	"\x10GetMemberRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
-	"\aunit_id\x18\x02 \x01(\tR\x06unitId\"\x8b\x01\n" +
+	"$milsimtools/members/v1/service.proto\x12\x16milsimtools.members.v1\x1a$milsimtools/members/v1/members.proto\x1a milsimtools/authz/v1/authz.proto\x1a\x1bbuf/validate/validate.proto\x1a google/protobuf/field_mask.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/api/annotations.proto\"T\n" +
+	"\x10GetMemberRequest\x12\x1f\n" +
+	"\auser_id\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x06userId\x12\x1f\n" +
+	"\aunit_id\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x06unitId\"\xa3\x01\n" +
 	"\x12ListMembersRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
 	"\aunit_id\x18\x02 \x01(\tR\x06unitId\x12$\n" +
 	"\tpage_size\x18\x03 \x01(\x05B\a\xbaH\x04\x1a\x02\x18dR\bpageSize\x12\x1d\n" +
 	"\n" +
-	"page_token\x18\x04 \x01(\tR\tpageToken\"{\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\x12\x16\n" +
+	"\x06filter\x18\x05 \x01(\tR\x06filter\"{\n" +
 	"\x13ListMembersResponse\x12<\n" +
 	"\amembers\x18\x01 \x03(\v2\".milsimtools.members.v1.UnitMemberR\amembers\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"Q\n" +
-	"\x13CreateMemberRequest\x12:\n" +
-	"\x06member\x18\x01 \x01(\v2\".milsimtools.members.v1.UnitMemberR\x06member\"\x8e\x01\n" +
-	"\x13UpdateMemberRequest\x12:\n" +
-	"\x06member\x18\x01 \x01(\v2\".milsimtools.members.v1.UnitMemberR\x06member\x12;\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"Y\n" +
+	"\x13CreateMemberRequest\x12B\n" +
+	"\x06member\x18\x01 \x01(\v2\".milsimtools.members.v1.UnitMemberB\x06\xbaH\x03\xc8\x01\x01R\x06member\"\x96\x01\n" +
+	"\x13UpdateMemberRequest\x12B\n" +
+	"\x06member\x18\x01 \x01(\v2\".milsimtools.members.v1.UnitMemberB\x06\xbaH\x03\xc8\x01\x01R\x06member\x12;\n" +
 	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
-	"updateMask\"G\n" +
-	"\x13DeleteMemberRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
-	"\aunit_id\x18\x02 \x01(\tR\x06unitId2\x86\x06\n" +
-	"\x0eMembersService\x12\x8a\x01\n" +
-	"\tGetMember\x12(.milsimtools.members.v1.GetMemberRequest\x1a\".milsimtools.members.v1.UnitMember\"/\x82\xd3\xe4\x93\x02)\x12'/v1/members/by-unit/{unit_id}/{user_id}\x12\xae\x01\n" +
-	"\vListMembers\x12*.milsimtools.members.v1.ListMembersRequest\x1a+.milsimtools.members.v1.ListMembersResponse\"F\x82\xd3\xe4\x93\x02@Z\x1f\x12\x1d/v1/members/by-user/{user_id}\x12\x1d/v1/members/by-unit/{unit_id}\x12\x8d\x01\n" +
-	"\fCreateMember\x12+.milsimtools.members.v1.CreateMemberRequest\x1a\".milsimtools.members.v1.UnitMember\",\x82\xd3\xe4\x93\x02&\"$/v1/members/by-unit/{member.unit_id}\x12\x9e\x01\n" +
-	"\fUpdateMember\x12+.milsimtools.members.v1.UpdateMemberRequest\x1a\".milsimtools.members.v1.UnitMember\"=\x82\xd3\xe4\x93\x02725/v1/members/by-unit/{member.unit_id}/{member.user_id}\x12\x84\x01\n" +
-	"\fDeleteMember\x12+.milsimtools.members.v1.DeleteMemberRequest\x1a\x16.google.protobuf.Empty\"/\x82\xd3\xe4\x93\x02)*'/v1/members/by-unit/{unit_id}/{user_id}B\xf1\x01\n" +
+	"updateMask\"W\n" +
+	"\x13DeleteMemberRequest\x12\x1f\n" +
+	"\auser_id\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x06userId\x12\x1f\n" +
+	"\aunit_id\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x06unitId\"x\n" +
+	"\x12AdmitMemberRequest\x12\x1f\n" +
+	"\aunit_id\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x06unitId\x12\x1f\n" +
+	"\auser_id\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x06userId\x12 \n" +
+	"\vpermissions\x18\x03 \x01(\x05R\vpermissions\";\n" +
+	"\x18DeleteUnitMembersRequest\x12\x1f\n" +
+	"\aunit_id\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x06unitId2\xcf\t\n" +
+	"\x0eMembersService\x12\xa5\x01\n" +
+	"\tGetMember\x12(.milsimtools.members.v1.GetMemberRequest\x1a\".milsimtools.members.v1.UnitMember\"J\xc2\xf3\x18\x14\b\x04\x12\aunit_id\x1a\auser_id\x82\xd3\xe4\x93\x02)\x12'/v1/members/by-unit/{unit_id}/{user_id}\x90\x02\x01\x12\xc9\x01\n" +
+	"\vListMembers\x12*.milsimtools.members.v1.ListMembersRequest\x1a+.milsimtools.members.v1.ListMembersResponse\"a\xc2\xf3\x18\x14\b\x04\x12\aunit_id\x1a\auser_id\x82\xd3\xe4\x93\x02@Z\x1f\x12\x1d/v1/members/by-user/{user_id}\x12\x1d/v1/members/by-unit/{unit_id}\x90\x02\x01\x12\xab\x01\n" +
+	"\fCreateMember\x12+.milsimtools.members.v1.CreateMemberRequest\x1a\".milsimtools.members.v1.UnitMember\"J\xc2\xf3\x18\x12\b\b\x12\x0emember.unit_id\x82\xd3\xe4\x93\x02.:\x06member\"$/v1/members/by-unit/{member.unit_id}\x12\xbc\x01\n" +
+	"\fUpdateMember\x12+.milsimtools.members.v1.UpdateMemberRequest\x1a\".milsimtools.members.v1.UnitMember\"[\xc2\xf3\x18\x12\b\b\x12\x0emember.unit_id\x82\xd3\xe4\x93\x02?:\x06member25/v1/members/by-unit/{member.unit_id}/{member.user_id}\x12\x9c\x01\n" +
+	"\fDeleteMember\x12+.milsimtools.members.v1.DeleteMemberRequest\x1a\x16.google.protobuf.Empty\"G\xc2\xf3\x18\x14\b\b\x12\aunit_id\x1a\auser_id\x82\xd3\xe4\x93\x02)*'/v1/members/by-unit/{unit_id}/{user_id}\x12\xa6\x01\n" +
+	"\vAdmitMember\x12*.milsimtools.members.v1.AdmitMemberRequest\x1a\".milsimtools.members.v1.UnitMember\"G\xc2\xf3\x18\v\b \x12\aunit_id\x82\xd3\xe4\x93\x022:\x01*\"-/v1/members/by-unit/{unit_id}/{user_id}:admit\x12\x93\x01\n" +
+	"\x11DeleteUnitMembers\x120.milsimtools.members.v1.DeleteUnitMembersRequest\x1a\x16.google.protobuf.Empty\"4\xc2\xf3\x18\v\b\x01\x12\aunit_id\x82\xd3\xe4\x93\x02\x1f*\x1d/v1/members/by-unit/{unit_id}B\xf1\x01\n" +
 	"\x1acom.milsimtools.members.v1B\fServiceProtoP\x01ZKgithub.com/milsim-tools/pincer/pkg/api/gen/milsimtools/members/v1;membersv1\xa2\x02\x03MMX\xaa\x02\x16Milsimtools.Members.V1\xca\x02\x16Milsimtools\\Members\\V1\xe2\x02\"Milsimtools\\Members\\V1\\GPBMetadata\xea\x02\x18Milsimtools::Members::V1b\x06proto3"
 
 var (
@@ -406,38 +551,44 @@ func file_milsimtools_members_v1_service_proto_rawDescGZIP() []byte {
 	return file_milsimtools_members_v1_service_proto_rawDescData
 }
 
-var file_milsimtools_members_v1_service_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_milsimtools_members_v1_service_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_milsimtools_members_v1_service_proto_goTypes = []any{
-	(*GetMemberRequest)(nil),      // 0: milsimtools.members.v1.GetMemberRequest
-	(*ListMembersRequest)(nil),    // 1: milsimtools.members.v1.ListMembersRequest
-	(*ListMembersResponse)(nil),   // 2: milsimtools.members.v1.ListMembersResponse
-	(*CreateMemberRequest)(nil),   // 3: milsimtools.members.v1.CreateMemberRequest
-	(*UpdateMemberRequest)(nil),   // 4: milsimtools.members.v1.UpdateMemberRequest
-	(*DeleteMemberRequest)(nil),   // 5: milsimtools.members.v1.DeleteMemberRequest
-	(*UnitMember)(nil),            // 6: milsimtools.members.v1.UnitMember
-	(*fieldmaskpb.FieldMask)(nil), // 7: google.protobuf.FieldMask
-	(*emptypb.Empty)(nil),         // 8: google.protobuf.Empty
+	(*GetMemberRequest)(nil),         // 0: milsimtools.members.v1.GetMemberRequest
+	(*ListMembersRequest)(nil),       // 1: milsimtools.members.v1.ListMembersRequest
+	(*ListMembersResponse)(nil),      // 2: milsimtools.members.v1.ListMembersResponse
+	(*CreateMemberRequest)(nil),      // 3: milsimtools.members.v1.CreateMemberRequest
+	(*UpdateMemberRequest)(nil),      // 4: milsimtools.members.v1.UpdateMemberRequest
+	(*DeleteMemberRequest)(nil),      // 5: milsimtools.members.v1.DeleteMemberRequest
+	(*AdmitMemberRequest)(nil),       // 6: milsimtools.members.v1.AdmitMemberRequest
+	(*DeleteUnitMembersRequest)(nil), // 7: milsimtools.members.v1.DeleteUnitMembersRequest
+	(*UnitMember)(nil),               // 8: milsimtools.members.v1.UnitMember
+	(*fieldmaskpb.FieldMask)(nil),    // 9: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),            // 10: google.protobuf.Empty
 }
 var file_milsimtools_members_v1_service_proto_depIdxs = []int32{
-	6, // 0: milsimtools.members.v1.ListMembersResponse.members:type_name -> milsimtools.members.v1.UnitMember
-	6, // 1: milsimtools.members.v1.CreateMemberRequest.member:type_name -> milsimtools.members.v1.UnitMember
-	6, // 2: milsimtools.members.v1.UpdateMemberRequest.member:type_name -> milsimtools.members.v1.UnitMember
-	7, // 3: milsimtools.members.v1.UpdateMemberRequest.update_mask:type_name -> google.protobuf.FieldMask
-	0, // 4: milsimtools.members.v1.MembersService.GetMember:input_type -> milsimtools.members.v1.GetMemberRequest
-	1, // 5: milsimtools.members.v1.MembersService.ListMembers:input_type -> milsimtools.members.v1.ListMembersRequest
-	3, // 6: milsimtools.members.v1.MembersService.CreateMember:input_type -> milsimtools.members.v1.CreateMemberRequest
-	4, // 7: milsimtools.members.v1.MembersService.UpdateMember:input_type -> milsimtools.members.v1.UpdateMemberRequest
-	5, // 8: milsimtools.members.v1.MembersService.DeleteMember:input_type -> milsimtools.members.v1.DeleteMemberRequest
-	6, // 9: milsimtools.members.v1.MembersService.GetMember:output_type -> milsimtools.members.v1.UnitMember
-	2, // 10: milsimtools.members.v1.MembersService.ListMembers:output_type -> milsimtools.members.v1.ListMembersResponse
-	6, // 11: milsimtools.members.v1.MembersService.CreateMember:output_type -> milsimtools.members.v1.UnitMember
-	6, // 12: milsimtools.members.v1.MembersService.UpdateMember:output_type -> milsimtools.members.v1.UnitMember
-	8, // 13: milsimtools.members.v1.MembersService.DeleteMember:output_type -> google.protobuf.Empty
-	9, // [9:14] is the sub-list for method output_type
-	4, // [4:9] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	8,  // 0: milsimtools.members.v1.ListMembersResponse.members:type_name -> milsimtools.members.v1.UnitMember
+	8,  // 1: milsimtools.members.v1.CreateMemberRequest.member:type_name -> milsimtools.members.v1.UnitMember
+	8,  // 2: milsimtools.members.v1.UpdateMemberRequest.member:type_name -> milsimtools.members.v1.UnitMember
+	9,  // 3: milsimtools.members.v1.UpdateMemberRequest.update_mask:type_name -> google.protobuf.FieldMask
+	0,  // 4: milsimtools.members.v1.MembersService.GetMember:input_type -> milsimtools.members.v1.GetMemberRequest
+	1,  // 5: milsimtools.members.v1.MembersService.ListMembers:input_type -> milsimtools.members.v1.ListMembersRequest
+	3,  // 6: milsimtools.members.v1.MembersService.CreateMember:input_type -> milsimtools.members.v1.CreateMemberRequest
+	4,  // 7: milsimtools.members.v1.MembersService.UpdateMember:input_type -> milsimtools.members.v1.UpdateMemberRequest
+	5,  // 8: milsimtools.members.v1.MembersService.DeleteMember:input_type -> milsimtools.members.v1.DeleteMemberRequest
+	6,  // 9: milsimtools.members.v1.MembersService.AdmitMember:input_type -> milsimtools.members.v1.AdmitMemberRequest
+	7,  // 10: milsimtools.members.v1.MembersService.DeleteUnitMembers:input_type -> milsimtools.members.v1.DeleteUnitMembersRequest
+	8,  // 11: milsimtools.members.v1.MembersService.GetMember:output_type -> milsimtools.members.v1.UnitMember
+	2,  // 12: milsimtools.members.v1.MembersService.ListMembers:output_type -> milsimtools.members.v1.ListMembersResponse
+	8,  // 13: milsimtools.members.v1.MembersService.CreateMember:output_type -> milsimtools.members.v1.UnitMember
+	8,  // 14: milsimtools.members.v1.MembersService.UpdateMember:output_type -> milsimtools.members.v1.UnitMember
+	10, // 15: milsimtools.members.v1.MembersService.DeleteMember:output_type -> google.protobuf.Empty
+	8,  // 16: milsimtools.members.v1.MembersService.AdmitMember:output_type -> milsimtools.members.v1.UnitMember
+	10, // 17: milsimtools.members.v1.MembersService.DeleteUnitMembers:output_type -> google.protobuf.Empty
+	11, // [11:18] is the sub-list for method output_type
+	4,  // [4:11] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_milsimtools_members_v1_service_proto_init() }
@@ -452,7 +603,7 @@ func file_milsimtools_members_v1_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_milsimtools_members_v1_service_proto_rawDesc), len(file_milsimtools_members_v1_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -20,27 +20,40 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	MembersService_GetMember_FullMethodName    = "/milsimtools.members.v1.MembersService/GetMember"
-	MembersService_ListMembers_FullMethodName  = "/milsimtools.members.v1.MembersService/ListMembers"
-	MembersService_CreateMember_FullMethodName = "/milsimtools.members.v1.MembersService/CreateMember"
-	MembersService_UpdateMember_FullMethodName = "/milsimtools.members.v1.MembersService/UpdateMember"
-	MembersService_DeleteMember_FullMethodName = "/milsimtools.members.v1.MembersService/DeleteMember"
+	MembersService_GetMember_FullMethodName         = "/milsimtools.members.v1.MembersService/GetMember"
+	MembersService_ListMembers_FullMethodName       = "/milsimtools.members.v1.MembersService/ListMembers"
+	MembersService_CreateMember_FullMethodName      = "/milsimtools.members.v1.MembersService/CreateMember"
+	MembersService_UpdateMember_FullMethodName      = "/milsimtools.members.v1.MembersService/UpdateMember"
+	MembersService_DeleteMember_FullMethodName      = "/milsimtools.members.v1.MembersService/DeleteMember"
+	MembersService_AdmitMember_FullMethodName       = "/milsimtools.members.v1.MembersService/AdmitMember"
+	MembersService_DeleteUnitMembers_FullMethodName = "/milsimtools.members.v1.MembersService/DeleteUnitMembers"
 )
 
 // MembersServiceClient is the client API for MembersService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type MembersServiceClient interface {
-	// Gets a user by an ID.
+	// Gets the member of a unit for a user.
 	GetMember(ctx context.Context, in *GetMemberRequest, opts ...grpc.CallOption) (*UnitMember, error)
-	// Lists users.
+	// Lists members, of a unit or of a user.
 	ListMembers(ctx context.Context, in *ListMembersRequest, opts ...grpc.CallOption) (*ListMembersResponse, error)
-	// Create a new user.
+	// Adds a user to a unit.
 	CreateMember(ctx context.Context, in *CreateMemberRequest, opts ...grpc.CallOption) (*UnitMember, error)
-	// Update an existing user by its ID.
+	// Updates the permissions or status of a member. Only unit owners and
+	// administrators change the status of the owner or of members holding
+	// ADMINISTRATOR or MANAGE_MEMBERS.
 	UpdateMember(ctx context.Context, in *UpdateMemberRequest, opts ...grpc.CallOption) (*UnitMember, error)
-	// Update an existing user by its ID.
+	// Removes a user from a unit. Members can remove themselves, unless they
+	// are banned, as that would lift the ban. Only unit owners and
+	// administrators remove the owner or members holding ADMINISTRATOR or
+	// MANAGE_MEMBERS.
 	DeleteMember(ctx context.Context, in *DeleteMemberRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Admits a user to a unit as an approved member, when their application
+	// to join it is accepted. Users who are already approved members are left
+	// as they are, and banned users cannot be admitted.
+	AdmitMember(ctx context.Context, in *AdmitMemberRequest, opts ...grpc.CallOption) (*UnitMember, error)
+	// Deletes every member of a unit, when the unit is deleted.
+	DeleteUnitMembers(ctx context.Context, in *DeleteUnitMembersRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type membersServiceClient struct {
@@ -101,20 +114,51 @@ func (c *membersServiceClient) DeleteMember(ctx context.Context, in *DeleteMembe
 	return out, nil
 }
 
+func (c *membersServiceClient) AdmitMember(ctx context.Context, in *AdmitMemberRequest, opts ...grpc.CallOption) (*UnitMember, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnitMember)
+	err := c.cc.Invoke(ctx, MembersService_AdmitMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *membersServiceClient) DeleteUnitMembers(ctx context.Context, in *DeleteUnitMembersRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, MembersService_DeleteUnitMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MembersServiceServer is the server API for MembersService service.
 // All implementations must embed UnimplementedMembersServiceServer
 // for forward compatibility.
 type MembersServiceServer interface {
-	// Gets a user by an ID.
+	// Gets the member of a unit for a user.
 	GetMember(context.Context, *GetMemberRequest) (*UnitMember, error)
-	// Lists users.
+	// Lists members, of a unit or of a user.
 	ListMembers(context.Context, *ListMembersRequest) (*ListMembersResponse, error)
-	// Create a new user.
+	// Adds a user to a unit.
 	CreateMember(context.Context, *CreateMemberRequest) (*UnitMember, error)
-	// Update an existing user by its ID.
+	// Updates the permissions or status of a member. Only unit owners and
+	// administrators change the status of the owner or of members holding
+	// ADMINISTRATOR or MANAGE_MEMBERS.
 	UpdateMember(context.Context, *UpdateMemberRequest) (*UnitMember, error)
-	// Update an existing user by its ID.
+	// Removes a user from a unit. Members can remove themselves, unless they
+	// are banned, as that would lift the ban. Only unit owners and
+	// administrators remove the owner or members holding ADMINISTRATOR or
+	// MANAGE_MEMBERS.
 	DeleteMember(context.Context, *DeleteMemberRequest) (*emptypb.Empty, error)
+	// Admits a user to a unit as an approved member, when their application
+	// to join it is accepted. Users who are already approved members are left
+	// as they are, and banned users cannot be admitted.
+	AdmitMember(context.Context, *AdmitMemberRequest) (*UnitMember, error)
+	// Deletes every member of a unit, when the unit is deleted.
+	DeleteUnitMembers(context.Context, *DeleteUnitMembersRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedMembersServiceServer()
 }
 
@@ -140,6 +184,12 @@ func (UnimplementedMembersServiceServer) UpdateMember(context.Context, *UpdateMe
 func (UnimplementedMembersServiceServer) DeleteMember(context.Context, *DeleteMemberRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteMember not implemented")
 }
+func (UnimplementedMembersServiceServer) AdmitMember(context.Context, *AdmitMemberRequest) (*UnitMember, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdmitMember not implemented")
+}
+func (UnimplementedMembersServiceServer) DeleteUnitMembers(context.Context, *DeleteUnitMembersRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUnitMembers not implemented")
+}
 func (UnimplementedMembersServiceServer) mustEmbedUnimplementedMembersServiceServer() {}
 func (UnimplementedMembersServiceServer) testEmbeddedByValue()                        {}
 
@@ -251,6 +301,42 @@ func _MembersService_DeleteMember_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MembersService_AdmitMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdmitMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MembersServiceServer).AdmitMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MembersService_AdmitMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MembersServiceServer).AdmitMember(ctx, req.(*AdmitMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MembersService_DeleteUnitMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUnitMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MembersServiceServer).DeleteUnitMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MembersService_DeleteUnitMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MembersServiceServer).DeleteUnitMembers(ctx, req.(*DeleteUnitMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MembersService_ServiceDesc is the grpc.ServiceDesc for MembersService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -278,6 +364,14 @@ var MembersService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteMember",
 			Handler:    _MembersService_DeleteMember_Handler,
 		},
+		{
+			MethodName: "AdmitMember",
+			Handler:    _MembersService_AdmitMember_Handler,
+		},
+		{
+			MethodName: "DeleteUnitMembers",
+			Handler:    _MembersService_DeleteUnitMembers_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "milsimtools/members/v1/service.proto",
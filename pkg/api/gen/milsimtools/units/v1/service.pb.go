@@ -7,9 +7,13 @@
 package unitsv1
 
 import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	_ "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/authz/v1"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -23,8 +27,12 @@ const (
 )
 
 type GetUnitRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Value:
+	//
+	//	*GetUnitRequest_Id
+	//	*GetUnitRequest_Slug
+	Value         isGetUnitRequest_Value `protobuf_oneof:"value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -59,18 +67,66 @@ func (*GetUnitRequest) Descriptor() ([]byte, []int) {
 	return file_milsimtools_units_v1_service_proto_rawDescGZIP(), []int{0}
 }
 
+func (x *GetUnitRequest) GetValue() isGetUnitRequest_Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
 func (x *GetUnitRequest) GetId() string {
 	if x != nil {
-		return x.Id
+		if x, ok := x.Value.(*GetUnitRequest_Id); ok {
+			return x.Id
+		}
+	}
+	return ""
+}
+
+func (x *GetUnitRequest) GetSlug() string {
+	if x != nil {
+		if x, ok := x.Value.(*GetUnitRequest_Slug); ok {
+			return x.Slug
+		}
 	}
 	return ""
 }
 
+type isGetUnitRequest_Value interface {
+	isGetUnitRequest_Value()
+}
+
+type GetUnitRequest_Id struct {
+	// The ID of the unit to retrieve.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3,oneof"`
+}
+
+type GetUnitRequest_Slug struct {
+	// The slug of the unit to retrieve. Units are also found by the slugs
+	// they had before being renamed, in which case REST callers are
+	// redirected to the current slug.
+	Slug string `protobuf:"bytes,2,opt,name=slug,proto3,oneof"`
+}
+
+func (*GetUnitRequest_Id) isGetUnitRequest_Value() {}
+
+func (*GetUnitRequest_Slug) isGetUnitRequest_Value() {}
+
 type ListUnitsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	OrderBy       string                 `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The maximum number of units to return. Default is 50, maximum is 100.
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// A page token, received from a previous `ListUnits` call.
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// The order of the units, a comma separated list of fields each optionally
+	// followed by `desc`, such as `display_name desc, created_at`. Units can be
+	// ordered by `display_name`, `slug`, `created_at` and `updated_at`, and are
+	// ordered newest first by default.
+	OrderBy string `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	// A CEL expression the units must match, over the `display_name`, `slug`
+	// and `owner_id` fields of the unit, such as
+	// `display_name.contains("Ranger")`.
+	Filter        string `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -126,6 +182,13 @@ func (x *ListUnitsRequest) GetOrderBy() string {
 	return ""
 }
 
+func (x *ListUnitsRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
 type ListUnitsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Units         []*UnitView            `protobuf:"bytes,1,rep,name=units,proto3" json:"units,omitempty"`
@@ -178,9 +241,193 @@ func (x *ListUnitsResponse) GetNextPageToken() string {
 	return ""
 }
 
+type SearchUnitsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The text to search for. Units match if each word of the query starts a
+	// word of their display name, slug or description, or if their display
+	// name or slug is within a few typos of the query.
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// The maximum number of units to return. Default is 50, maximum is 100.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// A page token, received from a previous `SearchUnits` call.
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchUnitsRequest) Reset() {
+	*x = SearchUnitsRequest{}
+	mi := &file_milsimtools_units_v1_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchUnitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchUnitsRequest) ProtoMessage() {}
+
+func (x *SearchUnitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_milsimtools_units_v1_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchUnitsRequest.ProtoReflect.Descriptor instead.
+func (*SearchUnitsRequest) Descriptor() ([]byte, []int) {
+	return file_milsimtools_units_v1_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SearchUnitsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchUnitsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchUnitsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type SearchUnitsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The matching units, most relevant first.
+	Results []*UnitSearchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	// A token, which can be sent as `page_token` to retrieve the next page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchUnitsResponse) Reset() {
+	*x = SearchUnitsResponse{}
+	mi := &file_milsimtools_units_v1_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchUnitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchUnitsResponse) ProtoMessage() {}
+
+func (x *SearchUnitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_milsimtools_units_v1_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchUnitsResponse.ProtoReflect.Descriptor instead.
+func (*SearchUnitsResponse) Descriptor() ([]byte, []int) {
+	return file_milsimtools_units_v1_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SearchUnitsResponse) GetResults() []*UnitSearchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *SearchUnitsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type UnitSearchResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The matching unit.
+	Unit *UnitView `protobuf:"bytes,1,opt,name=unit,proto3" json:"unit,omitempty"`
+	// The relevance of the unit to the query, higher is more relevant.
+	Rank float64 `protobuf:"fixed64,2,opt,name=rank,proto3" json:"rank,omitempty"`
+	// The fields of the unit that matched the query, by field name. Values are
+	// HTML-escaped, with the matching words wrapped in `<mark>` tags, and long
+	// fields are cut down to an excerpt around the first match.
+	Highlights    map[string]string `protobuf:"bytes,3,rep,name=highlights,proto3" json:"highlights,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnitSearchResult) Reset() {
+	*x = UnitSearchResult{}
+	mi := &file_milsimtools_units_v1_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnitSearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnitSearchResult) ProtoMessage() {}
+
+func (x *UnitSearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_milsimtools_units_v1_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnitSearchResult.ProtoReflect.Descriptor instead.
+func (*UnitSearchResult) Descriptor() ([]byte, []int) {
+	return file_milsimtools_units_v1_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UnitSearchResult) GetUnit() *UnitView {
+	if x != nil {
+		return x.Unit
+	}
+	return nil
+}
+
+func (x *UnitSearchResult) GetRank() float64 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *UnitSearchResult) GetHighlights() map[string]string {
+	if x != nil {
+		return x.Highlights
+	}
+	return nil
+}
+
 type CreateUnitRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The user to create.
+	// The unit to create.
 	Unit          *Unit `protobuf:"bytes,1,opt,name=unit,proto3" json:"unit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -188,7 +435,7 @@ type CreateUnitRequest struct {
 
 func (x *CreateUnitRequest) Reset() {
 	*x = CreateUnitRequest{}
-	mi := &file_milsimtools_units_v1_service_proto_msgTypes[3]
+	mi := &file_milsimtools_units_v1_service_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -200,7 +447,7 @@ func (x *CreateUnitRequest) String() string {
 func (*CreateUnitRequest) ProtoMessage() {}
 
 func (x *CreateUnitRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_milsimtools_units_v1_service_proto_msgTypes[3]
+	mi := &file_milsimtools_units_v1_service_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -213,7 +460,7 @@ func (x *CreateUnitRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateUnitRequest.ProtoReflect.Descriptor instead.
 func (*CreateUnitRequest) Descriptor() ([]byte, []int) {
-	return file_milsimtools_units_v1_service_proto_rawDescGZIP(), []int{3}
+	return file_milsimtools_units_v1_service_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *CreateUnitRequest) GetUnit() *Unit {
@@ -223,28 +470,162 @@ func (x *CreateUnitRequest) GetUnit() *Unit {
 	return nil
 }
 
+type UpdateUnitRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The unit to update.
+	//
+	// The unit's `id` field is used to identify the unit to update. Only the
+	// fields in `update_mask` are read, and validated by the service.
+	Unit *Unit `protobuf:"bytes,1,opt,name=unit,proto3" json:"unit,omitempty"`
+	// The list of fields to update.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUnitRequest) Reset() {
+	*x = UpdateUnitRequest{}
+	mi := &file_milsimtools_units_v1_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUnitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUnitRequest) ProtoMessage() {}
+
+func (x *UpdateUnitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_milsimtools_units_v1_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUnitRequest.ProtoReflect.Descriptor instead.
+func (*UpdateUnitRequest) Descriptor() ([]byte, []int) {
+	return file_milsimtools_units_v1_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateUnitRequest) GetUnit() *Unit {
+	if x != nil {
+		return x.Unit
+	}
+	return nil
+}
+
+func (x *UpdateUnitRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type DeleteUnitRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the unit to delete.
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUnitRequest) Reset() {
+	*x = DeleteUnitRequest{}
+	mi := &file_milsimtools_units_v1_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUnitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUnitRequest) ProtoMessage() {}
+
+func (x *DeleteUnitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_milsimtools_units_v1_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUnitRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUnitRequest) Descriptor() ([]byte, []int) {
+	return file_milsimtools_units_v1_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeleteUnitRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
 var File_milsimtools_units_v1_service_proto protoreflect.FileDescriptor
 
 const file_milsimtools_units_v1_service_proto_rawDesc = "" +
 	"\n" +
-	"\"milsimtools/units/v1/service.proto\x12\x14milsimtools.units.v1\x1a milsimtools/units/v1/units.proto\x1a\x1cgoogle/api/annotations.proto\" \n" +
-	"\x0eGetUnitRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"i\n" +
-	"\x10ListUnitsRequest\x12\x1b\n" +
-	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\"milsimtools/units/v1/service.proto\x12\x14milsimtools.units.v1\x1a milsimtools/units/v1/units.proto\x1a milsimtools/authz/v1/authz.proto\x1a\x1bbuf/validate/validate.proto\x1a google/protobuf/field_mask.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/api/annotations.proto\"H\n" +
+	"\x0eGetUnitRequest\x12\x10\n" +
+	"\x02id\x18\x01 \x01(\tH\x00R\x02id\x12\x14\n" +
+	"\x04slug\x18\x02 \x01(\tH\x00R\x04slugB\x0e\n" +
+	"\x05value\x12\x05\xbaH\x02\b\x01\"\x8a\x01\n" +
+	"\x10ListUnitsRequest\x12$\n" +
+	"\tpage_size\x18\x01 \x01(\x05B\a\xbaH\x04\x1a\x02\x18dR\bpageSize\x12\x1d\n" +
 	"\n" +
 	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x19\n" +
-	"\border_by\x18\x03 \x01(\tR\aorderBy\"q\n" +
+	"\border_by\x18\x03 \x01(\tR\aorderBy\x12\x16\n" +
+	"\x06filter\x18\x04 \x01(\tR\x06filter\"q\n" +
 	"\x11ListUnitsResponse\x124\n" +
 	"\x05units\x18\x01 \x03(\v2\x1e.milsimtools.units.v1.UnitViewR\x05units\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"C\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"{\n" +
+	"\x12SearchUnitsRequest\x12 \n" +
+	"\x05query\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xc8\x01R\x05query\x12$\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\a\xbaH\x04\x1a\x02\x18dR\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\x7f\n" +
+	"\x13SearchUnitsResponse\x12@\n" +
+	"\aresults\x18\x01 \x03(\v2&.milsimtools.units.v1.UnitSearchResultR\aresults\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xf1\x01\n" +
+	"\x10UnitSearchResult\x122\n" +
+	"\x04unit\x18\x01 \x01(\v2\x1e.milsimtools.units.v1.UnitViewR\x04unit\x12\x12\n" +
+	"\x04rank\x18\x02 \x01(\x01R\x04rank\x12V\n" +
+	"\n" +
+	"highlights\x18\x03 \x03(\v26.milsimtools.units.v1.UnitSearchResult.HighlightsEntryR\n" +
+	"highlights\x1a=\n" +
+	"\x0fHighlightsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"C\n" +
 	"\x11CreateUnitRequest\x12.\n" +
-	"\x04unit\x18\x01 \x01(\v2\x1a.milsimtools.units.v1.UnitR\x04unit2\xce\x02\n" +
-	"\fUnitsService\x12g\n" +
-	"\aGetUnit\x12$.milsimtools.units.v1.GetUnitRequest\x1a\x1e.milsimtools.units.v1.UnitView\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/units/{id}\x12o\n" +
-	"\tListUnits\x12&.milsimtools.units.v1.ListUnitsRequest\x1a'.milsimtools.units.v1.ListUnitsResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/v1/units\x12d\n" +
+	"\x04unit\x18\x01 \x01(\v2\x1a.milsimtools.units.v1.UnitR\x04unit\"\x88\x01\n" +
+	"\x11UpdateUnitRequest\x126\n" +
+	"\x04unit\x18\x01 \x01(\v2\x1a.milsimtools.units.v1.UnitB\x06\xbaH\x03\xd8\x01\x03R\x04unit\x12;\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"#\n" +
+	"\x11DeleteUnitRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id2\x8f\x06\n" +
+	"\fUnitsService\x12\x8a\x01\n" +
+	"\aGetUnit\x12$.milsimtools.units.v1.GetUnitRequest\x1a\x1e.milsimtools.units.v1.UnitView\"9\xc2\xf3\x18\x00\x82\xd3\xe4\x93\x02,Z\x1a\x12\x18/v1/units/by-slug/{slug}\x12\x0e/v1/units/{id}\x90\x02\x01\x12v\n" +
+	"\tListUnits\x12&.milsimtools.units.v1.ListUnitsRequest\x1a'.milsimtools.units.v1.ListUnitsResponse\"\x18\xc2\xf3\x18\x00\x82\xd3\xe4\x93\x02\v\x12\t/v1/units\x90\x02\x01\x12\x83\x01\n" +
+	"\vSearchUnits\x12(.milsimtools.units.v1.SearchUnitsRequest\x1a).milsimtools.units.v1.SearchUnitsResponse\"\x1f\xc2\xf3\x18\x00\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/units:search\x90\x02\x01\x12}\n" +
 	"\n" +
-	"CreateUnit\x12'.milsimtools.units.v1.CreateUnitRequest\x1a\x1a.milsimtools.units.v1.Unit\"\x11\x82\xd3\xe4\x93\x02\v\"\t/v1/unitsB\xe3\x01\n" +
+	"CreateUnit\x12'.milsimtools.units.v1.CreateUnitRequest\x1a\x1a.milsimtools.units.v1.Unit\"*\xc2\xf3\x18\x0f\x1a\runit.owner_id\x82\xd3\xe4\x93\x02\x11:\x04unit\"\t/v1/units\x12\x83\x01\n" +
+	"\n" +
+	"UpdateUnit\x12'.milsimtools.units.v1.UpdateUnitRequest\x1a\x1a.milsimtools.units.v1.Unit\"0\xc2\xf3\x18\v\b\x01\x12\aunit.id\x82\xd3\xe4\x93\x02\x1b:\x04unit2\x13/v1/units/{unit.id}\x12o\n" +
+	"\n" +
+	"DeleteUnit\x12'.milsimtools.units.v1.DeleteUnitRequest\x1a\x16.google.protobuf.Empty\" \xc2\xf3\x18\x06\b\x01\x12\x02id\x82\xd3\xe4\x93\x02\x10*\x0e/v1/units/{id}B\xe3\x01\n" +
 	"\x18com.milsimtools.units.v1B\fServiceProtoP\x01ZGgithub.com/milsim-tools/pincer/pkg/api/gen/milsimtools/units/v1;unitsv1\xa2\x02\x03MUX\xaa\x02\x14Milsimtools.Units.V1\xca\x02\x14Milsimtools\\Units\\V1\xe2\x02 Milsimtools\\Units\\V1\\GPBMetadata\xea\x02\x16Milsimtools::Units::V1b\x06proto3"
 
 var (
@@ -259,29 +640,48 @@ func file_milsimtools_units_v1_service_proto_rawDescGZIP() []byte {
 	return file_milsimtools_units_v1_service_proto_rawDescData
 }
 
-var file_milsimtools_units_v1_service_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_milsimtools_units_v1_service_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_milsimtools_units_v1_service_proto_goTypes = []any{
-	(*GetUnitRequest)(nil),    // 0: milsimtools.units.v1.GetUnitRequest
-	(*ListUnitsRequest)(nil),  // 1: milsimtools.units.v1.ListUnitsRequest
-	(*ListUnitsResponse)(nil), // 2: milsimtools.units.v1.ListUnitsResponse
-	(*CreateUnitRequest)(nil), // 3: milsimtools.units.v1.CreateUnitRequest
-	(*UnitView)(nil),          // 4: milsimtools.units.v1.UnitView
-	(*Unit)(nil),              // 5: milsimtools.units.v1.Unit
+	(*GetUnitRequest)(nil),        // 0: milsimtools.units.v1.GetUnitRequest
+	(*ListUnitsRequest)(nil),      // 1: milsimtools.units.v1.ListUnitsRequest
+	(*ListUnitsResponse)(nil),     // 2: milsimtools.units.v1.ListUnitsResponse
+	(*SearchUnitsRequest)(nil),    // 3: milsimtools.units.v1.SearchUnitsRequest
+	(*SearchUnitsResponse)(nil),   // 4: milsimtools.units.v1.SearchUnitsResponse
+	(*UnitSearchResult)(nil),      // 5: milsimtools.units.v1.UnitSearchResult
+	(*CreateUnitRequest)(nil),     // 6: milsimtools.units.v1.CreateUnitRequest
+	(*UpdateUnitRequest)(nil),     // 7: milsimtools.units.v1.UpdateUnitRequest
+	(*DeleteUnitRequest)(nil),     // 8: milsimtools.units.v1.DeleteUnitRequest
+	nil,                           // 9: milsimtools.units.v1.UnitSearchResult.HighlightsEntry
+	(*UnitView)(nil),              // 10: milsimtools.units.v1.UnitView
+	(*Unit)(nil),                  // 11: milsimtools.units.v1.Unit
+	(*fieldmaskpb.FieldMask)(nil), // 12: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),         // 13: google.protobuf.Empty
 }
 var file_milsimtools_units_v1_service_proto_depIdxs = []int32{
-	4, // 0: milsimtools.units.v1.ListUnitsResponse.units:type_name -> milsimtools.units.v1.UnitView
-	5, // 1: milsimtools.units.v1.CreateUnitRequest.unit:type_name -> milsimtools.units.v1.Unit
-	0, // 2: milsimtools.units.v1.UnitsService.GetUnit:input_type -> milsimtools.units.v1.GetUnitRequest
-	1, // 3: milsimtools.units.v1.UnitsService.ListUnits:input_type -> milsimtools.units.v1.ListUnitsRequest
-	3, // 4: milsimtools.units.v1.UnitsService.CreateUnit:input_type -> milsimtools.units.v1.CreateUnitRequest
-	4, // 5: milsimtools.units.v1.UnitsService.GetUnit:output_type -> milsimtools.units.v1.UnitView
-	2, // 6: milsimtools.units.v1.UnitsService.ListUnits:output_type -> milsimtools.units.v1.ListUnitsResponse
-	5, // 7: milsimtools.units.v1.UnitsService.CreateUnit:output_type -> milsimtools.units.v1.Unit
-	5, // [5:8] is the sub-list for method output_type
-	2, // [2:5] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	10, // 0: milsimtools.units.v1.ListUnitsResponse.units:type_name -> milsimtools.units.v1.UnitView
+	5,  // 1: milsimtools.units.v1.SearchUnitsResponse.results:type_name -> milsimtools.units.v1.UnitSearchResult
+	10, // 2: milsimtools.units.v1.UnitSearchResult.unit:type_name -> milsimtools.units.v1.UnitView
+	9,  // 3: milsimtools.units.v1.UnitSearchResult.highlights:type_name -> milsimtools.units.v1.UnitSearchResult.HighlightsEntry
+	11, // 4: milsimtools.units.v1.CreateUnitRequest.unit:type_name -> milsimtools.units.v1.Unit
+	11, // 5: milsimtools.units.v1.UpdateUnitRequest.unit:type_name -> milsimtools.units.v1.Unit
+	12, // 6: milsimtools.units.v1.UpdateUnitRequest.update_mask:type_name -> google.protobuf.FieldMask
+	0,  // 7: milsimtools.units.v1.UnitsService.GetUnit:input_type -> milsimtools.units.v1.GetUnitRequest
+	1,  // 8: milsimtools.units.v1.UnitsService.ListUnits:input_type -> milsimtools.units.v1.ListUnitsRequest
+	3,  // 9: milsimtools.units.v1.UnitsService.SearchUnits:input_type -> milsimtools.units.v1.SearchUnitsRequest
+	6,  // 10: milsimtools.units.v1.UnitsService.CreateUnit:input_type -> milsimtools.units.v1.CreateUnitRequest
+	7,  // 11: milsimtools.units.v1.UnitsService.UpdateUnit:input_type -> milsimtools.units.v1.UpdateUnitRequest
+	8,  // 12: milsimtools.units.v1.UnitsService.DeleteUnit:input_type -> milsimtools.units.v1.DeleteUnitRequest
+	10, // 13: milsimtools.units.v1.UnitsService.GetUnit:output_type -> milsimtools.units.v1.UnitView
+	2,  // 14: milsimtools.units.v1.UnitsService.ListUnits:output_type -> milsimtools.units.v1.ListUnitsResponse
+	4,  // 15: milsimtools.units.v1.UnitsService.SearchUnits:output_type -> milsimtools.units.v1.SearchUnitsResponse
+	11, // 16: milsimtools.units.v1.UnitsService.CreateUnit:output_type -> milsimtools.units.v1.Unit
+	11, // 17: milsimtools.units.v1.UnitsService.UpdateUnit:output_type -> milsimtools.units.v1.Unit
+	13, // 18: milsimtools.units.v1.UnitsService.DeleteUnit:output_type -> google.protobuf.Empty
+	13, // [13:19] is the sub-list for method output_type
+	7,  // [7:13] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_milsimtools_units_v1_service_proto_init() }
@@ -290,13 +690,17 @@ func file_milsimtools_units_v1_service_proto_init() {
 		return
 	}
 	file_milsimtools_units_v1_units_proto_init()
+	file_milsimtools_units_v1_service_proto_msgTypes[0].OneofWrappers = []any{
+		(*GetUnitRequest_Id)(nil),
+		(*GetUnitRequest_Slug)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_milsimtools_units_v1_service_proto_rawDesc), len(file_milsimtools_units_v1_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
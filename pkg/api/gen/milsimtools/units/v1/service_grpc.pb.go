@@ -11,6 +11,7 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -19,9 +20,12 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	UnitsService_GetUnit_FullMethodName    = "/milsimtools.units.v1.UnitsService/GetUnit"
-	UnitsService_ListUnits_FullMethodName  = "/milsimtools.units.v1.UnitsService/ListUnits"
-	UnitsService_CreateUnit_FullMethodName = "/milsimtools.units.v1.UnitsService/CreateUnit"
+	UnitsService_GetUnit_FullMethodName     = "/milsimtools.units.v1.UnitsService/GetUnit"
+	UnitsService_ListUnits_FullMethodName   = "/milsimtools.units.v1.UnitsService/ListUnits"
+	UnitsService_SearchUnits_FullMethodName = "/milsimtools.units.v1.UnitsService/SearchUnits"
+	UnitsService_CreateUnit_FullMethodName  = "/milsimtools.units.v1.UnitsService/CreateUnit"
+	UnitsService_UpdateUnit_FullMethodName  = "/milsimtools.units.v1.UnitsService/UpdateUnit"
+	UnitsService_DeleteUnit_FullMethodName  = "/milsimtools.units.v1.UnitsService/DeleteUnit"
 )
 
 // UnitsServiceClient is the client API for UnitsService service.
@@ -30,7 +34,12 @@ const (
 type UnitsServiceClient interface {
 	GetUnit(ctx context.Context, in *GetUnitRequest, opts ...grpc.CallOption) (*UnitView, error)
 	ListUnits(ctx context.Context, in *ListUnitsRequest, opts ...grpc.CallOption) (*ListUnitsResponse, error)
+	SearchUnits(ctx context.Context, in *SearchUnitsRequest, opts ...grpc.CallOption) (*SearchUnitsResponse, error)
 	CreateUnit(ctx context.Context, in *CreateUnitRequest, opts ...grpc.CallOption) (*Unit, error)
+	// Updates an existing unit by its ID.
+	UpdateUnit(ctx context.Context, in *UpdateUnitRequest, opts ...grpc.CallOption) (*Unit, error)
+	// Deletes a unit by its ID, along with its memberships.
+	DeleteUnit(ctx context.Context, in *DeleteUnitRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type unitsServiceClient struct {
@@ -61,6 +70,16 @@ func (c *unitsServiceClient) ListUnits(ctx context.Context, in *ListUnitsRequest
 	return out, nil
 }
 
+func (c *unitsServiceClient) SearchUnits(ctx context.Context, in *SearchUnitsRequest, opts ...grpc.CallOption) (*SearchUnitsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchUnitsResponse)
+	err := c.cc.Invoke(ctx, UnitsService_SearchUnits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *unitsServiceClient) CreateUnit(ctx context.Context, in *CreateUnitRequest, opts ...grpc.CallOption) (*Unit, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Unit)
@@ -71,13 +90,38 @@ func (c *unitsServiceClient) CreateUnit(ctx context.Context, in *CreateUnitReque
 	return out, nil
 }
 
+func (c *unitsServiceClient) UpdateUnit(ctx context.Context, in *UpdateUnitRequest, opts ...grpc.CallOption) (*Unit, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Unit)
+	err := c.cc.Invoke(ctx, UnitsService_UpdateUnit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *unitsServiceClient) DeleteUnit(ctx context.Context, in *DeleteUnitRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UnitsService_DeleteUnit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UnitsServiceServer is the server API for UnitsService service.
 // All implementations must embed UnimplementedUnitsServiceServer
 // for forward compatibility.
 type UnitsServiceServer interface {
 	GetUnit(context.Context, *GetUnitRequest) (*UnitView, error)
 	ListUnits(context.Context, *ListUnitsRequest) (*ListUnitsResponse, error)
+	SearchUnits(context.Context, *SearchUnitsRequest) (*SearchUnitsResponse, error)
 	CreateUnit(context.Context, *CreateUnitRequest) (*Unit, error)
+	// Updates an existing unit by its ID.
+	UpdateUnit(context.Context, *UpdateUnitRequest) (*Unit, error)
+	// Deletes a unit by its ID, along with its memberships.
+	DeleteUnit(context.Context, *DeleteUnitRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedUnitsServiceServer()
 }
 
@@ -94,9 +138,18 @@ func (UnimplementedUnitsServiceServer) GetUnit(context.Context, *GetUnitRequest)
 func (UnimplementedUnitsServiceServer) ListUnits(context.Context, *ListUnitsRequest) (*ListUnitsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListUnits not implemented")
 }
+func (UnimplementedUnitsServiceServer) SearchUnits(context.Context, *SearchUnitsRequest) (*SearchUnitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchUnits not implemented")
+}
 func (UnimplementedUnitsServiceServer) CreateUnit(context.Context, *CreateUnitRequest) (*Unit, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateUnit not implemented")
 }
+func (UnimplementedUnitsServiceServer) UpdateUnit(context.Context, *UpdateUnitRequest) (*Unit, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUnit not implemented")
+}
+func (UnimplementedUnitsServiceServer) DeleteUnit(context.Context, *DeleteUnitRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUnit not implemented")
+}
 func (UnimplementedUnitsServiceServer) mustEmbedUnimplementedUnitsServiceServer() {}
 func (UnimplementedUnitsServiceServer) testEmbeddedByValue()                      {}
 
@@ -154,6 +207,24 @@ func _UnitsService_ListUnits_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UnitsService_SearchUnits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchUnitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UnitsServiceServer).SearchUnits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UnitsService_SearchUnits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UnitsServiceServer).SearchUnits(ctx, req.(*SearchUnitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UnitsService_CreateUnit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateUnitRequest)
 	if err := dec(in); err != nil {
@@ -172,6 +243,42 @@ func _UnitsService_CreateUnit_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UnitsService_UpdateUnit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUnitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UnitsServiceServer).UpdateUnit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UnitsService_UpdateUnit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UnitsServiceServer).UpdateUnit(ctx, req.(*UpdateUnitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UnitsService_DeleteUnit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUnitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UnitsServiceServer).DeleteUnit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UnitsService_DeleteUnit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UnitsServiceServer).DeleteUnit(ctx, req.(*DeleteUnitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UnitsService_ServiceDesc is the grpc.ServiceDesc for UnitsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -187,10 +294,22 @@ var UnitsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListUnits",
 			Handler:    _UnitsService_ListUnits_Handler,
 		},
+		{
+			MethodName: "SearchUnits",
+			Handler:    _UnitsService_SearchUnits_Handler,
+		},
 		{
 			MethodName: "CreateUnit",
 			Handler:    _UnitsService_CreateUnit_Handler,
 		},
+		{
+			MethodName: "UpdateUnit",
+			Handler:    _UnitsService_UpdateUnit_Handler,
+		},
+		{
+			MethodName: "DeleteUnit",
+			Handler:    _UnitsService_DeleteUnit_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "milsimtools/units/v1/service.proto",
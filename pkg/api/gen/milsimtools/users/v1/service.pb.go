@@ -8,6 +8,7 @@ package usersv1
 
 import (
 	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	_ "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/authz/v1"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -32,6 +33,7 @@ type GetUserRequest struct {
 	//	*GetUserRequest_Id
 	//	*GetUserRequest_Username
 	//	*GetUserRequest_Email
+	//	*GetUserRequest_SsoId
 	Value         isGetUserRequest_Value `protobuf_oneof:"value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -101,6 +103,15 @@ func (x *GetUserRequest) GetEmail() string {
 	return ""
 }
 
+func (x *GetUserRequest) GetSsoId() string {
+	if x != nil {
+		if x, ok := x.Value.(*GetUserRequest_SsoId); ok {
+			return x.SsoId
+		}
+	}
+	return ""
+}
+
 type isGetUserRequest_Value interface {
 	isGetUserRequest_Value()
 }
@@ -120,18 +131,34 @@ type GetUserRequest_Email struct {
 	Email string `protobuf:"bytes,3,opt,name=email,proto3,oneof"`
 }
 
+type GetUserRequest_SsoId struct {
+	// The identity provider subject of the user to retrieve.
+	SsoId string `protobuf:"bytes,4,opt,name=sso_id,json=ssoId,proto3,oneof"`
+}
+
 func (*GetUserRequest_Id) isGetUserRequest_Value() {}
 
 func (*GetUserRequest_Username) isGetUserRequest_Value() {}
 
 func (*GetUserRequest_Email) isGetUserRequest_Value() {}
 
+func (*GetUserRequest_SsoId) isGetUserRequest_Value() {}
+
 type ListUsersRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The maximum number of users to return. Default is 50, maximum is 100.
 	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	// A page token, received from a previous `ListUsers` call.
-	PageToken     string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// The order of the users, a comma separated list of fields each optionally
+	// followed by `desc`, such as `display_name desc, created_at`. Users can be
+	// ordered by `username`, `display_name`, `created_at` and `updated_at`, and
+	// are ordered newest first by default.
+	OrderBy string `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	// A CEL expression the users must match, over the `id`, `username`,
+	// `display_name`, `created_at` and `updated_at` fields of the user, such as
+	// `username.startsWith("rgr_") && created_at > timestamp("2025-01-01T00:00:00Z")`.
+	Filter        string `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -180,6 +207,20 @@ func (x *ListUsersRequest) GetPageToken() string {
 	return ""
 }
 
+func (x *ListUsersRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListUsersRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
 type ListUsersResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The users.
@@ -234,6 +275,189 @@ func (x *ListUsersResponse) GetNextPageToken() string {
 	return ""
 }
 
+type SearchUsersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The text to search for. Users match if each word of the query starts a
+	// word of their username or display name, or if their names are within a
+	// few typos of the query.
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// The maximum number of users to return. Default is 50, maximum is 100.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// A page token, received from a previous `SearchUsers` call.
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchUsersRequest) Reset() {
+	*x = SearchUsersRequest{}
+	mi := &file_milsimtools_users_v1_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchUsersRequest) ProtoMessage() {}
+
+func (x *SearchUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_milsimtools_users_v1_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchUsersRequest.ProtoReflect.Descriptor instead.
+func (*SearchUsersRequest) Descriptor() ([]byte, []int) {
+	return file_milsimtools_users_v1_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SearchUsersRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchUsersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchUsersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type SearchUsersResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The matching users, most relevant first.
+	Results []*UserSearchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	// A token, which can be sent as `page_token` to retrieve the next page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchUsersResponse) Reset() {
+	*x = SearchUsersResponse{}
+	mi := &file_milsimtools_users_v1_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchUsersResponse) ProtoMessage() {}
+
+func (x *SearchUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_milsimtools_users_v1_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchUsersResponse.ProtoReflect.Descriptor instead.
+func (*SearchUsersResponse) Descriptor() ([]byte, []int) {
+	return file_milsimtools_users_v1_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SearchUsersResponse) GetResults() []*UserSearchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *SearchUsersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type UserSearchResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The matching user.
+	User *User `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	// The relevance of the user to the query, higher is more relevant.
+	Rank float64 `protobuf:"fixed64,2,opt,name=rank,proto3" json:"rank,omitempty"`
+	// The fields of the user that matched the query, by field name. Values are
+	// HTML-escaped, with the matching words wrapped in `<mark>` tags.
+	Highlights    map[string]string `protobuf:"bytes,3,rep,name=highlights,proto3" json:"highlights,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserSearchResult) Reset() {
+	*x = UserSearchResult{}
+	mi := &file_milsimtools_users_v1_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserSearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserSearchResult) ProtoMessage() {}
+
+func (x *UserSearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_milsimtools_users_v1_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserSearchResult.ProtoReflect.Descriptor instead.
+func (*UserSearchResult) Descriptor() ([]byte, []int) {
+	return file_milsimtools_users_v1_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UserSearchResult) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *UserSearchResult) GetRank() float64 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *UserSearchResult) GetHighlights() map[string]string {
+	if x != nil {
+		return x.Highlights
+	}
+	return nil
+}
+
 type CreateUserRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The user to create.
@@ -244,7 +468,7 @@ type CreateUserRequest struct {
 
 func (x *CreateUserRequest) Reset() {
 	*x = CreateUserRequest{}
-	mi := &file_milsimtools_users_v1_service_proto_msgTypes[3]
+	mi := &file_milsimtools_users_v1_service_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -256,7 +480,7 @@ func (x *CreateUserRequest) String() string {
 func (*CreateUserRequest) ProtoMessage() {}
 
 func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_milsimtools_users_v1_service_proto_msgTypes[3]
+	mi := &file_milsimtools_users_v1_service_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -269,7 +493,7 @@ func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateUserRequest.ProtoReflect.Descriptor instead.
 func (*CreateUserRequest) Descriptor() ([]byte, []int) {
-	return file_milsimtools_users_v1_service_proto_rawDescGZIP(), []int{3}
+	return file_milsimtools_users_v1_service_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *CreateUserRequest) GetUser() *User {
@@ -293,7 +517,7 @@ type UpdateUserRequest struct {
 
 func (x *UpdateUserRequest) Reset() {
 	*x = UpdateUserRequest{}
-	mi := &file_milsimtools_users_v1_service_proto_msgTypes[4]
+	mi := &file_milsimtools_users_v1_service_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -305,7 +529,7 @@ func (x *UpdateUserRequest) String() string {
 func (*UpdateUserRequest) ProtoMessage() {}
 
 func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_milsimtools_users_v1_service_proto_msgTypes[4]
+	mi := &file_milsimtools_users_v1_service_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -318,7 +542,7 @@ func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateUserRequest.ProtoReflect.Descriptor instead.
 func (*UpdateUserRequest) Descriptor() ([]byte, []int) {
-	return file_milsimtools_users_v1_service_proto_rawDescGZIP(), []int{4}
+	return file_milsimtools_users_v1_service_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *UpdateUserRequest) GetUser() *User {
@@ -347,7 +571,7 @@ type DeleteUserRequest struct {
 
 func (x *DeleteUserRequest) Reset() {
 	*x = DeleteUserRequest{}
-	mi := &file_milsimtools_users_v1_service_proto_msgTypes[5]
+	mi := &file_milsimtools_users_v1_service_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -359,7 +583,7 @@ func (x *DeleteUserRequest) String() string {
 func (*DeleteUserRequest) ProtoMessage() {}
 
 func (x *DeleteUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_milsimtools_users_v1_service_proto_msgTypes[5]
+	mi := &file_milsimtools_users_v1_service_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -372,7 +596,7 @@ func (x *DeleteUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteUserRequest.ProtoReflect.Descriptor instead.
 func (*DeleteUserRequest) Descriptor() ([]byte, []int) {
-	return file_milsimtools_users_v1_service_proto_rawDescGZIP(), []int{5}
+	return file_milsimtools_users_v1_service_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *DeleteUserRequest) GetUserId() string {
@@ -386,19 +610,40 @@ var File_milsimtools_users_v1_service_proto protoreflect.FileDescriptor
 
 const file_milsimtools_users_v1_service_proto_rawDesc = "" +
 	"\n" +
-	"\"milsimtools/users/v1/service.proto\x12\x14milsimtools.users.v1\x1a milsimtools/users/v1/users.proto\x1a\x1bbuf/validate/validate.proto\x1a google/protobuf/field_mask.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/api/annotations.proto\"h\n" +
+	"\"milsimtools/users/v1/service.proto\x12\x14milsimtools.users.v1\x1a milsimtools/users/v1/users.proto\x1a milsimtools/authz/v1/authz.proto\x1a\x1bbuf/validate/validate.proto\x1a google/protobuf/field_mask.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/api/annotations.proto\"\x81\x01\n" +
 	"\x0eGetUserRequest\x12\x10\n" +
 	"\x02id\x18\x01 \x01(\tH\x00R\x02id\x12\x1c\n" +
 	"\busername\x18\x02 \x01(\tH\x00R\busername\x12\x16\n" +
-	"\x05email\x18\x03 \x01(\tH\x00R\x05emailB\x0e\n" +
-	"\x05value\x12\x05\xbaH\x02\b\x01\"W\n" +
+	"\x05email\x18\x03 \x01(\tH\x00R\x05email\x12\x17\n" +
+	"\x06sso_id\x18\x04 \x01(\tH\x00R\x05ssoIdB\x0e\n" +
+	"\x05value\x12\x05\xbaH\x02\b\x01\"\x8a\x01\n" +
 	"\x10ListUsersRequest\x12$\n" +
 	"\tpage_size\x18\x01 \x01(\x05B\a\xbaH\x04\x1a\x02\x18dR\bpageSize\x12\x1d\n" +
 	"\n" +
-	"page_token\x18\x02 \x01(\tR\tpageToken\"q\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x19\n" +
+	"\border_by\x18\x03 \x01(\tR\aorderBy\x12\x16\n" +
+	"\x06filter\x18\x04 \x01(\tR\x06filter\"q\n" +
 	"\x11ListUsersResponse\x124\n" +
 	"\x05users\x18\x01 \x03(\v2\x1e.milsimtools.users.v1.UserViewR\x05users\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"C\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"{\n" +
+	"\x12SearchUsersRequest\x12 \n" +
+	"\x05query\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xc8\x01R\x05query\x12$\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\a\xbaH\x04\x1a\x02\x18dR\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\x7f\n" +
+	"\x13SearchUsersResponse\x12@\n" +
+	"\aresults\x18\x01 \x03(\v2&.milsimtools.users.v1.UserSearchResultR\aresults\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xed\x01\n" +
+	"\x10UserSearchResult\x12.\n" +
+	"\x04user\x18\x01 \x01(\v2\x1a.milsimtools.users.v1.UserR\x04user\x12\x12\n" +
+	"\x04rank\x18\x02 \x01(\x01R\x04rank\x12V\n" +
+	"\n" +
+	"highlights\x18\x03 \x03(\v26.milsimtools.users.v1.UserSearchResult.HighlightsEntryR\n" +
+	"highlights\x1a=\n" +
+	"\x0fHighlightsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"C\n" +
 	"\x11CreateUserRequest\x12.\n" +
 	"\x04user\x18\x01 \x01(\v2\x1a.milsimtools.users.v1.UserR\x04user\"\x80\x01\n" +
 	"\x11UpdateUserRequest\x12.\n" +
@@ -406,16 +651,17 @@ const file_milsimtools_users_v1_service_proto_rawDesc = "" +
 	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
 	"updateMask\",\n" +
 	"\x11DeleteUserRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId2\xaa\x04\n" +
-	"\fUsersService\x12g\n" +
-	"\aGetUser\x12$.milsimtools.users.v1.GetUserRequest\x1a\x1e.milsimtools.users.v1.UserView\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/users/{id}\x12o\n" +
-	"\tListUsers\x12&.milsimtools.users.v1.ListUsersRequest\x1a'.milsimtools.users.v1.ListUsersResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/v1/users\x12d\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId2\xf6\x05\n" +
+	"\fUsersService\x12n\n" +
+	"\aGetUser\x12$.milsimtools.users.v1.GetUserRequest\x1a\x1e.milsimtools.users.v1.UserView\"\x1d\xc2\xf3\x18\x00\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/users/{id}\x90\x02\x01\x12v\n" +
+	"\tListUsers\x12&.milsimtools.users.v1.ListUsersRequest\x1a'.milsimtools.users.v1.ListUsersResponse\"\x18\xc2\xf3\x18\x00\x82\xd3\xe4\x93\x02\v\x12\t/v1/users\x90\x02\x01\x12\x83\x01\n" +
+	"\vSearchUsers\x12(.milsimtools.users.v1.SearchUsersRequest\x1a).milsimtools.users.v1.SearchUsersResponse\"\x1f\xc2\xf3\x18\x00\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/users:search\x90\x02\x01\x12{\n" +
 	"\n" +
-	"CreateUser\x12'.milsimtools.users.v1.CreateUserRequest\x1a\x1a.milsimtools.users.v1.User\"\x11\x82\xd3\xe4\x93\x02\v\"\t/v1/users\x12n\n" +
+	"CreateUser\x12'.milsimtools.users.v1.CreateUserRequest\x1a\x1a.milsimtools.users.v1.User\"(\xc2\xf3\x18\r\"\vuser.sso_id\x82\xd3\xe4\x93\x02\x11:\x04user\"\t/v1/users\x12\x81\x01\n" +
 	"\n" +
-	"UpdateUser\x12'.milsimtools.users.v1.UpdateUserRequest\x1a\x1a.milsimtools.users.v1.User\"\x1b\x82\xd3\xe4\x93\x02\x152\x13/v1/users/{user.id}\x12j\n" +
+	"UpdateUser\x12'.milsimtools.users.v1.UpdateUserRequest\x1a\x1a.milsimtools.users.v1.User\".\xc2\xf3\x18\t\x1a\auser.id\x82\xd3\xe4\x93\x02\x1b:\x04user2\x13/v1/users/{user.id}\x12w\n" +
 	"\n" +
-	"DeleteUser\x12'.milsimtools.users.v1.DeleteUserRequest\x1a\x16.google.protobuf.Empty\"\x1b\x82\xd3\xe4\x93\x02\x15*\x13/v1/users/{user_id}B\xe3\x01\n" +
+	"DeleteUser\x12'.milsimtools.users.v1.DeleteUserRequest\x1a\x16.google.protobuf.Empty\"(\xc2\xf3\x18\t\x1a\auser_id\x82\xd3\xe4\x93\x02\x15*\x13/v1/users/{user_id}B\xe3\x01\n" +
 	"\x18com.milsimtools.users.v1B\fServiceProtoP\x01ZGgithub.com/milsim-tools/pincer/pkg/api/gen/milsimtools/users/v1;usersv1\xa2\x02\x03MUX\xaa\x02\x14Milsimtools.Users.V1\xca\x02\x14Milsimtools\\Users\\V1\xe2\x02 Milsimtools\\Users\\V1\\GPBMetadata\xea\x02\x16Milsimtools::Users::V1b\x06proto3"
 
 var (
@@ -430,39 +676,48 @@ func file_milsimtools_users_v1_service_proto_rawDescGZIP() []byte {
 	return file_milsimtools_users_v1_service_proto_rawDescData
 }
 
-var file_milsimtools_users_v1_service_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_milsimtools_users_v1_service_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_milsimtools_users_v1_service_proto_goTypes = []any{
 	(*GetUserRequest)(nil),        // 0: milsimtools.users.v1.GetUserRequest
 	(*ListUsersRequest)(nil),      // 1: milsimtools.users.v1.ListUsersRequest
 	(*ListUsersResponse)(nil),     // 2: milsimtools.users.v1.ListUsersResponse
-	(*CreateUserRequest)(nil),     // 3: milsimtools.users.v1.CreateUserRequest
-	(*UpdateUserRequest)(nil),     // 4: milsimtools.users.v1.UpdateUserRequest
-	(*DeleteUserRequest)(nil),     // 5: milsimtools.users.v1.DeleteUserRequest
-	(*UserView)(nil),              // 6: milsimtools.users.v1.UserView
-	(*User)(nil),                  // 7: milsimtools.users.v1.User
-	(*fieldmaskpb.FieldMask)(nil), // 8: google.protobuf.FieldMask
-	(*emptypb.Empty)(nil),         // 9: google.protobuf.Empty
+	(*SearchUsersRequest)(nil),    // 3: milsimtools.users.v1.SearchUsersRequest
+	(*SearchUsersResponse)(nil),   // 4: milsimtools.users.v1.SearchUsersResponse
+	(*UserSearchResult)(nil),      // 5: milsimtools.users.v1.UserSearchResult
+	(*CreateUserRequest)(nil),     // 6: milsimtools.users.v1.CreateUserRequest
+	(*UpdateUserRequest)(nil),     // 7: milsimtools.users.v1.UpdateUserRequest
+	(*DeleteUserRequest)(nil),     // 8: milsimtools.users.v1.DeleteUserRequest
+	nil,                           // 9: milsimtools.users.v1.UserSearchResult.HighlightsEntry
+	(*UserView)(nil),              // 10: milsimtools.users.v1.UserView
+	(*User)(nil),                  // 11: milsimtools.users.v1.User
+	(*fieldmaskpb.FieldMask)(nil), // 12: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),         // 13: google.protobuf.Empty
 }
 var file_milsimtools_users_v1_service_proto_depIdxs = []int32{
-	6, // 0: milsimtools.users.v1.ListUsersResponse.users:type_name -> milsimtools.users.v1.UserView
-	7, // 1: milsimtools.users.v1.CreateUserRequest.user:type_name -> milsimtools.users.v1.User
-	7, // 2: milsimtools.users.v1.UpdateUserRequest.user:type_name -> milsimtools.users.v1.User
-	8, // 3: milsimtools.users.v1.UpdateUserRequest.update_mask:type_name -> google.protobuf.FieldMask
-	0, // 4: milsimtools.users.v1.UsersService.GetUser:input_type -> milsimtools.users.v1.GetUserRequest
-	1, // 5: milsimtools.users.v1.UsersService.ListUsers:input_type -> milsimtools.users.v1.ListUsersRequest
-	3, // 6: milsimtools.users.v1.UsersService.CreateUser:input_type -> milsimtools.users.v1.CreateUserRequest
-	4, // 7: milsimtools.users.v1.UsersService.UpdateUser:input_type -> milsimtools.users.v1.UpdateUserRequest
-	5, // 8: milsimtools.users.v1.UsersService.DeleteUser:input_type -> milsimtools.users.v1.DeleteUserRequest
-	6, // 9: milsimtools.users.v1.UsersService.GetUser:output_type -> milsimtools.users.v1.UserView
-	2, // 10: milsimtools.users.v1.UsersService.ListUsers:output_type -> milsimtools.users.v1.ListUsersResponse
-	7, // 11: milsimtools.users.v1.UsersService.CreateUser:output_type -> milsimtools.users.v1.User
-	7, // 12: milsimtools.users.v1.UsersService.UpdateUser:output_type -> milsimtools.users.v1.User
-	9, // 13: milsimtools.users.v1.UsersService.DeleteUser:output_type -> google.protobuf.Empty
-	9, // [9:14] is the sub-list for method output_type
-	4, // [4:9] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	10, // 0: milsimtools.users.v1.ListUsersResponse.users:type_name -> milsimtools.users.v1.UserView
+	5,  // 1: milsimtools.users.v1.SearchUsersResponse.results:type_name -> milsimtools.users.v1.UserSearchResult
+	11, // 2: milsimtools.users.v1.UserSearchResult.user:type_name -> milsimtools.users.v1.User
+	9,  // 3: milsimtools.users.v1.UserSearchResult.highlights:type_name -> milsimtools.users.v1.UserSearchResult.HighlightsEntry
+	11, // 4: milsimtools.users.v1.CreateUserRequest.user:type_name -> milsimtools.users.v1.User
+	11, // 5: milsimtools.users.v1.UpdateUserRequest.user:type_name -> milsimtools.users.v1.User
+	12, // 6: milsimtools.users.v1.UpdateUserRequest.update_mask:type_name -> google.protobuf.FieldMask
+	0,  // 7: milsimtools.users.v1.UsersService.GetUser:input_type -> milsimtools.users.v1.GetUserRequest
+	1,  // 8: milsimtools.users.v1.UsersService.ListUsers:input_type -> milsimtools.users.v1.ListUsersRequest
+	3,  // 9: milsimtools.users.v1.UsersService.SearchUsers:input_type -> milsimtools.users.v1.SearchUsersRequest
+	6,  // 10: milsimtools.users.v1.UsersService.CreateUser:input_type -> milsimtools.users.v1.CreateUserRequest
+	7,  // 11: milsimtools.users.v1.UsersService.UpdateUser:input_type -> milsimtools.users.v1.UpdateUserRequest
+	8,  // 12: milsimtools.users.v1.UsersService.DeleteUser:input_type -> milsimtools.users.v1.DeleteUserRequest
+	10, // 13: milsimtools.users.v1.UsersService.GetUser:output_type -> milsimtools.users.v1.UserView
+	2,  // 14: milsimtools.users.v1.UsersService.ListUsers:output_type -> milsimtools.users.v1.ListUsersResponse
+	4,  // 15: milsimtools.users.v1.UsersService.SearchUsers:output_type -> milsimtools.users.v1.SearchUsersResponse
+	11, // 16: milsimtools.users.v1.UsersService.CreateUser:output_type -> milsimtools.users.v1.User
+	11, // 17: milsimtools.users.v1.UsersService.UpdateUser:output_type -> milsimtools.users.v1.User
+	13, // 18: milsimtools.users.v1.UsersService.DeleteUser:output_type -> google.protobuf.Empty
+	13, // [13:19] is the sub-list for method output_type
+	7,  // [7:13] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_milsimtools_users_v1_service_proto_init() }
@@ -475,6 +730,7 @@ func file_milsimtools_users_v1_service_proto_init() {
 		(*GetUserRequest_Id)(nil),
 		(*GetUserRequest_Username)(nil),
 		(*GetUserRequest_Email)(nil),
+		(*GetUserRequest_SsoId)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -482,7 +738,7 @@ func file_milsimtools_users_v1_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_milsimtools_users_v1_service_proto_rawDesc), len(file_milsimtools_users_v1_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -20,11 +20,12 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	UsersService_GetUser_FullMethodName    = "/milsimtools.users.v1.UsersService/GetUser"
-	UsersService_ListUsers_FullMethodName  = "/milsimtools.users.v1.UsersService/ListUsers"
-	UsersService_CreateUser_FullMethodName = "/milsimtools.users.v1.UsersService/CreateUser"
-	UsersService_UpdateUser_FullMethodName = "/milsimtools.users.v1.UsersService/UpdateUser"
-	UsersService_DeleteUser_FullMethodName = "/milsimtools.users.v1.UsersService/DeleteUser"
+	UsersService_GetUser_FullMethodName     = "/milsimtools.users.v1.UsersService/GetUser"
+	UsersService_ListUsers_FullMethodName   = "/milsimtools.users.v1.UsersService/ListUsers"
+	UsersService_SearchUsers_FullMethodName = "/milsimtools.users.v1.UsersService/SearchUsers"
+	UsersService_CreateUser_FullMethodName  = "/milsimtools.users.v1.UsersService/CreateUser"
+	UsersService_UpdateUser_FullMethodName  = "/milsimtools.users.v1.UsersService/UpdateUser"
+	UsersService_DeleteUser_FullMethodName  = "/milsimtools.users.v1.UsersService/DeleteUser"
 )
 
 // UsersServiceClient is the client API for UsersService service.
@@ -35,9 +36,12 @@ type UsersServiceClient interface {
 	GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*UserView, error)
 	// Lists users.
 	ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error)
+	// Searches users by their names.
+	SearchUsers(ctx context.Context, in *SearchUsersRequest, opts ...grpc.CallOption) (*SearchUsersResponse, error)
 	// Create a new user.
 	CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*User, error)
-	// Update an existing user by its ID.
+	// Update an existing user by its ID. The `sso_id` and `email` fields come
+	// from the identity provider, and are only updated by other modules.
 	UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*User, error)
 	// Update an existing user by its ID.
 	DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
@@ -71,6 +75,16 @@ func (c *usersServiceClient) ListUsers(ctx context.Context, in *ListUsersRequest
 	return out, nil
 }
 
+func (c *usersServiceClient) SearchUsers(ctx context.Context, in *SearchUsersRequest, opts ...grpc.CallOption) (*SearchUsersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchUsersResponse)
+	err := c.cc.Invoke(ctx, UsersService_SearchUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *usersServiceClient) CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*User, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(User)
@@ -109,9 +123,12 @@ type UsersServiceServer interface {
 	GetUser(context.Context, *GetUserRequest) (*UserView, error)
 	// Lists users.
 	ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error)
+	// Searches users by their names.
+	SearchUsers(context.Context, *SearchUsersRequest) (*SearchUsersResponse, error)
 	// Create a new user.
 	CreateUser(context.Context, *CreateUserRequest) (*User, error)
-	// Update an existing user by its ID.
+	// Update an existing user by its ID. The `sso_id` and `email` fields come
+	// from the identity provider, and are only updated by other modules.
 	UpdateUser(context.Context, *UpdateUserRequest) (*User, error)
 	// Update an existing user by its ID.
 	DeleteUser(context.Context, *DeleteUserRequest) (*emptypb.Empty, error)
@@ -131,6 +148,9 @@ func (UnimplementedUsersServiceServer) GetUser(context.Context, *GetUserRequest)
 func (UnimplementedUsersServiceServer) ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListUsers not implemented")
 }
+func (UnimplementedUsersServiceServer) SearchUsers(context.Context, *SearchUsersRequest) (*SearchUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchUsers not implemented")
+}
 func (UnimplementedUsersServiceServer) CreateUser(context.Context, *CreateUserRequest) (*User, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateUser not implemented")
 }
@@ -197,6 +217,24 @@ func _UsersService_ListUsers_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UsersService_SearchUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServiceServer).SearchUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersService_SearchUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServiceServer).SearchUsers(ctx, req.(*SearchUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UsersService_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateUserRequest)
 	if err := dec(in); err != nil {
@@ -266,6 +304,10 @@ var UsersService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListUsers",
 			Handler:    _UsersService_ListUsers_Handler,
 		},
+		{
+			MethodName: "SearchUsers",
+			Handler:    _UsersService_SearchUsers_Handler,
+		},
 		{
 			MethodName: "CreateUser",
 			Handler:    _UsersService_CreateUser_Handler,
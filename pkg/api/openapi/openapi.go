@@ -0,0 +1,27 @@
+// Package openapi embeds the OpenAPI document generated from the API
+// definitions by protoc-gen-openapiv2 (see buf.gen.yaml).
+package openapi
+
+import (
+	_ "embed"
+	"encoding/json"
+)
+
+//go:embed pincer.swagger.json
+var spec []byte
+
+// Spec returns the merged OpenAPI document for every Pincer service, with its
+// info block filled in for the given version.
+func Spec(version string) ([]byte, error) {
+	var doc map[string]any
+	if err := json.Unmarshal(spec, &doc); err != nil {
+		return nil, err
+	}
+
+	doc["info"] = map[string]any{
+		"title":   "Pincer API",
+		"version": version,
+	}
+
+	return json.Marshal(doc)
+}
@@ -0,0 +1,50 @@
+package applications
+
+import (
+	"context"
+	"time"
+
+	applicationsv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/applications/v1"
+	membersv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/members/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Applications) AcceptApplication(ctx context.Context, req *applicationsv1.AcceptApplicationRequest) (*applicationsv1.Application, error) {
+	application, err := s.application(ctx, req.UnitId, req.Id)
+	if err != nil {
+		return &applicationsv1.Application{}, err
+	}
+	if err := pending(application); err != nil {
+		return &applicationsv1.Application{}, err
+	}
+
+	// The applicant is admitted before the application is marked accepted, so
+	// that a failure leaves the application pending to be accepted again.
+	// Admitting an approved member again leaves them as they are.
+	if _, err := s.members.AdmitMember(ctx, &membersv1.AdmitMemberRequest{
+		UnitId:      application.UnitID,
+		UserId:      application.UserID,
+		Permissions: req.Permissions,
+	}); err != nil {
+		switch status.Code(err) {
+		case codes.FailedPrecondition, codes.InvalidArgument, codes.PermissionDenied:
+			return &applicationsv1.Application{}, err
+		}
+		return &applicationsv1.Application{}, status.Error(
+			codes.Internal,
+			"failed to call members service: "+err.Error(),
+		)
+	}
+
+	now := time.Now()
+	application.Status = int32(applicationsv1.ApplicationStatus_APPLICATION_STATUS_ACCEPTED)
+	application.DecidedBy = caller(ctx)
+	application.DecidedAt = &now
+
+	if err := s.decide(ctx, application); err != nil {
+		return &applicationsv1.Application{}, err
+	}
+
+	return application.Proto(), nil
+}
@@ -0,0 +1,104 @@
+package applications
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/grafana/dskit/services"
+	"github.com/milsim-tools/pincer/internal/helpers"
+	applicationsv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/applications/v1"
+	membersv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/members/v1"
+	unitsv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/units/v1"
+	usersv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/users/v1"
+	"github.com/milsim-tools/pincer/pkg/db"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	FlagUsersGrpcAddr   = "applications-users-grpc-addr"
+	FlagUnitsGrpcAddr   = "applications-units-grpc-addr"
+	FlagMembersGrpcAddr = "applications-members-grpc-addr"
+)
+
+var Flags = []cli.Flag{
+	&cli.StringFlag{
+		Name:    FlagUsersGrpcAddr,
+		Value:   "localhost:9000",
+		Usage:   "The users service, used when the users module runs in another process.",
+		EnvVars: []string{"PINCER_APPLICATIONS_USERS_GRPC_ADDR"},
+	},
+
+	&cli.StringFlag{
+		Name:    FlagUnitsGrpcAddr,
+		Value:   "localhost:9000",
+		Usage:   "The units service, used when the units module runs in another process.",
+		EnvVars: []string{"PINCER_APPLICATIONS_UNITS_GRPC_ADDR"},
+	},
+
+	&cli.StringFlag{
+		Name:    FlagMembersGrpcAddr,
+		Value:   "localhost:9000",
+		Usage:   "The members service, used when the members module runs in another process.",
+		EnvVars: []string{"PINCER_APPLICATIONS_MEMBERS_GRPC_ADDR"},
+	},
+}
+
+type Config struct {
+	UsersGrpcAddr   string
+	UnitsGrpcAddr   string
+	MembersGrpcAddr string
+}
+
+func ConfigFromFlags(ctx *cli.Context) Config {
+	var config Config
+
+	config.UsersGrpcAddr = ctx.String(FlagUsersGrpcAddr)
+	config.UnitsGrpcAddr = ctx.String(FlagUnitsGrpcAddr)
+	config.MembersGrpcAddr = ctx.String(FlagMembersGrpcAddr)
+
+	return config
+}
+
+type Applications struct {
+	applicationsv1.ApplicationsServiceServer
+	services.Service
+
+	cfg    Config
+	logger *slog.Logger
+
+	db    *db.Db
+	pages *helpers.Paginator
+
+	users   usersv1.UsersServiceClient
+	units   unitsv1.UnitsServiceClient
+	members membersv1.MembersServiceClient
+}
+
+func New(
+	logger *slog.Logger,
+	cfg Config,
+	db *db.Db,
+	pages *helpers.Paginator,
+	users usersv1.UsersServiceClient,
+	units unitsv1.UnitsServiceClient,
+	members membersv1.MembersServiceClient,
+) (*Applications, error) {
+	a := &Applications{
+		cfg:     cfg,
+		logger:  logger,
+		db:      db,
+		pages:   pages,
+		users:   users,
+		units:   units,
+		members: members,
+	}
+
+	a.Service = services.NewIdleService(a.starting, nil)
+
+	return a, nil
+}
+
+// starting migrates the database once it is reachable.
+func (s *Applications) starting(ctx context.Context) error {
+	return s.db.Migrate(ctx, Migrations)
+}
@@ -0,0 +1,34 @@
+package applications
+
+import (
+	"context"
+
+	"github.com/milsim-tools/pincer/pkg/authz"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// caller returns the ID of the user calling, to whom comments and votes are
+// attributed. It is empty when authentication is disabled.
+func caller(ctx context.Context) string {
+	identity, _ := authz.FromContext(ctx)
+	return identity.UserID
+}
+
+// reviewer returns the ID of the user calling, failing if the caller is not
+// linked to a user, as a review must be attributed to a reviewer. It is empty
+// when authentication is disabled, as the caller is then unknown.
+func reviewer(ctx context.Context) (string, error) {
+	identity, ok := authz.FromContext(ctx)
+	if !ok {
+		return "", nil
+	}
+
+	if identity.UserID == "" {
+		return "", status.Error(
+			codes.PermissionDenied,
+			"reviews are attributed to the caller, who must be signed in as a user",
+		)
+	}
+	return identity.UserID, nil
+}
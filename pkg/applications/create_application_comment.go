@@ -0,0 +1,41 @@
+package applications
+
+import (
+	"context"
+
+	"github.com/milsim-tools/pincer/internal/models"
+	applicationsv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/applications/v1"
+	"github.com/oklog/ulid/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+func (s *Applications) CreateApplicationComment(ctx context.Context, req *applicationsv1.CreateApplicationCommentRequest) (*applicationsv1.ApplicationComment, error) {
+	authorID, err := reviewer(ctx)
+	if err != nil {
+		return &applicationsv1.ApplicationComment{}, err
+	}
+
+	if _, err := s.application(ctx, req.UnitId, req.ApplicationId); err != nil {
+		return &applicationsv1.ApplicationComment{}, err
+	}
+
+	comment := &ApplicationsComment{
+		Model: models.Model{
+			ID: ulid.Make().String(),
+		},
+		ApplicationID: req.ApplicationId,
+		AuthorID:      authorID,
+		Body:          req.Body,
+	}
+
+	if err := gorm.G[ApplicationsComment](s.db.Db).Create(ctx, comment); err != nil {
+		return &applicationsv1.ApplicationComment{}, status.Error(
+			codes.Internal,
+			"failed to create comment: "+err.Error(),
+		)
+	}
+
+	return comment.Proto(), nil
+}
@@ -0,0 +1,38 @@
+package applications
+
+import (
+	"context"
+
+	applicationsv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/applications/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"gorm.io/gorm"
+)
+
+func (s *Applications) DeleteUnitApplications(ctx context.Context, req *applicationsv1.DeleteUnitApplicationsRequest) (*emptypb.Empty, error) {
+	err := s.db.Db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		applications := tx.Model(&ApplicationsApplication{}).Select("id").Where("unit_id = ?", req.UnitId)
+
+		if _, err := gorm.G[ApplicationsVote](tx).Where("application_id IN (?)", applications).Delete(ctx); err != nil {
+			return err
+		}
+		if _, err := gorm.G[ApplicationsComment](tx).Where("application_id IN (?)", applications).Delete(ctx); err != nil {
+			return err
+		}
+		if _, err := gorm.G[ApplicationsApplication](tx).Where("unit_id = ?", req.UnitId).Delete(ctx); err != nil {
+			return err
+		}
+
+		_, err := gorm.G[ApplicationsForm](tx).Where("unit_id = ?", req.UnitId).Delete(ctx)
+		return err
+	})
+	if err != nil {
+		return &emptypb.Empty{}, status.Error(
+			codes.Internal,
+			"failed to delete applications: "+err.Error(),
+		)
+	}
+
+	return &emptypb.Empty{}, nil
+}
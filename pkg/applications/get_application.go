@@ -0,0 +1,64 @@
+package applications
+
+import (
+	"context"
+	"errors"
+
+	applicationsv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/applications/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+func (s *Applications) GetApplication(ctx context.Context, req *applicationsv1.GetApplicationRequest) (*applicationsv1.Application, error) {
+	application, err := s.application(ctx, req.UnitId, req.Id)
+	if err != nil {
+		return &applicationsv1.Application{}, err
+	}
+
+	return application.Proto(), nil
+}
+
+// application returns an application to the unit. Applications are looked up
+// within the unit the caller was authorized for, so that the unit's reviewers
+// cannot reach the applications to other units.
+func (s *Applications) application(ctx context.Context, unitID string, id string) (ApplicationsApplication, error) {
+	application, err := gorm.G[ApplicationsApplication](s.db.Db).
+		Where("id = ? AND unit_id = ?", id, unitID).
+		First(ctx)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ApplicationsApplication{}, status.Error(
+				codes.NotFound,
+				"application not found",
+			)
+		}
+
+		return ApplicationsApplication{}, status.Error(
+			codes.Internal,
+			"failed to query application: "+err.Error(),
+		)
+	}
+
+	return application, nil
+}
+
+// pending returns a FailedPrecondition status error unless the application
+// awaits a decision.
+func pending(application ApplicationsApplication) error {
+	if applicationsv1.ApplicationStatus(application.Status) != applicationsv1.ApplicationStatus_APPLICATION_STATUS_PENDING {
+		return status.Error(
+			codes.FailedPrecondition,
+			"the application was already "+decision(application.Status),
+		)
+	}
+	return nil
+}
+
+// decision names the decision taken on an application.
+func decision(s int32) string {
+	if applicationsv1.ApplicationStatus(s) == applicationsv1.ApplicationStatus_APPLICATION_STATUS_ACCEPTED {
+		return "accepted"
+	}
+	return "rejected"
+}
@@ -0,0 +1,30 @@
+package applications
+
+import (
+	"context"
+	"errors"
+
+	applicationsv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/applications/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+func (s *Applications) GetApplicationForm(ctx context.Context, req *applicationsv1.GetApplicationFormRequest) (*applicationsv1.ApplicationForm, error) {
+	form, err := gorm.G[ApplicationsForm](s.db.Db).Where("unit_id = ?", req.UnitId).First(ctx)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &applicationsv1.ApplicationForm{}, status.Error(
+				codes.NotFound,
+				"the unit has no application form",
+			)
+		}
+
+		return &applicationsv1.ApplicationForm{}, status.Error(
+			codes.Internal,
+			"failed to query application form: "+err.Error(),
+		)
+	}
+
+	return form.Proto(), nil
+}
@@ -0,0 +1,57 @@
+package applications
+
+import (
+	"context"
+
+	applicationsv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/applications/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+func (s *Applications) GetApplicationReview(ctx context.Context, req *applicationsv1.GetApplicationReviewRequest) (*applicationsv1.ApplicationReview, error) {
+	if _, err := s.application(ctx, req.UnitId, req.ApplicationId); err != nil {
+		return &applicationsv1.ApplicationReview{}, err
+	}
+
+	comments, err := gorm.G[ApplicationsComment](s.db.Db).
+		Where("application_id = ?", req.ApplicationId).
+		Order("created_at, id").
+		Find(ctx)
+	if err != nil {
+		return &applicationsv1.ApplicationReview{}, status.Error(
+			codes.Internal,
+			"failed to query comments: "+err.Error(),
+		)
+	}
+
+	votes, err := gorm.G[ApplicationsVote](s.db.Db).
+		Where("application_id = ?", req.ApplicationId).
+		Order("updated_at, reviewer_id").
+		Find(ctx)
+	if err != nil {
+		return &applicationsv1.ApplicationReview{}, status.Error(
+			codes.Internal,
+			"failed to query votes: "+err.Error(),
+		)
+	}
+
+	review := &applicationsv1.ApplicationReview{
+		ApplicationId: req.ApplicationId,
+	}
+	for _, comment := range comments {
+		review.Comments = append(review.Comments, comment.Proto())
+	}
+	for _, vote := range votes {
+		review.Votes = append(review.Votes, vote.Proto())
+
+		switch applicationsv1.ApplicationVoteValue(vote.Value) {
+		case applicationsv1.ApplicationVoteValue_APPLICATION_VOTE_VALUE_APPROVE:
+			review.Approvals++
+		case applicationsv1.ApplicationVoteValue_APPLICATION_VOTE_VALUE_REJECT:
+			review.Rejections++
+		}
+	}
+
+	return review, nil
+}
@@ -0,0 +1,66 @@
+package applications
+
+import (
+	"context"
+	"errors"
+
+	"github.com/milsim-tools/pincer/internal/helpers"
+	applicationsv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/applications/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// filter translates the filters of ListApplications.
+var filter = helpers.MustNewFilter(&applicationsv1.Application{}, helpers.FilterColumns{
+	"unit_id":    "unit_id",
+	"user_id":    "user_id",
+	"status":     "status",
+	"decided_at": "decided_at",
+	"created_at": "created_at",
+})
+
+func (s *Applications) ListApplications(ctx context.Context, req *applicationsv1.ListApplicationsRequest) (*applicationsv1.ListApplicationsResponse, error) {
+	where, err := filter.Parse(req.Filter)
+	if err != nil {
+		return &applicationsv1.ListApplicationsResponse{}, err
+	}
+
+	qb := gorm.G[ApplicationsApplication](s.db.Replica).Select("*")
+	if where != nil {
+		qb = qb.Where(where)
+	}
+	if req.UnitId != "" {
+		qb = qb.Where("unit_id = ?", req.UnitId)
+	}
+	if req.UserId != "" {
+		qb = qb.Where("user_id = ?", req.UserId)
+	}
+
+	applications, next, err := helpers.Paginate(ctx, s.pages, qb, helpers.Page{
+		Size:    int(req.PageSize),
+		Token:   req.PageToken,
+		Filters: []any{req.UnitId, req.UserId, req.Filter},
+	})
+	if err != nil {
+		if errors.Is(err, helpers.ErrInvalidPageToken) {
+			return &applicationsv1.ListApplicationsResponse{}, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return &applicationsv1.ListApplicationsResponse{}, status.Error(
+			codes.Internal,
+			"failed to query applications: "+err.Error(),
+		)
+	}
+
+	var applicationViews []*applicationsv1.Application
+	for _, application := range applications {
+		applicationViews = append(applicationViews, application.Proto())
+	}
+
+	resp := &applicationsv1.ListApplicationsResponse{
+		Applications:  applicationViews,
+		NextPageToken: next,
+	}
+
+	return resp, nil
+}
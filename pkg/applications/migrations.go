@@ -0,0 +1,17 @@
+package applications
+
+import (
+	"embed"
+
+	"github.com/milsim-tools/pincer/pkg/db/migrate"
+)
+
+//go:embed migrations/*.sql
+var migrationsFS embed.FS
+
+// Migrations are the schema migrations of the applications module.
+var Migrations = migrate.Source{
+	Module: "applications",
+	FS:     migrationsFS,
+	Dir:    "migrations",
+}
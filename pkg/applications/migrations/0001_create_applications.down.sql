@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS applications_votes;
+DROP TABLE IF EXISTS applications_comments;
+DROP TABLE IF EXISTS applications_applications;
+DROP TABLE IF EXISTS applications_forms;
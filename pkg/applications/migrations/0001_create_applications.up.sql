@@ -0,0 +1,48 @@
+CREATE TABLE IF NOT EXISTS applications_forms (
+  unit_id text PRIMARY KEY,
+  created_at timestamptz,
+  updated_at timestamptz,
+  description text NOT NULL,
+  open boolean NOT NULL,
+  questions text NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS applications_applications (
+  id text PRIMARY KEY,
+  created_at timestamptz,
+  updated_at timestamptz,
+  unit_id text NOT NULL,
+  user_id text NOT NULL,
+  status integer NOT NULL DEFAULT 1,
+  answers text NOT NULL,
+  rejection_reason text NOT NULL,
+  reapply_after timestamptz,
+  decided_by text NOT NULL,
+  decided_at timestamptz
+);
+
+CREATE INDEX IF NOT EXISTS idx_applications_applications_unit_id ON applications_applications (unit_id);
+CREATE INDEX IF NOT EXISTS idx_applications_applications_user_id ON applications_applications (user_id);
+
+-- A user has at most one pending application to a unit.
+CREATE UNIQUE INDEX IF NOT EXISTS idx_applications_applications_pending ON applications_applications (unit_id, user_id) WHERE status = 1;
+
+CREATE TABLE IF NOT EXISTS applications_comments (
+  id text PRIMARY KEY,
+  created_at timestamptz,
+  updated_at timestamptz,
+  application_id text NOT NULL,
+  author_id text NOT NULL,
+  body text NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_applications_comments_application_id ON applications_comments (application_id);
+
+CREATE TABLE IF NOT EXISTS applications_votes (
+  application_id text NOT NULL,
+  reviewer_id text NOT NULL,
+  created_at timestamptz,
+  updated_at timestamptz,
+  value integer NOT NULL,
+  PRIMARY KEY (application_id, reviewer_id)
+);
@@ -0,0 +1,147 @@
+package applications
+
+import (
+	"time"
+
+	"github.com/milsim-tools/pincer/internal/models"
+	applicationsv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/applications/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type ApplicationsForm struct {
+	UnitID      string     `gorm:"primaryKey"`
+	Description string     `gorm:"notNull"`
+	Open        bool       `gorm:"notNull"`
+	Questions   []Question `gorm:"serializer:json;notNull"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+func (f ApplicationsForm) Proto() *applicationsv1.ApplicationForm {
+	questions := make([]*applicationsv1.ApplicationQuestion, 0, len(f.Questions))
+	for _, q := range f.Questions {
+		questions = append(questions, q.Proto())
+	}
+
+	return &applicationsv1.ApplicationForm{
+		UnitId:      f.UnitID,
+		Description: f.Description,
+		Open:        f.Open,
+		Questions:   questions,
+		CreatedAt:   timestamppb.New(f.CreatedAt),
+		UpdatedAt:   timestamppb.New(f.UpdatedAt),
+	}
+}
+
+// Question is a question of an ApplicationsForm, stored with the form.
+type Question struct {
+	ID          string   `json:"id"`
+	Prompt      string   `json:"prompt"`
+	Description string   `json:"description,omitempty"`
+	Type        int32    `json:"type"`
+	Required    bool     `json:"required,omitempty"`
+	Options     []string `json:"options,omitempty"`
+}
+
+func (q Question) Proto() *applicationsv1.ApplicationQuestion {
+	return &applicationsv1.ApplicationQuestion{
+		Id:          q.ID,
+		Prompt:      q.Prompt,
+		Description: q.Description,
+		Type:        applicationsv1.ApplicationQuestionType(q.Type),
+		Required:    q.Required,
+		Options:     q.Options,
+	}
+}
+
+type ApplicationsApplication struct {
+	models.Model
+
+	UnitID          string   `gorm:"notNull;index"`
+	UserID          string   `gorm:"notNull;index"`
+	Status          int32    `gorm:"notNull;default:1"`
+	Answers         []Answer `gorm:"serializer:json;notNull"`
+	RejectionReason string   `gorm:"notNull"`
+	ReapplyAfter    *time.Time
+	DecidedBy       string `gorm:"notNull"`
+	DecidedAt       *time.Time
+}
+
+func (a ApplicationsApplication) Proto() *applicationsv1.Application {
+	answers := make([]*applicationsv1.ApplicationAnswer, 0, len(a.Answers))
+	for _, answer := range a.Answers {
+		answers = append(answers, answer.Proto())
+	}
+
+	application := &applicationsv1.Application{
+		Id:              a.ID,
+		UnitId:          a.UnitID,
+		UserId:          a.UserID,
+		Status:          applicationsv1.ApplicationStatus(a.Status),
+		Answers:         answers,
+		RejectionReason: a.RejectionReason,
+		DecidedBy:       a.DecidedBy,
+		CreatedAt:       timestamppb.New(a.CreatedAt),
+		UpdatedAt:       timestamppb.New(a.UpdatedAt),
+	}
+	if a.ReapplyAfter != nil {
+		application.ReapplyAfter = timestamppb.New(*a.ReapplyAfter)
+	}
+	if a.DecidedAt != nil {
+		application.DecidedAt = timestamppb.New(*a.DecidedAt)
+	}
+
+	return application
+}
+
+// Answer is an answer of an ApplicationsApplication, stored with the
+// application along with the question as it was asked.
+type Answer struct {
+	QuestionID string   `json:"question_id"`
+	Prompt     string   `json:"prompt"`
+	Text       string   `json:"text,omitempty"`
+	Choices    []string `json:"choices,omitempty"`
+}
+
+func (a Answer) Proto() *applicationsv1.ApplicationAnswer {
+	return &applicationsv1.ApplicationAnswer{
+		QuestionId: a.QuestionID,
+		Prompt:     a.Prompt,
+		Text:       a.Text,
+		Choices:    a.Choices,
+	}
+}
+
+type ApplicationsComment struct {
+	models.Model
+
+	ApplicationID string `gorm:"notNull;index"`
+	AuthorID      string `gorm:"notNull"`
+	Body          string `gorm:"notNull"`
+}
+
+func (c ApplicationsComment) Proto() *applicationsv1.ApplicationComment {
+	return &applicationsv1.ApplicationComment{
+		Id:            c.ID,
+		ApplicationId: c.ApplicationID,
+		AuthorId:      c.AuthorID,
+		Body:          c.Body,
+		CreatedAt:     timestamppb.New(c.CreatedAt),
+	}
+}
+
+type ApplicationsVote struct {
+	ApplicationID string `gorm:"primaryKey"`
+	ReviewerID    string `gorm:"primaryKey"`
+	Value         int32  `gorm:"notNull"`
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+func (v ApplicationsVote) Proto() *applicationsv1.ApplicationVote {
+	return &applicationsv1.ApplicationVote{
+		ReviewerId: v.ReviewerID,
+		Value:      applicationsv1.ApplicationVoteValue(v.Value),
+		UpdatedAt:  timestamppb.New(v.UpdatedAt),
+	}
+}
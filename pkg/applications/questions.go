@@ -0,0 +1,119 @@
+package applications
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/milsim-tools/pincer/internal/helpers"
+	applicationsv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/applications/v1"
+	"github.com/oklog/ulid/v2"
+)
+
+// maxShortTextLength bounds the answers to short text questions, in
+// characters.
+const maxShortTextLength = 500
+
+// isChoice reports whether questions of the type are answered with options.
+func isChoice(t applicationsv1.ApplicationQuestionType) bool {
+	return t == applicationsv1.ApplicationQuestionType_APPLICATION_QUESTION_TYPE_SINGLE_CHOICE ||
+		t == applicationsv1.ApplicationQuestionType_APPLICATION_QUESTION_TYPE_MULTIPLE_CHOICE
+}
+
+// questions validates the questions of an updated form, keeping the IDs of
+// the questions it already had and assigning IDs to new ones.
+func questions(previous []Question, updated []*applicationsv1.ApplicationQuestion) ([]Question, error) {
+	result := make([]Question, 0, len(updated))
+	for i, q := range updated {
+		field := fmt.Sprintf("form.questions[%d]", i)
+
+		id := q.Id
+		if id == "" {
+			id = ulid.Make().String()
+		} else if !slices.ContainsFunc(previous, func(p Question) bool { return p.ID == id }) {
+			return nil, helpers.InvalidArgument(field+".id", "the form has no question "+id+", leave the ID out to add a question")
+		} else if slices.ContainsFunc(result, func(r Question) bool { return r.ID == id }) {
+			return nil, helpers.InvalidArgument(field+".id", "the question "+id+" is asked more than once")
+		}
+
+		if isChoice(q.Type) && len(q.Options) == 0 {
+			return nil, helpers.InvalidArgument(field+".options", "choice questions need options")
+		}
+		if !isChoice(q.Type) && len(q.Options) > 0 {
+			return nil, helpers.InvalidArgument(field+".options", "only choice questions have options")
+		}
+
+		result = append(result, Question{
+			ID:          id,
+			Prompt:      q.Prompt,
+			Description: q.Description,
+			Type:        int32(q.Type),
+			Required:    q.Required,
+			Options:     q.Options,
+		})
+	}
+
+	return result, nil
+}
+
+// answers validates the answers to the questions of a form, and returns them
+// in the order the questions are asked.
+func answers(questions []Question, given []*applicationsv1.ApplicationAnswer) ([]Answer, error) {
+	byQuestion := make(map[string]*applicationsv1.ApplicationAnswer, len(given))
+	for _, a := range given {
+		if !slices.ContainsFunc(questions, func(q Question) bool { return q.ID == a.QuestionId }) {
+			return nil, helpers.InvalidArgument("answers", "the form has no question "+a.QuestionId)
+		}
+		if _, ok := byQuestion[a.QuestionId]; ok {
+			return nil, helpers.InvalidArgument("answers", "the question "+a.QuestionId+" is answered more than once")
+		}
+		byQuestion[a.QuestionId] = a
+	}
+
+	result := make([]Answer, 0, len(given))
+	for _, q := range questions {
+		a, ok := byQuestion[q.ID]
+		if !ok || (strings.TrimSpace(a.Text) == "" && len(a.Choices) == 0) {
+			if q.Required {
+				return nil, helpers.InvalidArgument("answers", fmt.Sprintf("the question %q must be answered", q.Prompt))
+			}
+			continue
+		}
+
+		t := applicationsv1.ApplicationQuestionType(q.Type)
+		if isChoice(t) {
+			if a.Text != "" {
+				return nil, helpers.InvalidArgument("answers", fmt.Sprintf("the question %q is answered with choices", q.Prompt))
+			}
+			if t == applicationsv1.ApplicationQuestionType_APPLICATION_QUESTION_TYPE_SINGLE_CHOICE && len(a.Choices) > 1 {
+				return nil, helpers.InvalidArgument("answers", fmt.Sprintf("the question %q takes a single choice", q.Prompt))
+			}
+			for i, choice := range a.Choices {
+				if !slices.Contains(q.Options, choice) {
+					return nil, helpers.InvalidArgument("answers", fmt.Sprintf("%q is not an option of the question %q", choice, q.Prompt))
+				}
+				if slices.Contains(a.Choices[:i], choice) {
+					return nil, helpers.InvalidArgument("answers", fmt.Sprintf("%q is chosen more than once", choice))
+				}
+			}
+		} else {
+			if len(a.Choices) > 0 {
+				return nil, helpers.InvalidArgument("answers", fmt.Sprintf("the question %q is answered with text", q.Prompt))
+			}
+			if t == applicationsv1.ApplicationQuestionType_APPLICATION_QUESTION_TYPE_SHORT_TEXT &&
+				(utf8.RuneCountInString(a.Text) > maxShortTextLength || strings.ContainsAny(a.Text, "\r\n")) {
+				return nil, helpers.InvalidArgument("answers", fmt.Sprintf("the question %q takes a single line of at most %d characters", q.Prompt, maxShortTextLength))
+			}
+		}
+
+		result = append(result, Answer{
+			QuestionID: q.ID,
+			Prompt:     q.Prompt,
+			Text:       a.Text,
+			Choices:    a.Choices,
+		})
+	}
+
+	return result, nil
+}
@@ -0,0 +1,60 @@
+package applications
+
+import (
+	"context"
+	"time"
+
+	applicationsv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/applications/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+func (s *Applications) RejectApplication(ctx context.Context, req *applicationsv1.RejectApplicationRequest) (*applicationsv1.Application, error) {
+	application, err := s.application(ctx, req.UnitId, req.Id)
+	if err != nil {
+		return &applicationsv1.Application{}, err
+	}
+	if err := pending(application); err != nil {
+		return &applicationsv1.Application{}, err
+	}
+
+	now := time.Now()
+	application.Status = int32(applicationsv1.ApplicationStatus_APPLICATION_STATUS_REJECTED)
+	application.RejectionReason = req.Reason
+	application.DecidedBy = caller(ctx)
+	application.DecidedAt = &now
+	if cooldown := req.ReapplyCooldown.AsDuration(); cooldown > 0 {
+		reapplyAfter := now.Add(cooldown)
+		application.ReapplyAfter = &reapplyAfter
+	}
+
+	if err := s.decide(ctx, application); err != nil {
+		return &applicationsv1.Application{}, err
+	}
+
+	return application.Proto(), nil
+}
+
+// decide saves the decision taken on an application, unless another decision
+// was taken on it in the meantime.
+func (s *Applications) decide(ctx context.Context, application ApplicationsApplication) error {
+	rows, err := gorm.G[ApplicationsApplication](s.db.Db).
+		Where("status = ?", int32(applicationsv1.ApplicationStatus_APPLICATION_STATUS_PENDING)).
+		Select("status", "rejection_reason", "reapply_after", "decided_by", "decided_at", "updated_at").
+		Updates(ctx, application)
+	if err != nil {
+		return status.Error(
+			codes.Internal,
+			"failed to update application: "+err.Error(),
+		)
+	}
+	if rows == 0 {
+		return status.Error(
+			codes.Aborted,
+			"the application was decided on concurrently",
+		)
+	}
+
+	return nil
+}
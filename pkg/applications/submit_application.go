@@ -0,0 +1,126 @@
+package applications
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/milsim-tools/pincer/internal/models"
+	applicationsv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/applications/v1"
+	membersv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/members/v1"
+	usersv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/users/v1"
+	"github.com/oklog/ulid/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+func (s *Applications) SubmitApplication(ctx context.Context, req *applicationsv1.SubmitApplicationRequest) (*applicationsv1.Application, error) {
+	form, err := gorm.G[ApplicationsForm](s.db.Db).Where("unit_id = ?", req.UnitId).First(ctx)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return &applicationsv1.Application{}, status.Error(
+			codes.Internal,
+			"failed to query application form: "+err.Error(),
+		)
+	}
+	if errors.Is(err, gorm.ErrRecordNotFound) || !form.Open {
+		return &applicationsv1.Application{}, status.Error(
+			codes.FailedPrecondition,
+			"the unit does not accept applications",
+		)
+	}
+
+	answers, err := answers(form.Questions, req.Answers)
+	if err != nil {
+		return &applicationsv1.Application{}, err
+	}
+
+	if _, err := s.users.GetUser(ctx, &usersv1.GetUserRequest{
+		Value: &usersv1.GetUserRequest_Id{Id: req.UserId},
+	}); err != nil {
+		if status.Code(err) == codes.NotFound {
+			return &applicationsv1.Application{}, status.Error(
+				codes.InvalidArgument,
+				"user_id does not correspond to an existing user",
+			)
+		}
+		return &applicationsv1.Application{}, status.Error(
+			codes.Internal,
+			"failed to call users service: "+err.Error(),
+		)
+	}
+
+	member, err := s.members.GetMember(ctx, &membersv1.GetMemberRequest{
+		UnitId: req.UnitId,
+		UserId: req.UserId,
+	})
+	if err != nil && status.Code(err) != codes.NotFound {
+		return &applicationsv1.Application{}, status.Error(
+			codes.Internal,
+			"failed to call members service: "+err.Error(),
+		)
+	}
+	switch member.GetStatus() {
+	case membersv1.UnitMemberStatus_UNIT_MEMBER_STATUS_APPROVED:
+		return &applicationsv1.Application{}, status.Error(
+			codes.FailedPrecondition,
+			"the user is already a member of the unit",
+		)
+	case membersv1.UnitMemberStatus_UNIT_MEMBER_STATUS_BANNED:
+		return &applicationsv1.Application{}, status.Error(
+			codes.FailedPrecondition,
+			"the user is banned from the unit",
+		)
+	}
+
+	previous, err := gorm.G[ApplicationsApplication](s.db.Db).
+		Where("unit_id = ? AND user_id = ?", req.UnitId, req.UserId).
+		Order("created_at DESC").
+		First(ctx)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return &applicationsv1.Application{}, status.Error(
+			codes.Internal,
+			"failed to query applications: "+err.Error(),
+		)
+	}
+	if err == nil {
+		if applicationsv1.ApplicationStatus(previous.Status) == applicationsv1.ApplicationStatus_APPLICATION_STATUS_PENDING {
+			return &applicationsv1.Application{}, status.Error(
+				codes.AlreadyExists,
+				"the user already has a pending application to the unit",
+			)
+		}
+		if previous.ReapplyAfter != nil && time.Now().Before(*previous.ReapplyAfter) {
+			return &applicationsv1.Application{}, status.Error(
+				codes.FailedPrecondition,
+				"the user may apply again after "+previous.ReapplyAfter.UTC().Format(time.RFC3339),
+			)
+		}
+	}
+
+	application := &ApplicationsApplication{
+		Model: models.Model{
+			ID: ulid.Make().String(),
+		},
+		UnitID:  req.UnitId,
+		UserID:  req.UserId,
+		Status:  int32(applicationsv1.ApplicationStatus_APPLICATION_STATUS_PENDING),
+		Answers: answers,
+	}
+
+	if err := gorm.G[ApplicationsApplication](s.db.Db).Create(ctx, application); err != nil {
+		// The user may have applied since the check above.
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return &applicationsv1.Application{}, status.Error(
+				codes.AlreadyExists,
+				"the user already has a pending application to the unit",
+			)
+		}
+		return &applicationsv1.Application{}, status.Error(
+			codes.Internal,
+			"failed to create application: "+err.Error(),
+		)
+	}
+
+	return application.Proto(), nil
+}
@@ -0,0 +1,86 @@
+package applications
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/milsim-tools/pincer/internal/helpers"
+	applicationsv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/applications/v1"
+	unitsv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/units/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+func (s *Applications) UpdateApplicationForm(ctx context.Context, req *applicationsv1.UpdateApplicationFormRequest) (*applicationsv1.ApplicationForm, error) {
+	form, err := gorm.G[ApplicationsForm](s.db.Db).Where("unit_id = ?", req.Form.UnitId).First(ctx)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return &applicationsv1.ApplicationForm{}, status.Error(
+			codes.Internal,
+			"failed to query application form: "+err.Error(),
+		)
+	}
+
+	created := errors.Is(err, gorm.ErrRecordNotFound)
+	if created {
+		if _, err := s.units.GetUnit(ctx, &unitsv1.GetUnitRequest{
+			Value: &unitsv1.GetUnitRequest_Id{Id: req.Form.UnitId},
+		}); err != nil {
+			if status.Code(err) == codes.NotFound {
+				return &applicationsv1.ApplicationForm{}, status.Error(
+					codes.InvalidArgument,
+					"unit_id does not correspond to an existing unit",
+				)
+			}
+			return &applicationsv1.ApplicationForm{}, status.Error(
+				codes.Internal,
+				"failed to call units service: "+err.Error(),
+			)
+		}
+
+		form = ApplicationsForm{
+			UnitID:    req.Form.UnitId,
+			Questions: []Question{},
+		}
+	}
+
+	// Paths are accepted both relative to the form, as the REST gateway sends
+	// them, and prefixed with `form.`.
+	for _, path := range req.UpdateMask.GetPaths() {
+		switch strings.TrimPrefix(path, "form.") {
+		case "description":
+			form.Description = req.Form.Description
+		case "open":
+			form.Open = req.Form.Open
+		case "questions":
+			form.Questions, err = questions(form.Questions, req.Form.Questions)
+			if err != nil {
+				return &applicationsv1.ApplicationForm{}, err
+			}
+		case "unit_id", "created_at", "updated_at":
+			return &applicationsv1.ApplicationForm{}, helpers.InvalidArgument("update_mask", path+" cannot be updated")
+		default:
+			return &applicationsv1.ApplicationForm{}, helpers.InvalidArgument("update_mask", "unknown field "+path)
+		}
+	}
+
+	if created {
+		err = gorm.G[ApplicationsForm](s.db.Db).Create(ctx, &form)
+	} else {
+		// Selecting the columns also writes fields cleared to their zero
+		// value.
+		_, err = gorm.G[ApplicationsForm](s.db.Db).
+			Where("unit_id = ?", form.UnitID).
+			Select("description", "open", "questions", "updated_at").
+			Updates(ctx, form)
+	}
+	if err != nil {
+		return &applicationsv1.ApplicationForm{}, status.Error(
+			codes.Internal,
+			"failed to save application form: "+err.Error(),
+		)
+	}
+
+	return form.Proto(), nil
+}
@@ -0,0 +1,49 @@
+package applications
+
+import (
+	"context"
+	"time"
+
+	applicationsv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/applications/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func (s *Applications) VoteApplication(ctx context.Context, req *applicationsv1.VoteApplicationRequest) (*applicationsv1.ApplicationVote, error) {
+	reviewerID, err := reviewer(ctx)
+	if err != nil {
+		return &applicationsv1.ApplicationVote{}, err
+	}
+
+	application, err := s.application(ctx, req.UnitId, req.ApplicationId)
+	if err != nil {
+		return &applicationsv1.ApplicationVote{}, err
+	}
+	if err := pending(application); err != nil {
+		return &applicationsv1.ApplicationVote{}, err
+	}
+
+	now := time.Now()
+	vote := &ApplicationsVote{
+		ApplicationID: req.ApplicationId,
+		ReviewerID:    reviewerID,
+		Value:         int32(req.Value),
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+
+	// Voting again replaces the reviewer's vote.
+	if err := gorm.G[ApplicationsVote](s.db.Db, clause.OnConflict{
+		Columns:   []clause.Column{{Name: "application_id"}, {Name: "reviewer_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"value", "updated_at"}),
+	}).Create(ctx, vote); err != nil {
+		return &applicationsv1.ApplicationVote{}, status.Error(
+			codes.Internal,
+			"failed to save vote: "+err.Error(),
+		)
+	}
+
+	return vote.Proto(), nil
+}
@@ -0,0 +1,232 @@
+package authz
+
+import (
+	"context"
+
+	"github.com/urfave/cli/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	FlagUnitsGrpcAddr   = "authz-units-grpc-addr"
+	FlagMembersGrpcAddr = "authz-members-grpc-addr"
+)
+
+var Flags = []cli.Flag{
+	&cli.StringFlag{
+		Name:    FlagUnitsGrpcAddr,
+		Value:   "localhost:9000",
+		Usage:   "The units service used for authorization when the units module runs elsewhere.",
+		EnvVars: []string{"PINCER_AUTHZ_UNITS_GRPC_ADDR"},
+	},
+
+	&cli.StringFlag{
+		Name:    FlagMembersGrpcAddr,
+		Value:   "localhost:9000",
+		Usage:   "The members service used for authorization when the members module runs elsewhere.",
+		EnvVars: []string{"PINCER_AUTHZ_MEMBERS_GRPC_ADDR"},
+	},
+}
+
+type Config struct {
+	UnitsGrpcAddr   string
+	MembersGrpcAddr string
+}
+
+func ConfigFromFlags(ctx *cli.Context) Config {
+	var config Config
+
+	config.UnitsGrpcAddr = ctx.String(FlagUnitsGrpcAddr)
+	config.MembersGrpcAddr = ctx.String(FlagMembersGrpcAddr)
+
+	return config
+}
+
+// Store looks up the unit data authorization decisions are based on.
+type Store interface {
+	// UnitOwner returns the ID of the user who owns the unit. It returns a
+	// NotFound status error if the unit does not exist.
+	UnitOwner(ctx context.Context, unitID string) (string, error)
+
+	// MemberPermissions returns the permissions the user holds in the unit,
+	// and whether the user is a member of the unit at all.
+	MemberPermissions(ctx context.Context, unitID string, userID string) (int32, bool, error)
+
+	// UserUnits returns the IDs of the units the user owns or is a member of.
+	UserUnits(ctx context.Context, userID string) ([]string, error)
+}
+
+// Authorizer decides whether the caller of a request may perform an action.
+type Authorizer struct {
+	store   Store
+	enabled bool
+}
+
+// NewAuthorizer creates an Authorizer. If enabled is false, requests are not
+// authenticated and every check passes.
+func NewAuthorizer(store Store, enabled bool) *Authorizer {
+	return &Authorizer{
+		store:   store,
+		enabled: enabled,
+	}
+}
+
+// Caller returns the identity of the caller, failing if the request is
+// anonymous or the caller has no user.
+func (a *Authorizer) Caller(ctx context.Context) (Identity, error) {
+	identity, ok := FromContext(ctx)
+	if !ok {
+		return Identity{}, status.Error(codes.Unauthenticated, "request is not authenticated")
+	}
+
+	if identity.UserID == "" {
+		return Identity{}, status.Error(codes.PermissionDenied, "caller is not linked to a user")
+	}
+
+	return identity, nil
+}
+
+// IsTrusted reports whether every check passes for the caller, because
+// authentication is disabled or the caller is another module.
+func (a *Authorizer) IsTrusted(ctx context.Context) bool {
+	if !a.enabled {
+		return true
+	}
+
+	identity, ok := FromContext(ctx)
+	return ok && identity.Service
+}
+
+// IsSelf reports whether the caller is the given user.
+func (a *Authorizer) IsSelf(ctx context.Context, userID string) bool {
+	if !a.enabled {
+		return true
+	}
+
+	identity, ok := FromContext(ctx)
+	return ok && identity.UserID != "" && identity.UserID == userID
+}
+
+// RequireSelf ensures that the caller is the given user.
+func (a *Authorizer) RequireSelf(ctx context.Context, userID string) error {
+	if a.IsTrusted(ctx) {
+		return nil
+	}
+
+	identity, err := a.Caller(ctx)
+	if err != nil {
+		return err
+	}
+
+	if identity.UserID != userID {
+		return status.Error(codes.PermissionDenied, "callers may only act on their own user")
+	}
+
+	return nil
+}
+
+// RequireSubject ensures that the caller authenticated as the given token
+// subject, whether or not it is linked to a user yet.
+func (a *Authorizer) RequireSubject(ctx context.Context, subject string) error {
+	if a.IsTrusted(ctx) {
+		return nil
+	}
+
+	identity, ok := FromContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "request is not authenticated")
+	}
+
+	if identity.Claims.Subject != subject {
+		return status.Error(codes.PermissionDenied, "callers may only act on their own identity")
+	}
+
+	return nil
+}
+
+// RequireUnitPermission ensures that the caller holds the permission(s) in the
+// given unit. Unit owners and administrators hold every permission, while
+// banned members other than the owner hold none.
+func (a *Authorizer) RequireUnitPermission(ctx context.Context, unitID string, permission int32) error {
+	if a.IsTrusted(ctx) {
+		return nil
+	}
+
+	identity, err := a.Caller(ctx)
+	if err != nil {
+		return err
+	}
+
+	return a.requireUserPermission(ctx, unitID, identity.UserID, permission)
+}
+
+// HasUnitPermission reports whether the given user, who need not be the
+// caller, holds the permission(s) in the given unit, by the same rules as
+// RequireUnitPermission.
+func (a *Authorizer) HasUnitPermission(ctx context.Context, unitID string, userID string, permission int32) (bool, error) {
+	if !a.enabled {
+		return true, nil
+	}
+
+	err := a.requireUserPermission(ctx, unitID, userID, permission)
+	if status.Code(err) == codes.PermissionDenied {
+		return false, nil
+	}
+
+	return err == nil, err
+}
+
+// UnitsWithPermission returns the units in which the given user holds the
+// permission(s), by the same rules as RequireUnitPermission. Only the units
+// the user owns or is a member of are considered.
+func (a *Authorizer) UnitsWithPermission(ctx context.Context, userID string, permission int32) ([]string, error) {
+	units, err := a.store.UserUnits(ctx, userID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to query units of user: "+err.Error())
+	}
+
+	var permitted []string
+	for _, unitID := range units {
+		can, err := a.HasUnitPermission(ctx, unitID, userID, permission)
+		if status.Code(err) == codes.NotFound {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if can {
+			permitted = append(permitted, unitID)
+		}
+	}
+
+	return permitted, nil
+}
+
+func (a *Authorizer) requireUserPermission(ctx context.Context, unitID string, userID string, permission int32) error {
+	owner, err := a.store.UnitOwner(ctx, unitID)
+	if err != nil {
+		return err
+	}
+
+	// Owners hold every permission even if banned, so they cannot be locked
+	// out of their own unit.
+	if owner == userID {
+		return nil
+	}
+
+	permissions, member, err := a.store.MemberPermissions(ctx, unitID, userID)
+	if err != nil {
+		return status.Error(codes.Internal, "failed to query unit permissions: "+err.Error())
+	}
+
+	if member && Can(permissions, PermissionBanned) {
+		return status.Error(codes.PermissionDenied, "caller is banned from the unit")
+	}
+
+	if Can(permissions, PermissionAdministrator) || Can(permissions, permission) {
+		return nil
+	}
+
+	return status.Error(codes.PermissionDenied, "caller lacks the required unit permission")
+}
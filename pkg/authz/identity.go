@@ -0,0 +1,40 @@
+package authz
+
+import "context"
+
+// Claims are the claims of a verified bearer token that Pincer cares about.
+type Claims struct {
+	Subject           string `json:"sub"`
+	Email             string `json:"email"`
+	Name              string `json:"name"`
+	PreferredUsername string `json:"preferred_username"`
+	Picture           string `json:"picture"`
+}
+
+// Identity is the authenticated caller of a request.
+type Identity struct {
+	// The ID of the Pincer user the token belongs to. This is empty if the
+	// subject has not been linked to a user yet.
+	UserID string
+
+	// The verified claims of the caller's token.
+	Claims Claims
+
+	// Whether the caller is another Pincer module, authenticated with the
+	// service token rather than as a user. Services may perform any action.
+	Service bool
+}
+
+type identityKey struct{}
+
+// NewContext returns a copy of ctx carrying the given identity.
+func NewContext(ctx context.Context, identity Identity) context.Context {
+	return context.WithValue(ctx, identityKey{}, identity)
+}
+
+// FromContext returns the identity of the caller, if the request was
+// authenticated.
+func FromContext(ctx context.Context) (Identity, bool) {
+	identity, ok := ctx.Value(identityKey{}).(Identity)
+	return identity, ok
+}
@@ -0,0 +1,190 @@
+package authz
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	authzv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/authz/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// servicePrefix is the package prefix of the services that must declare an
+// authorization rule for every RPC.
+const servicePrefix = "milsimtools."
+
+// rules caches the methodRule of each method, keyed by full method name.
+var rules sync.Map
+
+type methodRule struct {
+	rule *authzv1.Rule
+
+	// Whether the method belongs to a service that must declare a rule.
+	governed bool
+}
+
+// UnaryServerInterceptor returns an interceptor that enforces the
+// `milsimtools.authz.v1.rule` option declared on each RPC.
+func (a *Authorizer) UnaryServerInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if err := a.authorize(ctx, info.FullMethod, req); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// StreamServerInterceptor returns an interceptor that enforces the
+// `milsimtools.authz.v1.rule` option declared on each streaming RPC. Rules
+// referring to request fields cannot be evaluated for streams, since the
+// request has not been received yet.
+func (a *Authorizer) StreamServerInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := a.authorize(ss.Context(), info.FullMethod, nil); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
+
+func (a *Authorizer) authorize(ctx context.Context, fullMethod string, req any) error {
+	if a.IsTrusted(ctx) {
+		return nil
+	}
+
+	mr, err := ruleForMethod(fullMethod)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	if !mr.governed {
+		return nil
+	}
+
+	rule := mr.rule
+	if rule == nil {
+		return status.Errorf(codes.PermissionDenied, "%s has no authorization rule", fullMethod)
+	}
+
+	var msg protoreflect.Message
+	if m, ok := req.(proto.Message); ok {
+		msg = m.ProtoReflect()
+	}
+
+	field := func(path string) (string, error) {
+		if msg == nil {
+			return "", status.Errorf(codes.Internal, "cannot read %s from a streaming request", path)
+		}
+		return stringField(msg, path)
+	}
+
+	if _, ok := FromContext(ctx); !ok {
+		return status.Error(codes.Unauthenticated, "request is not authenticated")
+	}
+
+	if rule.SubjectField != "" {
+		subject, err := field(rule.SubjectField)
+		if err != nil {
+			return err
+		}
+		if err := a.RequireSubject(ctx, subject); err != nil {
+			return err
+		}
+	}
+
+	if rule.SelfField != "" {
+		userID, err := field(rule.SelfField)
+		if err != nil {
+			return err
+		}
+		if a.IsSelf(ctx, userID) {
+			return nil
+		}
+		if rule.Permission == authzv1.Permission_PERMISSION_UNSPECIFIED {
+			return a.RequireSelf(ctx, userID)
+		}
+	}
+
+	if rule.Permission != authzv1.Permission_PERMISSION_UNSPECIFIED {
+		unitID, err := field(rule.UnitField)
+		if err != nil {
+			return err
+		}
+		if unitID == "" {
+			return status.Error(codes.PermissionDenied, "request does not identify a unit")
+		}
+		return a.RequireUnitPermission(ctx, unitID, int32(rule.Permission))
+	}
+
+	return nil
+}
+
+// ruleForMethod returns the authorization rule declared on the method.
+func ruleForMethod(fullMethod string) (methodRule, error) {
+	if cached, ok := rules.Load(fullMethod); ok {
+		return cached.(methodRule), nil
+	}
+
+	serviceName, methodName, ok := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+	if !ok {
+		return methodRule{}, fmt.Errorf("malformed method name %s", fullMethod)
+	}
+
+	mr := methodRule{
+		governed: strings.HasPrefix(serviceName, servicePrefix),
+	}
+
+	if mr.governed {
+		desc, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(serviceName))
+		if err != nil {
+			return methodRule{}, fmt.Errorf("failed to find service %s: %w", serviceName, err)
+		}
+
+		service, ok := desc.(protoreflect.ServiceDescriptor)
+		if !ok {
+			return methodRule{}, fmt.Errorf("%s is not a service", serviceName)
+		}
+
+		method := service.Methods().ByName(protoreflect.Name(methodName))
+		if method == nil {
+			return methodRule{}, fmt.Errorf("failed to find method %s", fullMethod)
+		}
+
+		if proto.HasExtension(method.Options(), authzv1.E_Rule) {
+			mr.rule = proto.GetExtension(method.Options(), authzv1.E_Rule).(*authzv1.Rule)
+		}
+	}
+
+	rules.Store(fullMethod, mr)
+	return mr, nil
+}
+
+// stringField reads the string field at the dotted path from the message.
+// Unset messages along the path read as an empty string.
+func stringField(msg protoreflect.Message, path string) (string, error) {
+	names := strings.Split(path, ".")
+	for i, name := range names {
+		fd := msg.Descriptor().Fields().ByName(protoreflect.Name(name))
+		if fd == nil {
+			return "", status.Errorf(codes.Internal, "authorization rule refers to unknown field %s", path)
+		}
+
+		if i == len(names)-1 {
+			if fd.Kind() != protoreflect.StringKind || fd.IsList() {
+				return "", status.Errorf(codes.Internal, "authorization rule field %s is not a string", path)
+			}
+			return msg.Get(fd).String(), nil
+		}
+
+		if fd.Message() == nil || fd.IsList() || fd.IsMap() {
+			return "", status.Errorf(codes.Internal, "authorization rule field %s is not a message", path)
+		}
+		if !msg.Has(fd) {
+			return "", nil
+		}
+		msg = msg.Get(fd).Message()
+	}
+
+	return "", nil
+}
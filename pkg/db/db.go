@@ -1,68 +1,621 @@
 package db
 
 import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
 	"log/slog"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
 
+	"github.com/grafana/dskit/backoff"
 	"github.com/grafana/dskit/services"
+	"github.com/jackc/pgx/v5"
+	"github.com/milsim-tools/pincer/pkg/db/migrate"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
 	"github.com/urfave/cli/v2"
-	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
+	"gorm.io/plugin/dbresolver"
 )
 
 const (
-	FlagsDSN = "db-dsn"
+	FlagsDSN                = "db-dsn"
+	FlagSchema              = "db-schema"
+	FlagReplicaDSN          = "db-replica-dsn"
+	FlagModuleDSN           = "db-module-dsn"
+	FlagModuleSchema        = "db-module-schema"
+	FlagModuleReplicaDSN    = "db-module-replica-dsn"
+	FlagAutoMigrate         = "db-auto-migrate"
+	FlagMaxOpenConns        = "db-max-open-conns"
+	FlagMaxIdleConns        = "db-max-idle-conns"
+	FlagConnMaxLifetime     = "db-conn-max-lifetime"
+	FlagConnMaxIdleTime     = "db-conn-max-idle-time"
+	FlagConnectTimeout      = "db-connect-timeout"
+	FlagHealthCheckInterval = "db-health-check-interval"
+	FlagSlowQueryThreshold  = "db-slow-query-threshold"
+	FlagRedactColumns       = "db-log-redact-columns"
 )
 
 var Flags = []cli.Flag{
 	&cli.StringFlag{
-		Name:     FlagsDSN,
-		Required: true,
-		Usage:    "Database data source name",
-		EnvVars:  []string{"PINCER_DB_DSN"},
+		Name:    FlagsDSN,
+		Usage:   "Database data source name, used by every module without a DSN of its own. Either a Postgres DSN, or sqlite://<path> for a SQLite database",
+		EnvVars: []string{"PINCER_DB_DSN"},
+	},
+
+	&cli.StringFlag{
+		Name:    FlagSchema,
+		Usage:   "The Postgres schema modules without a schema of their own keep their tables in. Defaults to the DSN's search path",
+		EnvVars: []string{"PINCER_DB_SCHEMA"},
+	},
+
+	&cli.StringSliceFlag{
+		Name:    FlagReplicaDSN,
+		Usage:   "The data source name of a read replica of the default database, which list, search and report queries are balanced over. May be repeated",
+		EnvVars: []string{"PINCER_DB_REPLICA_DSN"},
+	},
+
+	&cli.StringSliceFlag{
+		Name:    FlagModuleDSN,
+		Usage:   "The data source name of a module's database, as `module=dsn`. May be repeated",
+		EnvVars: []string{"PINCER_DB_MODULE_DSN"},
+		Action:  validateModuleValues(FlagModuleDSN),
+	},
+
+	&cli.StringSliceFlag{
+		Name:    FlagModuleSchema,
+		Usage:   "The Postgres schema a module keeps its tables in, as `module=schema`. May be repeated",
+		EnvVars: []string{"PINCER_DB_MODULE_SCHEMA"},
+		Action:  validateModuleValues(FlagModuleSchema),
+	},
+
+	&cli.StringSliceFlag{
+		Name:    FlagModuleReplicaDSN,
+		Usage:   "The data source name of a read replica of a module's database, as `module=dsn`. May be repeated",
+		EnvVars: []string{"PINCER_DB_MODULE_REPLICA_DSN"},
+		Action:  validateModuleValues(FlagModuleReplicaDSN),
+	},
+
+	&cli.BoolFlag{
+		Name:    FlagAutoMigrate,
+		Value:   true,
+		Usage:   "Apply pending migrations of each module as it starts. Disable to run `pincer migrate up` separately",
+		EnvVars: []string{"PINCER_DB_AUTO_MIGRATE"},
+	},
+
+	&cli.IntFlag{
+		Name:    FlagMaxOpenConns,
+		Value:   25,
+		Usage:   "The maximum number of open connections of each connection pool, 0 for no limit",
+		EnvVars: []string{"PINCER_DB_MAX_OPEN_CONNS"},
+	},
+
+	&cli.IntFlag{
+		Name:    FlagMaxIdleConns,
+		Value:   5,
+		Usage:   "The maximum number of idle connections kept by each connection pool",
+		EnvVars: []string{"PINCER_DB_MAX_IDLE_CONNS"},
+	},
+
+	&cli.DurationFlag{
+		Name:    FlagConnMaxLifetime,
+		Value:   30 * time.Minute,
+		Usage:   "How long a connection may be reused before it is closed, 0 to reuse connections forever",
+		EnvVars: []string{"PINCER_DB_CONN_MAX_LIFETIME"},
+	},
+
+	&cli.DurationFlag{
+		Name:    FlagConnMaxIdleTime,
+		Value:   5 * time.Minute,
+		Usage:   "How long a connection may be idle before it is closed, 0 to keep idle connections forever",
+		EnvVars: []string{"PINCER_DB_CONN_MAX_IDLE_TIME"},
+	},
+
+	&cli.DurationFlag{
+		Name:    FlagConnectTimeout,
+		Value:   time.Minute,
+		Usage:   "How long to keep retrying the database on startup before giving up, 0 to retry forever",
+		EnvVars: []string{"PINCER_DB_CONNECT_TIMEOUT"},
+	},
+
+	&cli.DurationFlag{
+		Name:    FlagHealthCheckInterval,
+		Value:   10 * time.Second,
+		Usage:   "How often the database is pinged to determine readiness, 0 to disable",
+		EnvVars: []string{"PINCER_DB_HEALTH_CHECK_INTERVAL"},
+	},
+
+	&cli.DurationFlag{
+		Name:    FlagSlowQueryThreshold,
+		Value:   200 * time.Millisecond,
+		Usage:   "Queries taking at least this long are logged at warn, 0 to disable. Other queries are logged at debug",
+		EnvVars: []string{"PINCER_DB_SLOW_QUERY_THRESHOLD"},
+	},
+
+	&cli.StringSliceFlag{
+		Name:    FlagRedactColumns,
+		Value:   cli.NewStringSlice("email", "password", "token", "secret"),
+		Usage:   "Parameters of columns whose name contains any of these are redacted from logged queries",
+		EnvVars: []string{"PINCER_DB_LOG_REDACT_COLUMNS"},
 	},
 }
 
+func validateModuleValues(flag string) func(*cli.Context, []string) error {
+	return func(_ *cli.Context, values []string) error {
+		for _, value := range values {
+			if module, v, ok := strings.Cut(value, "="); !ok || module == "" || v == "" {
+				return fmt.Errorf("%s must be given as module=value, got %q", flag, value)
+			}
+		}
+		return nil
+	}
+}
+
+func parseModuleValues(values []string) map[string]string {
+	result := make(map[string]string, len(values))
+	for _, value := range values {
+		if module, v, ok := strings.Cut(value, "="); ok {
+			result[module] = v
+		}
+	}
+	return result
+}
+
+func parseModuleLists(values []string) map[string][]string {
+	result := make(map[string][]string, len(values))
+	for _, value := range values {
+		if module, v, ok := strings.Cut(value, "="); ok {
+			result[module] = append(result[module], v)
+		}
+	}
+	return result
+}
+
 type Config struct {
-	DSN string
+	DSN         string
+	Schema      string
+	ReplicaDSNs []string
+
+	// ModuleDSNs, ModuleSchemas and ModuleReplicaDSNs override DSN, Schema
+	// and ReplicaDSNs for the modules they contain.
+	ModuleDSNs        map[string]string
+	ModuleSchemas     map[string]string
+	ModuleReplicaDSNs map[string][]string
+
+	AutoMigrate bool
+
+	MaxOpenConns    int
+	MaxIdleConns    int
+	ConnMaxLifetime time.Duration
+	ConnMaxIdleTime time.Duration
+
+	ConnectTimeout      time.Duration
+	HealthCheckInterval time.Duration
+
+	SlowQueryThreshold time.Duration
+	RedactColumns      []string
 }
 
 func ConfigFromFlags(ctx *cli.Context) Config {
 	var config Config
 	config.DSN = ctx.String(FlagsDSN)
+	config.Schema = ctx.String(FlagSchema)
+	config.ReplicaDSNs = ctx.StringSlice(FlagReplicaDSN)
+	config.ModuleDSNs = parseModuleValues(ctx.StringSlice(FlagModuleDSN))
+	config.ModuleSchemas = parseModuleValues(ctx.StringSlice(FlagModuleSchema))
+	config.ModuleReplicaDSNs = parseModuleLists(ctx.StringSlice(FlagModuleReplicaDSN))
+	config.AutoMigrate = ctx.Bool(FlagAutoMigrate)
+	config.MaxOpenConns = ctx.Int(FlagMaxOpenConns)
+	config.MaxIdleConns = ctx.Int(FlagMaxIdleConns)
+	config.ConnMaxLifetime = ctx.Duration(FlagConnMaxLifetime)
+	config.ConnMaxIdleTime = ctx.Duration(FlagConnMaxIdleTime)
+	config.ConnectTimeout = ctx.Duration(FlagConnectTimeout)
+	config.HealthCheckInterval = ctx.Duration(FlagHealthCheckInterval)
+	config.SlowQueryThreshold = ctx.Duration(FlagSlowQueryThreshold)
+	config.RedactColumns = ctx.StringSlice(FlagRedactColumns)
 	return config
 }
 
+// poolConfig is the database a pool connects to.
+type poolConfig struct {
+	dsn      string
+	schema   string
+	replicas []string
+}
+
+// moduleConfig returns the database the given module uses. Replicas of the
+// default database are not used by modules with a DSN of their own.
+func (c Config) moduleConfig(module string) poolConfig {
+	pc := poolConfig{dsn: c.DSN, schema: c.Schema, replicas: c.ReplicaDSNs}
+	if v, ok := c.ModuleDSNs[module]; ok {
+		pc.dsn = v
+		pc.replicas = nil
+	}
+	if v, ok := c.ModuleSchemas[module]; ok {
+		pc.schema = v
+	}
+	if v, ok := c.ModuleReplicaDSNs[module]; ok {
+		pc.replicas = v
+	}
+	return pc
+}
+
 type Db struct {
 	services.Service
 
 	cfg    Config
 	logger *slog.Logger
 
+	// Db is the database of the module this handle was created for, or the
+	// default database for the root handle. Every query runs against the
+	// primary, so reads see the writes made before them.
 	Db *gorm.DB
+
+	// Replica is Db with queries outside of transactions that only read sent
+	// to a replica, if there are any. It is for queries that can tolerate
+	// replication lag, such as listing and searching, and never for those
+	// authorization decisions are based on.
+	Replica *gorm.DB
+
+	pools *pools
+}
+
+// pools are the connection pools shared by every handle, one for each
+// distinct DSN and schema.
+type pools struct {
+	mu  sync.Mutex
+	dbs map[poolKey]*pool
+
+	cfg     Config
+	logger  *slog.Logger
+	reg     prometheus.Registerer
+	metrics *metricsPlugin
+	tracing *tracingPlugin
+
+	healthy atomic.Bool
+}
+
+type poolKey struct {
+	dsn    string
+	schema string
+}
+
+// pool is the connection pool of a database, and of its replicas.
+type pool struct {
+	primary *gorm.DB
+	replica *gorm.DB
+
+	schema   string
+	sqlDB    *sql.DB
+	replicas []*sql.DB
 }
 
 func New(
 	logger *slog.Logger,
 	cfg Config,
+	reg prometheus.Registerer,
 ) (*Db, error) {
-	db, err := gorm.Open(postgres.Open(cfg.DSN), &gorm.Config{})
+	u := &Db{
+		cfg:    cfg,
+		logger: logger,
+		pools: &pools{
+			dbs:     map[poolKey]*pool{},
+			cfg:     cfg,
+			logger:  logger,
+			reg:     reg,
+			metrics: newMetricsPlugin(reg),
+			tracing: newTracingPlugin(),
+		},
+	}
+
+	// Modules may all have databases of their own, in which case no default
+	// database is needed.
+	if cfg.DSN != "" {
+		p, err := u.pools.get(poolConfig{dsn: cfg.DSN, schema: cfg.Schema, replicas: cfg.ReplicaDSNs}, "pincer")
+		if err != nil {
+			return nil, err
+		}
+		u.Db, u.Replica = p.primary, p.replica
+	}
+
+	u.Service = services.NewBasicService(u.starting, u.running, u.stopping)
+
+	return u, nil
+}
+
+// Module returns a handle to the database of the given module, which may
+// differ from the default database if the module has a DSN or schema of its
+// own. Queries made through the handle are logged to the module's logger.
+func (d *Db) Module(module string, logger *slog.Logger) (*Db, error) {
+	pc := d.cfg.moduleConfig(module)
+	if pc.dsn == "" {
+		return nil, fmt.Errorf("no database configured for the %s module, set --%s or --%s", module, FlagsDSN, FlagModuleDSN)
+	}
+
+	p, err := d.pools.get(pc, "pincer_"+module)
 	if err != nil {
 		return nil, err
 	}
 
-	// TODO: Query logging
+	session := &gorm.Session{Logger: newQueryLogger(logger, d.cfg)}
 
-	u := &Db{
-		cfg:    cfg,
-		logger: logger,
-		Db:     db,
+	return &Db{
+		Service: d.Service,
+		cfg:     d.cfg,
+		logger:  logger,
+		Db:      p.primary.Session(session),
+		Replica: p.replica.Session(session),
+		pools:   d.pools,
+	}, nil
+}
+
+// Healthy reports whether every database answered the latest health check.
+func (d *Db) Healthy() bool {
+	return d.pools.healthy.Load()
+}
+
+// Connect waits until every database accepts connections, retrying with
+// backoff until the context is done.
+func (d *Db) Connect(ctx context.Context) error {
+	retries := backoff.New(ctx, backoff.Config{
+		MinBackoff: 500 * time.Millisecond,
+		MaxBackoff: 10 * time.Second,
+	})
+
+	var err error
+	for retries.Ongoing() {
+		if err = d.pools.connect(ctx); err == nil {
+			d.pools.healthy.Store(true)
+			return nil
+		}
+
+		d.logger.Warn("failed to connect to database, retrying", "error", err, "attempt", retries.NumRetries()+1)
+		retries.Wait()
 	}
 
-	u.Service = services.NewIdleService(nil, u.stopping)
+	return fmt.Errorf("failed to connect to database after %d attempts: %w", retries.NumRetries(), err)
+}
 
-	return u, nil
+// get returns the pool for the DSN and schema, creating it on first use. No
+// connections are made until the pool is connected. The name labels the
+// pool's metrics.
+func (p *pools) get(pc poolConfig, name string) (*pool, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	key := poolKey{dsn: pc.dsn, schema: pc.schema}
+	if existing, ok := p.dbs[key]; ok {
+		return existing, nil
+	}
+
+	drv := driverFor(pc.dsn)
+	if len(pc.replicas) > 0 && !drv.replicas() {
+		return nil, errors.New("read replicas are not supported by SQLite databases")
+	}
+
+	sqlDB, err := p.open(drv, pc.dsn, pc.schema, name)
+	if err != nil {
+		return nil, err
+	}
+
+	primary, err := p.gorm(drv, sqlDB)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &pool{
+		primary: primary,
+		replica: primary,
+		schema:  pc.schema,
+		sqlDB:   sqlDB,
+	}
+
+	if len(pc.replicas) > 0 {
+		dialectors := make([]gorm.Dialector, 0, len(pc.replicas))
+		for i, dsn := range pc.replicas {
+			replica, err := p.open(drv, dsn, pc.schema, fmt.Sprintf("%s_replica_%d", name, i))
+			if err != nil {
+				return nil, err
+			}
+			result.replicas = append(result.replicas, replica)
+			dialectors = append(dialectors, drv.dialector(replica))
+		}
+
+		// Replica routing gets a GORM instance of its own, which queries opt
+		// into, leaving the primary one for everything else.
+		if result.replica, err = p.gorm(drv, sqlDB); err != nil {
+			return nil, err
+		}
+		if err := result.replica.Use(dbresolver.Register(dbresolver.Config{
+			Replicas: dialectors,
+			Policy:   dbresolver.RandomPolicy{},
+		})); err != nil {
+			return nil, err
+		}
+	}
+
+	p.dbs[key] = result
+
+	return result, nil
 }
 
-func (d *Db) stopping(_ error) error {
+// open creates a connection pool for the DSN using the schema, and registers
+// its metrics.
+func (p *pools) open(drv driver, dsn string, schema string, name string) (*sql.DB, error) {
+	sqlDB, err := drv.open(dsn, schema, p.cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.reg.Register(collectors.NewDBStatsCollector(sqlDB, name)); err != nil {
+		return nil, err
+	}
+
+	return sqlDB, nil
+}
+
+func (p *pools) gorm(drv driver, sqlDB *sql.DB) (*gorm.DB, error) {
+	db, err := gorm.Open(drv.dialector(sqlDB), &gorm.Config{
+		Logger:               newQueryLogger(p.logger, p.cfg),
+		DisableAutomaticPing: true,
+		// Constraint violations are returned as gorm.ErrDuplicatedKey and
+		// friends, whatever the database.
+		TranslateError: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := db.Use(p.metrics); err != nil {
+		return nil, err
+	}
+	if err := db.Use(p.tracing); err != nil {
+		return nil, err
+	}
+
+	return db, nil
+}
+
+// connect pings every pool and its replicas, and creates the schemas of the
+// pools that have one.
+func (p *pools) connect(ctx context.Context) error {
+	if err := p.ping(ctx); err != nil {
+		return err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, pool := range p.dbs {
+		if pool.schema == "" {
+			continue
+		}
+		if err := pool.primary.WithContext(ctx).Exec("CREATE SCHEMA IF NOT EXISTS " + pgx.Identifier{pool.schema}.Sanitize()).Error; err != nil {
+			return fmt.Errorf("failed to create schema %s: %w", pool.schema, err)
+		}
+	}
+
 	return nil
 }
+
+// ping checks that every pool and its replicas accept connections.
+func (p *pools) ping(ctx context.Context) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var errs []error
+	for _, pool := range p.dbs {
+		for _, sqlDB := range append([]*sql.DB{pool.sqlDB}, pool.replicas...) {
+			if err := sqlDB.PingContext(ctx); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (p *pools) close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var errs []error
+	for key, pool := range p.dbs {
+		for _, sqlDB := range append([]*sql.DB{pool.sqlDB}, pool.replicas...) {
+			errs = append(errs, sqlDB.Close())
+		}
+		delete(p.dbs, key)
+	}
+
+	return errors.Join(errs...)
+}
+
+// Migrate applies the pending migrations of a module if auto-migration is
+// enabled, and otherwise warns if the module's schema is out of date.
+func (d *Db) Migrate(ctx context.Context, source migrate.Source) error {
+	migrator := d.Migrator()
+
+	if !d.cfg.AutoMigrate {
+		statuses, err := migrator.Status(ctx, source)
+		if err != nil {
+			return err
+		}
+
+		pending := 0
+		for _, status := range statuses {
+			if !status.Applied {
+				pending++
+			}
+		}
+		if pending > 0 {
+			d.logger.Warn("database schema is out of date, run `pincer migrate up`", "pending", pending)
+		}
+
+		return nil
+	}
+
+	_, err := migrator.Up(ctx, source)
+	return err
+}
+
+// Migrator returns a migrator for the primary of this handle's database.
+func (d *Db) Migrator() *migrate.Migrator {
+	return migrate.New(d.Db, d.logger)
+}
+
+func (d *Db) starting(ctx context.Context) error {
+	if d.cfg.ConnectTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, d.cfg.ConnectTimeout)
+		defer cancel()
+	}
+
+	return d.Connect(ctx)
+}
+
+// running pings the databases periodically, marking them unhealthy while any
+// of them cannot be reached.
+func (d *Db) running(ctx context.Context) error {
+	if d.cfg.HealthCheckInterval <= 0 {
+		<-ctx.Done()
+		return nil
+	}
+
+	ticker := time.NewTicker(d.cfg.HealthCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			d.checkHealth(ctx)
+		}
+	}
+}
+
+func (d *Db) checkHealth(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, d.cfg.HealthCheckInterval)
+	defer cancel()
+
+	err := d.pools.ping(ctx)
+	if err != nil {
+		if d.pools.healthy.Swap(false) {
+			d.logger.Error("database is unreachable", "error", err)
+		}
+		return
+	}
+
+	if !d.pools.healthy.Swap(true) {
+		d.logger.Info("database is reachable again")
+	}
+}
+
+func (d *Db) stopping(_ error) error {
+	d.pools.healthy.Store(false)
+	return d.pools.close()
+}
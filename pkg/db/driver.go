@@ -0,0 +1,128 @@
+package db
+
+import (
+	"database/sql"
+	"errors"
+	"net/url"
+	"strings"
+
+	"github.com/glebarez/sqlite"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/stdlib"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// SQLiteScheme prefixes the DSNs of SQLite databases, followed by the path of
+// the database file, or `:memory:` for a database that lives as long as the
+// process. Any other DSN is a Postgres DSN.
+const SQLiteScheme = "sqlite://"
+
+// driver opens the databases of one kind of DSN.
+type driver interface {
+	// open creates a connection pool for the DSN, using the schema if it is
+	// not empty.
+	open(dsn string, schema string, cfg Config) (*sql.DB, error)
+
+	// dialector returns the GORM dialector for a pool created by open.
+	dialector(conn *sql.DB) gorm.Dialector
+
+	// replicas reports whether the database can have read replicas.
+	replicas() bool
+}
+
+// driverFor returns the driver for the DSN, based on its scheme.
+func driverFor(dsn string) driver {
+	if strings.HasPrefix(dsn, SQLiteScheme) {
+		return sqliteDriver{}
+	}
+	return postgresDriver{}
+}
+
+// configurePool applies the configured pool settings.
+func configurePool(sqlDB *sql.DB, cfg Config) {
+	sqlDB.SetMaxOpenConns(cfg.MaxOpenConns)
+	sqlDB.SetMaxIdleConns(cfg.MaxIdleConns)
+	sqlDB.SetConnMaxLifetime(cfg.ConnMaxLifetime)
+	sqlDB.SetConnMaxIdleTime(cfg.ConnMaxIdleTime)
+}
+
+type postgresDriver struct{}
+
+func (postgresDriver) open(dsn string, schema string, cfg Config) (*sql.DB, error) {
+	connConfig, err := pgx.ParseConfig(dsn)
+	if err != nil {
+		return nil, err
+	}
+	if schema != "" {
+		connConfig.RuntimeParams["search_path"] = schema
+	}
+
+	sqlDB := stdlib.OpenDB(*connConfig)
+	configurePool(sqlDB, cfg)
+
+	return sqlDB, nil
+}
+
+func (postgresDriver) dialector(conn *sql.DB) gorm.Dialector {
+	return postgres.New(postgres.Config{Conn: conn})
+}
+
+func (postgresDriver) replicas() bool {
+	return true
+}
+
+// sqliteDriver opens SQLite databases with a pure-Go driver, so that no cgo
+// is needed.
+type sqliteDriver struct{}
+
+func (sqliteDriver) open(dsn string, schema string, cfg Config) (*sql.DB, error) {
+	if schema != "" {
+		return nil, errors.New("schemas are not supported by SQLite databases")
+	}
+
+	path, query, _ := strings.Cut(strings.TrimPrefix(dsn, SQLiteScheme), "?")
+	if path == "" {
+		return nil, errors.New("the SQLite DSN has no database path, use " + SQLiteScheme + "<path> or " + SQLiteScheme + ":memory:")
+	}
+
+	params, err := url.ParseQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	memory := path == ":memory:"
+
+	// Foreign keys are off by default in SQLite, and concurrent writers fail
+	// immediately rather than waiting for the lock unless told otherwise.
+	params.Add("_pragma", "foreign_keys(1)")
+	params.Add("_pragma", "busy_timeout(5000)")
+	if !memory {
+		params.Add("_pragma", "journal_mode(WAL)")
+	}
+
+	sqlDB, err := sql.Open("sqlite", path+"?"+params.Encode())
+	if err != nil {
+		return nil, err
+	}
+	configurePool(sqlDB, cfg)
+
+	// Every connection to :memory: opens a database of its own, so a single
+	// connection is kept open for the lifetime of the pool.
+	if memory {
+		sqlDB.SetMaxOpenConns(1)
+		sqlDB.SetMaxIdleConns(1)
+		sqlDB.SetConnMaxLifetime(0)
+		sqlDB.SetConnMaxIdleTime(0)
+	}
+
+	return sqlDB, nil
+}
+
+func (sqliteDriver) dialector(conn *sql.DB) gorm.Dialector {
+	return &sqlite.Dialector{Conn: conn}
+}
+
+func (sqliteDriver) replicas() bool {
+	return false
+}
@@ -0,0 +1,190 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+	gormlogger "gorm.io/gorm/logger"
+)
+
+const (
+	// redacted replaces the parameters of sensitive columns in logged queries.
+	redacted = "[REDACTED]"
+
+	// maxComparisonLength bounds how far before a parameter the column it is
+	// compared with is looked for.
+	maxComparisonLength = 256
+)
+
+var (
+	// placeholder matches the bind parameters of a query, numbered or not.
+	placeholder = regexp.MustCompile(`\$\d+|\?`)
+
+	// comparedColumn matches the column a parameter is compared with or
+	// assigned to, right before the parameter, looking through a function
+	// call on either side such as lower(email) = lower(?).
+	comparedColumn = regexp.MustCompile(`(?i)["\x60]?(\w+)["\x60]?\s*\)?\s*(?:=|<>|!=|<=|>=|<|>|\bLIKE|\bILIKE|\bIN\s*\((?:[^()]*,)?)\s*(?:\w+\s*\()?\s*$`)
+
+	// insertColumns matches the column list of an insert, and the start of its
+	// values.
+	insertColumns = regexp.MustCompile(`(?is)^\s*INSERT\s+INTO\s+\S+\s*\(([^)]*)\)\s*VALUES\s*`)
+)
+
+// queryLogger is a GORM logger writing every query to a slog.Logger at debug,
+// slow queries at warn and failed queries at error.
+//
+// Queries are logged with the context they were made with, so the request ID
+// and trace ID of the request they were made for are attached to each line by
+// the logger's handler.
+type queryLogger struct {
+	logger        *slog.Logger
+	slowThreshold time.Duration
+
+	// redactColumns are lowercased substrings of the names of the columns
+	// whose parameters are redacted.
+	redactColumns []string
+}
+
+var (
+	_ gormlogger.Interface = (*queryLogger)(nil)
+	_ gorm.ParamsFilter    = (*queryLogger)(nil)
+)
+
+func newQueryLogger(logger *slog.Logger, cfg Config) *queryLogger {
+	redactColumns := make([]string, 0, len(cfg.RedactColumns))
+	for _, column := range cfg.RedactColumns {
+		if column = strings.ToLower(strings.TrimSpace(column)); column != "" {
+			redactColumns = append(redactColumns, column)
+		}
+	}
+
+	return &queryLogger{
+		logger:        logger,
+		slowThreshold: cfg.SlowQueryThreshold,
+		redactColumns: redactColumns,
+	}
+}
+
+// LogMode is a no-op, as the level is determined by the slog handler.
+func (l *queryLogger) LogMode(gormlogger.LogLevel) gormlogger.Interface {
+	return l
+}
+
+func (l *queryLogger) Info(ctx context.Context, msg string, args ...any) {
+	l.logger.InfoContext(ctx, fmt.Sprintf(msg, args...))
+}
+
+func (l *queryLogger) Warn(ctx context.Context, msg string, args ...any) {
+	l.logger.WarnContext(ctx, fmt.Sprintf(msg, args...))
+}
+
+func (l *queryLogger) Error(ctx context.Context, msg string, args ...any) {
+	l.logger.ErrorContext(ctx, fmt.Sprintf(msg, args...))
+}
+
+func (l *queryLogger) Trace(ctx context.Context, begin time.Time, fc func() (string, int64), err error) {
+	duration := time.Since(begin)
+
+	level, msg := slog.LevelDebug, "query"
+	switch {
+	case err != nil && !errors.Is(err, gorm.ErrRecordNotFound):
+		level, msg = slog.LevelError, "query failed"
+	case l.slowThreshold > 0 && duration >= l.slowThreshold:
+		level, msg = slog.LevelWarn, "slow query"
+	}
+
+	// Rendering the query is not free, so skip it if it won't be logged.
+	if !l.logger.Enabled(ctx, level) {
+		return
+	}
+
+	sql, rows := fc()
+	args := []any{"sql", sql, "duration", duration, "rows", rows}
+	if err != nil {
+		args = append(args, "err", err)
+	}
+
+	l.logger.Log(ctx, level, msg, args...)
+}
+
+// ParamsFilter redacts the parameters of sensitive columns before the query
+// is rendered for logging.
+func (l *queryLogger) ParamsFilter(_ context.Context, sql string, params ...any) (string, []any) {
+	if len(l.redactColumns) == 0 || len(params) == 0 {
+		return sql, params
+	}
+
+	filtered := make([]any, len(params))
+	copy(filtered, params)
+
+	for i, column := range parameterColumns(sql, len(params)) {
+		if column != "" && l.redact(column) {
+			filtered[i] = redacted
+		}
+	}
+
+	return sql, filtered
+}
+
+func (l *queryLogger) redact(column string) bool {
+	column = strings.ToLower(column)
+	for _, sensitive := range l.redactColumns {
+		if strings.Contains(column, sensitive) {
+			return true
+		}
+	}
+	return false
+}
+
+// parameterColumns returns the column each parameter of the query is bound
+// to, as far as it can be told from the query. Parameters of inserts map to
+// the column they are compared with or assigned to, or failing that to the
+// inserted column at their position.
+func parameterColumns(sql string, count int) []string {
+	columns := make([]string, count)
+
+	var inserted []string
+	valuesStart := -1
+	if match := insertColumns.FindStringSubmatchIndex(sql); match != nil {
+		for _, column := range strings.Split(sql[match[2]:match[3]], ",") {
+			inserted = append(inserted, strings.Trim(strings.TrimSpace(column), "\"`"))
+		}
+		valuesStart = match[1]
+	}
+
+	for n, loc := range placeholder.FindAllStringIndex(sql, -1) {
+		index := n
+		if sql[loc[0]] == '$' {
+			parsed, err := strconv.Atoi(sql[loc[0]+1 : loc[1]])
+			if err != nil {
+				continue
+			}
+			index = parsed - 1
+		}
+		if index < 0 || index >= count {
+			continue
+		}
+
+		if match := comparedColumn.FindStringSubmatch(sql[max(0, loc[0]-maxComparisonLength):loc[0]]); match != nil {
+			columns[index] = match[1]
+		} else if valuesStart >= 0 && loc[0] >= valuesStart && len(inserted) > 0 {
+			columns[index] = inserted[insertPosition(sql[valuesStart:loc[0]])%len(inserted)]
+		}
+	}
+
+	return columns
+}
+
+// insertPosition returns the position within its row of the value that
+// follows the given part of an insert's values.
+func insertPosition(values string) int {
+	row := values[strings.LastIndex(values, "(")+1:]
+	return strings.Count(row, ",")
+}
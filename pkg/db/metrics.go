@@ -0,0 +1,77 @@
+package db
+
+import (
+	"errors"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"gorm.io/gorm"
+)
+
+const queryStartKey = "pincer:query_start"
+
+// metricsPlugin is a GORM plugin recording the duration of every query.
+type metricsPlugin struct {
+	duration *prometheus.HistogramVec
+}
+
+func newMetricsPlugin(reg prometheus.Registerer) *metricsPlugin {
+	duration := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "pincer_db_query_duration_seconds",
+		Help:    "Time spent executing database queries.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"operation", "table", "status"})
+	reg.MustRegister(duration)
+
+	return &metricsPlugin{
+		duration: duration,
+	}
+}
+
+func (p *metricsPlugin) Name() string {
+	return "pincer:metrics"
+}
+
+func (p *metricsPlugin) Initialize(db *gorm.DB) error {
+	cb := db.Callback()
+	return errors.Join(
+		cb.Create().Before("gorm:create").Register("pincer:metrics_before_create", p.before),
+		cb.Create().After("gorm:create").Register("pincer:metrics_after_create", p.after("create")),
+		cb.Query().Before("gorm:query").Register("pincer:metrics_before_query", p.before),
+		cb.Query().After("gorm:query").Register("pincer:metrics_after_query", p.after("query")),
+		cb.Update().Before("gorm:update").Register("pincer:metrics_before_update", p.before),
+		cb.Update().After("gorm:update").Register("pincer:metrics_after_update", p.after("update")),
+		cb.Delete().Before("gorm:delete").Register("pincer:metrics_before_delete", p.before),
+		cb.Delete().After("gorm:delete").Register("pincer:metrics_after_delete", p.after("delete")),
+		cb.Row().Before("gorm:row").Register("pincer:metrics_before_row", p.before),
+		cb.Row().After("gorm:row").Register("pincer:metrics_after_row", p.after("row")),
+		cb.Raw().Before("gorm:raw").Register("pincer:metrics_before_raw", p.before),
+		cb.Raw().After("gorm:raw").Register("pincer:metrics_after_raw", p.after("raw")),
+	)
+}
+
+func (p *metricsPlugin) before(db *gorm.DB) {
+	db.InstanceSet(queryStartKey, time.Now())
+}
+
+func (p *metricsPlugin) after(operation string) func(*gorm.DB) {
+	return func(db *gorm.DB) {
+		value, ok := db.InstanceGet(queryStartKey)
+		if !ok {
+			return
+		}
+		start, ok := value.(time.Time)
+		if !ok {
+			return
+		}
+
+		status := "ok"
+		if db.Error != nil && !errors.Is(db.Error, gorm.ErrRecordNotFound) {
+			status = "error"
+		}
+
+		p.duration.
+			WithLabelValues(operation, db.Statement.Table, status).
+			Observe(time.Since(start).Seconds())
+	}
+}
@@ -0,0 +1,368 @@
+// Package migrate applies the versioned SQL migrations that each module embeds
+// for its tables.
+//
+// Migrations are pairs of files named `<version>_<name>.up.sql` and
+// `<version>_<name>.down.sql`, holding SQL that runs on every supported
+// database. Where a database needs different statements, a file named
+// `<version>_<name>.<dialect>.up.sql` (or `.down.sql`) takes precedence for
+// that database, where dialect is "postgres" or "sqlite".
+//
+// The versions applied for each module are recorded in a table. On Postgres,
+// migrations run under an advisory lock so that replicas starting at the same
+// time do not race each other.
+package migrate
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// TableName is the table applied migrations are recorded in.
+const TableName = "pincer_schema_migrations"
+
+// lockID is the key of the Postgres advisory lock held while migrating. SQLite
+// databases are not shared between processes, so no lock is taken there.
+const lockID = 7_161_202_503
+
+var (
+	fileName      = regexp.MustCompile(`^(\d+)_([a-z0-9_]+?)(?:\.(postgres|sqlite))?\.(up|down)\.sql$`)
+	migrationName = regexp.MustCompile(`^[a-z0-9_]+$`)
+)
+
+// Source is the set of migrations of a module.
+type Source struct {
+	// Module is the name of the module the migrations belong to.
+	Module string
+
+	// FS holds the migration files, in Dir.
+	FS  fs.FS
+	Dir string
+}
+
+// Migration is a single schema change and the statements reverting it.
+type Migration struct {
+	Version uint64
+	Name    string
+	Up      string
+	Down    string
+}
+
+// Migrations reads the migrations of the source for the given dialect,
+// ordered by version.
+func (s Source) Migrations(dialect string) ([]Migration, error) {
+	entries, err := fs.ReadDir(s.FS, s.Dir)
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := map[uint64]*Migration{}
+	// specific records which parts of each migration came from a file for the
+	// dialect, so that generic files don't replace them.
+	specific := map[string]bool{}
+	for _, entry := range entries {
+		match := fileName.FindStringSubmatch(entry.Name())
+		if entry.IsDir() || match == nil {
+			continue
+		}
+		if match[3] != "" && match[3] != dialect {
+			continue
+		}
+
+		version, err := strconv.ParseUint(match[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid migration version %s: %w", entry.Name(), err)
+		}
+
+		m, ok := byVersion[version]
+		if !ok {
+			m = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = m
+		} else if m.Name != match[2] {
+			return nil, fmt.Errorf("%s migration %d has conflicting names %s and %s", s.Module, version, m.Name, match[2])
+		}
+
+		part := match[1] + "." + match[4]
+		if specific[part] {
+			continue
+		}
+		specific[part] = match[3] != ""
+
+		sql, err := fs.ReadFile(s.FS, path.Join(s.Dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		if match[4] == "up" {
+			m.Up = string(sql)
+		} else {
+			m.Down = string(sql)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.Up == "" {
+			return nil, fmt.Errorf("%s migration %d_%s has no up migration", s.Module, m.Version, m.Name)
+		}
+		migrations = append(migrations, *m)
+	}
+
+	slices.SortFunc(migrations, func(a, b Migration) int {
+		return cmp.Compare(a.Version, b.Version)
+	})
+
+	return migrations, nil
+}
+
+// record is a row of the migrations table.
+type record struct {
+	Module    string `gorm:"primaryKey"`
+	Version   uint64 `gorm:"primaryKey;autoIncrement:false"`
+	Name      string `gorm:"notNull"`
+	AppliedAt time.Time
+}
+
+func (record) TableName() string {
+	return TableName
+}
+
+// Status is a migration and whether it has been applied.
+type Status struct {
+	Migration
+
+	Applied   bool
+	AppliedAt time.Time
+}
+
+// Migrator applies migrations to a database.
+type Migrator struct {
+	db     *gorm.DB
+	logger *slog.Logger
+}
+
+func New(db *gorm.DB, logger *slog.Logger) *Migrator {
+	return &Migrator{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// Up applies every pending migration of the source, returning how many were
+// applied.
+func (m *Migrator) Up(ctx context.Context, source Source) (int, error) {
+	migrations, err := source.Migrations(m.db.Dialector.Name())
+	if err != nil {
+		return 0, err
+	}
+
+	applied := 0
+	err = m.locked(ctx, func(conn *gorm.DB) error {
+		records, err := appliedRecords(conn, source.Module)
+		if err != nil {
+			return err
+		}
+
+		for _, migration := range migrations {
+			if _, ok := records[migration.Version]; ok {
+				continue
+			}
+
+			m.logger.Info("applying migration", "version", migration.Version, "name", migration.Name)
+			if err := conn.Transaction(func(tx *gorm.DB) error {
+				if err := tx.Exec(migration.Up).Error; err != nil {
+					return err
+				}
+				return tx.Create(&record{
+					Module:    source.Module,
+					Version:   migration.Version,
+					Name:      migration.Name,
+					AppliedAt: time.Now(),
+				}).Error
+			}); err != nil {
+				return fmt.Errorf("failed to apply %s migration %d_%s: %w", source.Module, migration.Version, migration.Name, err)
+			}
+			applied++
+		}
+
+		return nil
+	})
+
+	return applied, err
+}
+
+// Down reverts the given number of most recently applied migrations of the
+// source, returning how many were reverted.
+func (m *Migrator) Down(ctx context.Context, source Source, steps int) (int, error) {
+	migrations, err := source.Migrations(m.db.Dialector.Name())
+	if err != nil {
+		return 0, err
+	}
+
+	reverted := 0
+	err = m.locked(ctx, func(conn *gorm.DB) error {
+		records, err := appliedRecords(conn, source.Module)
+		if err != nil {
+			return err
+		}
+
+		for _, migration := range slices.Backward(migrations) {
+			if reverted >= steps {
+				break
+			}
+			if _, ok := records[migration.Version]; !ok {
+				continue
+			}
+			if migration.Down == "" {
+				return fmt.Errorf("%s migration %d_%s cannot be reverted", source.Module, migration.Version, migration.Name)
+			}
+
+			m.logger.Info("reverting migration", "version", migration.Version, "name", migration.Name)
+			if err := conn.Transaction(func(tx *gorm.DB) error {
+				if err := tx.Exec(migration.Down).Error; err != nil {
+					return err
+				}
+				return tx.Delete(&record{Module: source.Module, Version: migration.Version}).Error
+			}); err != nil {
+				return fmt.Errorf("failed to revert %s migration %d_%s: %w", source.Module, migration.Version, migration.Name, err)
+			}
+			reverted++
+		}
+
+		return nil
+	})
+
+	return reverted, err
+}
+
+// Status lists the migrations of the source, and whether they have been
+// applied.
+func (m *Migrator) Status(ctx context.Context, source Source) ([]Status, error) {
+	migrations, err := source.Migrations(m.db.Dialector.Name())
+	if err != nil {
+		return nil, err
+	}
+
+	conn := m.db.WithContext(ctx)
+	if err := ensureTable(conn); err != nil {
+		return nil, err
+	}
+
+	records, err := appliedRecords(conn, source.Module)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]Status, 0, len(migrations))
+	for _, migration := range migrations {
+		r, ok := records[migration.Version]
+		statuses = append(statuses, Status{
+			Migration: migration,
+			Applied:   ok,
+			AppliedAt: r.AppliedAt,
+		})
+	}
+
+	return statuses, nil
+}
+
+// locked runs fn on a single connection holding the migration lock.
+func (m *Migrator) locked(ctx context.Context, fn func(conn *gorm.DB) error) error {
+	return m.db.WithContext(ctx).Connection(func(conn *gorm.DB) error {
+		if conn.Dialector.Name() == "postgres" {
+			if err := conn.Exec("SELECT pg_advisory_lock(?)", lockID).Error; err != nil {
+				return fmt.Errorf("failed to acquire migration lock: %w", err)
+			}
+			defer conn.Exec("SELECT pg_advisory_unlock(?)", lockID)
+		}
+
+		if err := ensureTable(conn); err != nil {
+			return err
+		}
+
+		return fn(conn)
+	})
+}
+
+func ensureTable(conn *gorm.DB) error {
+	// SQLite only reads columns declared as a datetime back as times.
+	timestamp := "timestamptz"
+	if conn.Dialector.Name() == "sqlite" {
+		timestamp = "datetime"
+	}
+
+	return conn.Exec(`CREATE TABLE IF NOT EXISTS ` + TableName + ` (
+		module text NOT NULL,
+		version bigint NOT NULL,
+		name text NOT NULL,
+		applied_at ` + timestamp + ` NOT NULL,
+		PRIMARY KEY (module, version)
+	)`).Error
+}
+
+func appliedRecords(conn *gorm.DB, module string) (map[uint64]record, error) {
+	var records []record
+	if err := conn.Where("module = ?", module).Find(&records).Error; err != nil {
+		return nil, err
+	}
+
+	byVersion := make(map[uint64]record, len(records))
+	for _, r := range records {
+		byVersion[r.Version] = r
+	}
+
+	return byVersion, nil
+}
+
+// Create writes an empty pair of up and down migration files to dir, numbered
+// after the latest migration in it. It returns the paths of the files.
+func Create(dir string, name string) (string, string, error) {
+	if !migrationName.MatchString(name) {
+		return "", "", errors.New("migration names may only contain lowercase letters, digits and underscores")
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return "", "", err
+	}
+
+	version := uint64(1)
+	for _, entry := range entries {
+		match := fileName.FindStringSubmatch(entry.Name())
+		if entry.IsDir() || match == nil {
+			continue
+		}
+		if v, err := strconv.ParseUint(match[1], 10, 64); err == nil && v >= version {
+			version = v + 1
+		}
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", "", err
+	}
+
+	base := fmt.Sprintf("%04d_%s", version, name)
+	up := filepath.Join(dir, base+".up.sql")
+	down := filepath.Join(dir, base+".down.sql")
+
+	if err := os.WriteFile(up, []byte("-- "+base+"\n"), 0o644); err != nil {
+		return "", "", err
+	}
+	if err := os.WriteFile(down, []byte("-- Reverts "+base+"\n"), 0o644); err != nil {
+		return "", "", err
+	}
+
+	return up, down, nil
+}
@@ -0,0 +1,89 @@
+package db
+
+import (
+	"errors"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+const querySpanKey = "pincer:query_span"
+
+// tracingPlugin is a GORM plugin wrapping every query in a span, parented to
+// the span of the request the query was made for.
+type tracingPlugin struct {
+	tracer trace.Tracer
+}
+
+func newTracingPlugin() *tracingPlugin {
+	return &tracingPlugin{
+		tracer: otel.Tracer("github.com/milsim-tools/pincer/pkg/db"),
+	}
+}
+
+func (p *tracingPlugin) Name() string {
+	return "pincer:tracing"
+}
+
+func (p *tracingPlugin) Initialize(db *gorm.DB) error {
+	cb := db.Callback()
+	return errors.Join(
+		cb.Create().Before("gorm:create").Register("pincer:tracing_before_create", p.before("create")),
+		cb.Create().After("gorm:create").Register("pincer:tracing_after_create", p.after),
+		cb.Query().Before("gorm:query").Register("pincer:tracing_before_query", p.before("query")),
+		cb.Query().After("gorm:query").Register("pincer:tracing_after_query", p.after),
+		cb.Update().Before("gorm:update").Register("pincer:tracing_before_update", p.before("update")),
+		cb.Update().After("gorm:update").Register("pincer:tracing_after_update", p.after),
+		cb.Delete().Before("gorm:delete").Register("pincer:tracing_before_delete", p.before("delete")),
+		cb.Delete().After("gorm:delete").Register("pincer:tracing_after_delete", p.after),
+		cb.Row().Before("gorm:row").Register("pincer:tracing_before_row", p.before("row")),
+		cb.Row().After("gorm:row").Register("pincer:tracing_after_row", p.after),
+		cb.Raw().Before("gorm:raw").Register("pincer:tracing_before_raw", p.before("raw")),
+		cb.Raw().After("gorm:raw").Register("pincer:tracing_after_raw", p.after),
+	)
+}
+
+func (p *tracingPlugin) before(operation string) func(*gorm.DB) {
+	return func(db *gorm.DB) {
+		name := "db." + operation
+		if db.Statement.Table != "" {
+			name += " " + db.Statement.Table
+		}
+
+		ctx, span := p.tracer.Start(db.Statement.Context, name,
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(
+				attribute.String("db.system.name", db.Dialector.Name()),
+				attribute.String("db.operation.name", operation),
+				attribute.String("db.collection.name", db.Statement.Table),
+			),
+		)
+		db.Statement.Context = ctx
+		db.InstanceSet(querySpanKey, span)
+	}
+}
+
+func (p *tracingPlugin) after(db *gorm.DB) {
+	value, ok := db.InstanceGet(querySpanKey)
+	if !ok {
+		return
+	}
+	span, ok := value.(trace.Span)
+	if !ok {
+		return
+	}
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.query.text", db.Statement.SQL.String()),
+		attribute.Int64("db.response.returned_rows", db.Statement.RowsAffected),
+	)
+
+	if db.Error != nil && !errors.Is(db.Error, gorm.ErrRecordNotFound) {
+		span.RecordError(db.Error)
+		span.SetStatus(codes.Error, db.Error.Error())
+	}
+}
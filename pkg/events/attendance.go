@@ -0,0 +1,84 @@
+package events
+
+import (
+	"context"
+
+	eventsv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/events/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// attendanceTotals sums up attendance records.
+type attendanceTotals struct {
+	present, late, excused, awol int32
+}
+
+func (t *attendanceTotals) add(other attendanceTotals) {
+	t.present += other.present
+	t.late += other.late
+	t.excused += other.excused
+	t.awol += other.awol
+}
+
+// rate returns the share of occurrences attended, leaving out excused
+// absences, and whether there are any occurrences it is a share of.
+func (t attendanceTotals) rate() (float64, bool) {
+	expected := t.present + t.late + t.awol
+	if expected == 0 {
+		return 0, false
+	}
+
+	return float64(t.present+t.late) / float64(expected), true
+}
+
+func (t attendanceTotals) Proto() *eventsv1.AttendanceTotals {
+	totals := &eventsv1.AttendanceTotals{
+		Present: t.present,
+		Late:    t.late,
+		Excused: t.excused,
+		Awol:    t.awol,
+	}
+	if rate, ok := t.rate(); ok {
+		totals.Rate = &rate
+	}
+
+	return totals
+}
+
+// countAttendance sums up the attendance records matching the query, by user.
+func (s *Events) countAttendance(ctx context.Context, query any, args ...any) (map[string]attendanceTotals, error) {
+	var counts []struct {
+		UserID string
+		Status int32
+		Count  int32
+	}
+	if err := s.db.Replica.WithContext(ctx).
+		Model(&EventsAttendance{}).
+		Select("user_id, status, COUNT(*) AS count").
+		Where(query, args...).
+		Group("user_id, status").
+		Scan(&counts).Error; err != nil {
+		return nil, status.Error(
+			codes.Internal,
+			"failed to count attendance: "+err.Error(),
+		)
+	}
+
+	byUser := map[string]attendanceTotals{}
+	for _, c := range counts {
+		t := byUser[c.UserID]
+		switch eventsv1.AttendanceStatus(c.Status) {
+		case eventsv1.AttendanceStatus_ATTENDANCE_STATUS_PRESENT:
+			t.present += c.Count
+		case eventsv1.AttendanceStatus_ATTENDANCE_STATUS_LATE:
+			t.late += c.Count
+		case eventsv1.AttendanceStatus_ATTENDANCE_STATUS_EXCUSED:
+			t.excused += c.Count
+		case eventsv1.AttendanceStatus_ATTENDANCE_STATUS_AWOL:
+			t.awol += c.Count
+		}
+		byUser[c.UserID] = t
+	}
+
+	return byUser, nil
+}
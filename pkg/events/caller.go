@@ -0,0 +1,14 @@
+package events
+
+import (
+	"context"
+
+	"github.com/milsim-tools/pincer/pkg/authz"
+)
+
+// caller returns the ID of the user calling, who events are attributed to. It
+// is empty when authentication is disabled.
+func caller(ctx context.Context) string {
+	identity, _ := authz.FromContext(ctx)
+	return identity.UserID
+}
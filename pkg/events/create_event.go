@@ -0,0 +1,65 @@
+package events
+
+import (
+	"context"
+
+	"github.com/milsim-tools/pincer/internal/models"
+	eventsv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/events/v1"
+	unitsv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/units/v1"
+	"github.com/oklog/ulid/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+func (s *Events) CreateEvent(ctx context.Context, req *eventsv1.CreateEventRequest) (*eventsv1.Event, error) {
+	event := EventsEvent{
+		Model: models.Model{
+			ID: ulid.Make().String(),
+		},
+		UnitID:     req.Event.UnitId,
+		Title:      req.Event.Title,
+		Briefing:   req.Event.Briefing,
+		StartTime:  seconds(req.Event.StartTime),
+		EndTime:    seconds(req.Event.EndTime),
+		TimeZone:   req.Event.TimeZone,
+		Visibility: int32(req.Event.Visibility),
+		Server:     newServer(req.Event.Server),
+		CreatedBy:  caller(ctx),
+	}
+	event.setRecurrence(req.Event.Recurrence)
+	if event.TimeZone == "" {
+		event.TimeZone = "UTC"
+	}
+	if event.Visibility == int32(eventsv1.EventVisibility_EVENT_VISIBILITY_UNSPECIFIED) {
+		event.Visibility = int32(eventsv1.EventVisibility_EVENT_VISIBILITY_UNIT)
+	}
+
+	if err := validate(&event); err != nil {
+		return &eventsv1.Event{}, err
+	}
+
+	if _, err := s.units.GetUnit(ctx, &unitsv1.GetUnitRequest{
+		Value: &unitsv1.GetUnitRequest_Id{Id: event.UnitID},
+	}); err != nil {
+		if status.Code(err) == codes.NotFound {
+			return &eventsv1.Event{}, status.Error(
+				codes.InvalidArgument,
+				"event.unit_id does not correspond to an existing unit",
+			)
+		}
+		return &eventsv1.Event{}, status.Error(
+			codes.Internal,
+			"failed to call units service: "+err.Error(),
+		)
+	}
+
+	if err := gorm.G[EventsEvent](s.db.Db).Create(ctx, &event); err != nil {
+		return &eventsv1.Event{}, status.Error(
+			codes.Internal,
+			"failed to create event: "+err.Error(),
+		)
+	}
+
+	return event.Proto(), nil
+}
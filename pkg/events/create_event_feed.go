@@ -0,0 +1,75 @@
+package events
+
+import (
+	"context"
+
+	"github.com/milsim-tools/pincer/internal/models"
+	eventsv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/events/v1"
+	unitsv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/units/v1"
+	"github.com/oklog/ulid/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+func (s *Events) CreateEventFeed(ctx context.Context, req *eventsv1.CreateEventFeedRequest) (*eventsv1.EventFeed, error) {
+	if req.UnitId != "" {
+		if _, err := s.units.GetUnit(ctx, &unitsv1.GetUnitRequest{
+			Value: &unitsv1.GetUnitRequest_Id{Id: req.UnitId},
+		}); err != nil {
+			if status.Code(err) == codes.NotFound {
+				return &eventsv1.EventFeed{}, status.Error(
+					codes.InvalidArgument,
+					"unit_id does not correspond to an existing unit",
+				)
+			}
+			return &eventsv1.EventFeed{}, status.Error(
+				codes.Internal,
+				"failed to call units service: "+err.Error(),
+			)
+		}
+	}
+
+	count, err := gorm.G[EventsFeed](s.db.Db).Where("user_id = ?", req.UserId).Count(ctx, "*")
+	if err != nil {
+		return &eventsv1.EventFeed{}, status.Error(
+			codes.Internal,
+			"failed to count feeds: "+err.Error(),
+		)
+	}
+	if count >= maxFeeds {
+		return &eventsv1.EventFeed{}, status.Error(
+			codes.FailedPrecondition,
+			"the user has too many feeds, delete one first",
+		)
+	}
+
+	token, hash, err := newFeedToken()
+	if err != nil {
+		return &eventsv1.EventFeed{}, status.Error(
+			codes.Internal,
+			"failed to generate token: "+err.Error(),
+		)
+	}
+
+	feed := EventsFeed{
+		Model: models.Model{
+			ID: ulid.Make().String(),
+		},
+		UserID:    req.UserId,
+		UnitID:    req.UnitId,
+		TokenHash: hash,
+	}
+	if err := gorm.G[EventsFeed](s.db.Db).Create(ctx, &feed); err != nil {
+		return &eventsv1.EventFeed{}, status.Error(
+			codes.Internal,
+			"failed to create feed: "+err.Error(),
+		)
+	}
+
+	// Only the hash of the token is kept, so this is the one time it is known.
+	resp := feed.Proto()
+	resp.Path = feedPath(token)
+
+	return resp, nil
+}
@@ -0,0 +1,40 @@
+package events
+
+import (
+	"context"
+
+	eventsv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/events/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"gorm.io/gorm"
+)
+
+func (s *Events) DeleteEvent(ctx context.Context, req *eventsv1.DeleteEventRequest) (*emptypb.Empty, error) {
+	if _, err := s.event(ctx, req.UnitId, req.Id); err != nil {
+		return &emptypb.Empty{}, err
+	}
+
+	err := s.db.Db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if _, err := gorm.G[EventsResponse](tx).Where("event_id = ?", req.Id).Delete(ctx); err != nil {
+			return err
+		}
+		if _, err := gorm.G[EventsOverride](tx).Where("event_id = ?", req.Id).Delete(ctx); err != nil {
+			return err
+		}
+		if _, err := gorm.G[EventsAttendance](tx).Where("event_id = ?", req.Id).Delete(ctx); err != nil {
+			return err
+		}
+
+		_, err := gorm.G[EventsEvent](tx).Where("id = ?", req.Id).Delete(ctx)
+		return err
+	})
+	if err != nil {
+		return &emptypb.Empty{}, status.Error(
+			codes.Internal,
+			"failed to delete event: "+err.Error(),
+		)
+	}
+
+	return &emptypb.Empty{}, nil
+}
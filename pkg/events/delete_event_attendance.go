@@ -0,0 +1,38 @@
+package events
+
+import (
+	"context"
+
+	eventsv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/events/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"gorm.io/gorm"
+)
+
+func (s *Events) DeleteEventAttendance(ctx context.Context, req *eventsv1.DeleteEventAttendanceRequest) (*emptypb.Empty, error) {
+	event, err := s.event(ctx, req.UnitId, req.EventId)
+	if err != nil {
+		return &emptypb.Empty{}, err
+	}
+
+	o, err := s.occurrence(ctx, event, req.OccurrenceTime, "occurrence_time")
+	if err != nil {
+		return &emptypb.Empty{}, err
+	}
+
+	deleted, err := gorm.G[EventsAttendance](s.db.Db).
+		Where("event_id = ? AND occurrence_time = ? AND user_id = ?", req.EventId, o.time, req.UserId).
+		Delete(ctx)
+	if err != nil {
+		return &emptypb.Empty{}, status.Error(
+			codes.Internal,
+			"failed to delete attendance: "+err.Error(),
+		)
+	}
+	if deleted == 0 {
+		return &emptypb.Empty{}, status.Error(codes.NotFound, "attendance not found")
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,28 @@
+package events
+
+import (
+	"context"
+
+	eventsv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/events/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"gorm.io/gorm"
+)
+
+func (s *Events) DeleteEventFeed(ctx context.Context, req *eventsv1.DeleteEventFeedRequest) (*emptypb.Empty, error) {
+	deleted, err := gorm.G[EventsFeed](s.db.Db).
+		Where("id = ? AND user_id = ?", req.Id, req.UserId).
+		Delete(ctx)
+	if err != nil {
+		return &emptypb.Empty{}, status.Error(
+			codes.Internal,
+			"failed to delete feed: "+err.Error(),
+		)
+	}
+	if deleted == 0 {
+		return &emptypb.Empty{}, status.Error(codes.NotFound, "feed not found")
+	}
+
+	return &emptypb.Empty{}, nil
+}
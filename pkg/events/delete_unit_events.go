@@ -0,0 +1,41 @@
+package events
+
+import (
+	"context"
+
+	eventsv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/events/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"gorm.io/gorm"
+)
+
+func (s *Events) DeleteUnitEvents(ctx context.Context, req *eventsv1.DeleteUnitEventsRequest) (*emptypb.Empty, error) {
+	err := s.db.Db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		events := tx.Model(&EventsEvent{}).Select("id").Where("unit_id = ?", req.UnitId)
+
+		if _, err := gorm.G[EventsResponse](tx).Where("event_id IN (?)", events).Delete(ctx); err != nil {
+			return err
+		}
+		if _, err := gorm.G[EventsOverride](tx).Where("event_id IN (?)", events).Delete(ctx); err != nil {
+			return err
+		}
+		if _, err := gorm.G[EventsAttendance](tx).Where("unit_id = ?", req.UnitId).Delete(ctx); err != nil {
+			return err
+		}
+		if _, err := gorm.G[EventsFeed](tx).Where("unit_id = ?", req.UnitId).Delete(ctx); err != nil {
+			return err
+		}
+
+		_, err := gorm.G[EventsEvent](tx).Where("unit_id = ?", req.UnitId).Delete(ctx)
+		return err
+	})
+	if err != nil {
+		return &emptypb.Empty{}, status.Error(
+			codes.Internal,
+			"failed to delete events: "+err.Error(),
+		)
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,80 @@
+package events
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/grafana/dskit/services"
+	"github.com/milsim-tools/pincer/internal/helpers"
+	eventsv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/events/v1"
+	unitsv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/units/v1"
+	"github.com/milsim-tools/pincer/pkg/authz"
+	"github.com/milsim-tools/pincer/pkg/db"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	FlagUnitsGrpcAddr = "events-units-grpc-addr"
+)
+
+var Flags = []cli.Flag{
+	&cli.StringFlag{
+		Name:    FlagUnitsGrpcAddr,
+		Value:   "localhost:9000",
+		Usage:   "The units service, used when the units module runs in another process.",
+		EnvVars: []string{"PINCER_EVENTS_UNITS_GRPC_ADDR"},
+	},
+}
+
+type Config struct {
+	UnitsGrpcAddr string
+}
+
+func ConfigFromFlags(ctx *cli.Context) Config {
+	var config Config
+
+	config.UnitsGrpcAddr = ctx.String(FlagUnitsGrpcAddr)
+
+	return config
+}
+
+type Events struct {
+	eventsv1.EventsServiceServer
+	services.Service
+
+	cfg    Config
+	logger *slog.Logger
+
+	db         *db.Db
+	pages      *helpers.Paginator
+	authorizer *authz.Authorizer
+
+	units unitsv1.UnitsServiceClient
+}
+
+func New(
+	logger *slog.Logger,
+	cfg Config,
+	db *db.Db,
+	pages *helpers.Paginator,
+	authorizer *authz.Authorizer,
+	units unitsv1.UnitsServiceClient,
+) (*Events, error) {
+	e := &Events{
+		cfg:        cfg,
+		logger:     logger,
+		db:         db,
+		pages:      pages,
+		authorizer: authorizer,
+		units:      units,
+	}
+
+	e.Service = services.NewIdleService(e.starting, nil)
+
+	return e, nil
+}
+
+// starting migrates the database once it is reachable.
+func (s *Events) starting(ctx context.Context) error {
+	return s.db.Migrate(ctx, Migrations)
+}
@@ -0,0 +1,163 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	ics "github.com/arran4/golang-ical"
+	"github.com/gorilla/mux"
+	eventsv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/events/v1"
+	"github.com/milsim-tools/pincer/pkg/authz"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+const (
+	// feedHistory and feedHorizon bound the occurrences a feed has, relative
+	// to the time it is fetched.
+	feedHistory = 30 * 24 * time.Hour
+	feedHorizon = 365 * 24 * time.Hour
+)
+
+// RegisterFeeds serves feeds in iCalendar format on the router. Calendar apps
+// cannot authenticate, so the token in the path is what grants access to a
+// feed, which has the events its user can view.
+func (s *Events) RegisterFeeds(router *mux.Router) {
+	router.Path(feedRoute).Methods("GET").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		feed, err := gorm.G[EventsFeed](s.db.Db).
+			Where("token_hash = ?", hashFeedToken(mux.Vars(r)["token"])).
+			First(ctx)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				http.Error(w, "feed not found", http.StatusNotFound)
+				return
+			}
+			s.logger.Error("failed to query feed", "err", err)
+			http.Error(w, "failed to query feed", http.StatusInternalServerError)
+			return
+		}
+
+		now := time.Now().UTC().Truncate(time.Second)
+		occurrences, err := s.feedOccurrences(ctx, feed, now.Add(-feedHistory), now.Add(feedHorizon))
+		if err != nil {
+			s.logger.Error("failed to build feed", "feed", feed.ID, "err", err)
+			http.Error(w, "failed to build feed", http.StatusInternalServerError)
+			return
+		}
+
+		if _, err := gorm.G[EventsFeed](s.db.Db).
+			Where("id = ?", feed.ID).
+			Update(ctx, "last_used_at", now); err != nil {
+			s.logger.Warn("failed to record feed use", "feed", feed.ID, "err", err)
+		}
+
+		cal := ics.NewCalendar()
+		cal.SetMethod(ics.MethodPublish)
+		cal.SetProductId("-//milsim-tools//Pincer//EN")
+		cal.SetName("Pincer events")
+		cal.SetXWRCalName("Pincer events")
+		cal.SetRefreshInterval("PT1H")
+		cal.SetXPublishedTTL("PT1H")
+		for _, o := range occurrences {
+			addOccurrence(cal, o, now)
+		}
+
+		w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+		w.Header().Set("Cache-Control", "private, max-age=300")
+		if err := cal.SerializeTo(w); err != nil {
+			s.logger.Warn("failed to write feed", "feed", feed.ID, "err", err)
+		}
+	})
+}
+
+// feedOccurrences returns the occurrences of the feed between from and to.
+// Occurrences of units whose events the feed's user cannot view are left out,
+// except for public events of the unit of a unit feed, which are stripped of
+// their server.
+func (s *Events) feedOccurrences(ctx context.Context, feed EventsFeed, from, to time.Time) ([]occurrence, error) {
+	var visible []string
+	if feed.UnitID == "" {
+		units, err := s.authorizer.UnitsWithPermission(ctx, feed.UserID, authz.PermissionViewEvents)
+		if err != nil {
+			return nil, err
+		}
+		visible = units
+	} else {
+		can, err := s.authorizer.HasUnitPermission(ctx, feed.UnitID, feed.UserID, authz.PermissionViewEvents)
+		if err != nil && status.Code(err) != codes.NotFound {
+			return nil, err
+		}
+		if can {
+			visible = []string{feed.UnitID}
+		}
+	}
+
+	var occurrences []occurrence
+	if len(visible) > 0 {
+		o, err := s.occurrencesIn(ctx, gorm.G[EventsEvent](s.db.Db).Where("unit_id IN ?", visible), from, to)
+		if err != nil {
+			return nil, err
+		}
+		occurrences = o
+	} else if feed.UnitID != "" {
+		o, err := s.occurrencesIn(ctx, gorm.G[EventsEvent](s.db.Db).
+			Where("unit_id = ? AND visibility = ?", feed.UnitID, int32(eventsv1.EventVisibility_EVENT_VISIBILITY_PUBLIC)), from, to)
+		if err != nil {
+			return nil, err
+		}
+		for i := range o {
+			o[i].event.Server = Server{}
+		}
+		occurrences = o
+	}
+
+	return occurrences, nil
+}
+
+// addOccurrence adds the occurrence to the calendar as an event of its own,
+// so calendar apps need not expand recurrences or apply overrides. Server
+// passwords are left out, as calendars are often shared further.
+func addOccurrence(cal *ics.Calendar, o occurrence, now time.Time) {
+	uid := o.event.ID
+	if o.event.Recurrence != "" {
+		uid += "-" + o.time.UTC().Format("20060102T150405Z")
+	}
+
+	e := cal.AddEvent(uid + "@pincer")
+	e.SetDtStampTime(now)
+	e.SetCreatedTime(o.event.CreatedAt)
+	e.SetModifiedAt(o.event.UpdatedAt)
+	e.SetStartAt(o.event.StartTime)
+	e.SetEndAt(o.event.EndTime)
+	e.SetSummary(o.event.Title)
+
+	description := o.event.Briefing
+	if server := o.event.Server; server.Address != "" {
+		location := server.Address
+		if server.Port != 0 {
+			location += ":" + strconv.FormatUint(uint64(server.Port), 10)
+		}
+		e.SetLocation(location)
+
+		var details []string
+		if server.Game != "" {
+			details = append(details, "Game: "+server.Game)
+		}
+		details = append(details, "Server: "+location)
+		if server.Modset != "" {
+			details = append(details, "Modset: "+server.Modset)
+		}
+		description = strings.TrimSpace(fmt.Sprintf("%s\n\n%s", description, strings.Join(details, "\n")))
+	}
+	if description != "" {
+		e.SetDescription(description)
+	}
+}
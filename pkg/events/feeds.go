@@ -0,0 +1,38 @@
+package events
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+)
+
+const (
+	// feedRoute is the route of feeds on the HTTP listener.
+	feedRoute = "/feeds/events/{token}.ics"
+
+	// maxFeeds is the number of feeds a user can have at once.
+	maxFeeds = 20
+)
+
+// newFeedToken returns a random token granting access to a feed, and the hash
+// it is stored as.
+func newFeedToken() (string, string, error) {
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return "", "", err
+	}
+	token := base64.RawURLEncoding.EncodeToString(raw)
+
+	return token, hashFeedToken(token), nil
+}
+
+func hashFeedToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// feedPath returns the path of the feed with the token.
+func feedPath(token string) string {
+	return "/feeds/events/" + token + ".ics"
+}
@@ -0,0 +1,66 @@
+package events
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	eventsv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/events/v1"
+)
+
+func (s *Events) GetAttendanceReport(ctx context.Context, req *eventsv1.GetAttendanceReportRequest) (*eventsv1.GetAttendanceReportResponse, error) {
+	from, to, err := window(req.StartTime, req.EndTime)
+	if err != nil {
+		return &eventsv1.GetAttendanceReportResponse{}, err
+	}
+
+	byUser, err := s.countAttendance(ctx,
+		"unit_id = ? AND occurrence_time >= ? AND occurrence_time < ?", req.UnitId, from, to)
+	if err != nil {
+		return &eventsv1.GetAttendanceReportResponse{}, err
+	}
+
+	var unit attendanceTotals
+	users := make([]string, 0, len(byUser))
+	for user, t := range byUser {
+		unit.add(t)
+
+		if req.Below != nil {
+			if rate, ok := t.rate(); !ok || rate >= *req.Below {
+				continue
+			}
+		}
+		users = append(users, user)
+	}
+
+	// Members with the lowest attendance come first, members whose every
+	// absence is excused last.
+	slices.SortFunc(users, func(a, b string) int {
+		ra, oka := byUser[a].rate()
+		rb, okb := byUser[b].rate()
+		if oka != okb {
+			if oka {
+				return -1
+			}
+			return 1
+		}
+		if c := cmp.Compare(ra, rb); c != 0 {
+			return c
+		}
+		return cmp.Compare(a, b)
+	})
+
+	// TODO: sum up the totals of each section too, once units have sections
+	// to group their members by.
+	resp := &eventsv1.GetAttendanceReportResponse{
+		Totals: unit.Proto(),
+	}
+	for _, user := range users {
+		resp.Members = append(resp.Members, &eventsv1.MemberAttendance{
+			UserId: user,
+			Totals: byUser[user].Proto(),
+		})
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,80 @@
+package events
+
+import (
+	"context"
+	"errors"
+
+	eventsv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/events/v1"
+	"github.com/milsim-tools/pincer/pkg/authz"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+func (s *Events) GetEvent(ctx context.Context, req *eventsv1.GetEventRequest) (*eventsv1.Event, error) {
+	event, err := s.event(ctx, req.UnitId, req.Id)
+	if err != nil {
+		return &eventsv1.Event{}, err
+	}
+
+	unit, err := s.canViewUnit(ctx, req.UnitId)
+	if err != nil {
+		return &eventsv1.Event{}, err
+	}
+	if !unit && eventsv1.EventVisibility(event.Visibility) != eventsv1.EventVisibility_EVENT_VISIBILITY_PUBLIC {
+		return &eventsv1.Event{}, status.Error(
+			codes.PermissionDenied,
+			"caller lacks the required unit permission",
+		)
+	}
+
+	return view(event, unit), nil
+}
+
+// event returns an event of the unit. Events are looked up within the unit
+// the caller was authorized for, so that the unit's staff cannot reach the
+// events of other units.
+func (s *Events) event(ctx context.Context, unitID string, id string) (EventsEvent, error) {
+	event, err := gorm.G[EventsEvent](s.db.Db).
+		Where("id = ? AND unit_id = ?", id, unitID).
+		First(ctx)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return EventsEvent{}, status.Error(
+				codes.NotFound,
+				"event not found",
+			)
+		}
+
+		return EventsEvent{}, status.Error(
+			codes.Internal,
+			"failed to query event: "+err.Error(),
+		)
+	}
+
+	return event, nil
+}
+
+// canViewUnit reports whether the caller can view all the events of the unit,
+// rather than only its public events.
+func (s *Events) canViewUnit(ctx context.Context, unitID string) (bool, error) {
+	err := s.authorizer.RequireUnitPermission(ctx, unitID, authz.PermissionViewEvents)
+	switch status.Code(err) {
+	case codes.OK:
+		return true, nil
+	case codes.PermissionDenied, codes.Unauthenticated:
+		return false, nil
+	}
+
+	return false, err
+}
+
+// view returns the event as shown to the caller. Callers who cannot view all
+// the unit's events are not shown its game server.
+func view(event EventsEvent, unit bool) *eventsv1.Event {
+	e := event.Proto()
+	if !unit {
+		e.Server = nil
+	}
+	return e
+}
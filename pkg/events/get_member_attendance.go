@@ -0,0 +1,25 @@
+package events
+
+import (
+	"context"
+
+	eventsv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/events/v1"
+)
+
+func (s *Events) GetMemberAttendance(ctx context.Context, req *eventsv1.GetMemberAttendanceRequest) (*eventsv1.MemberAttendance, error) {
+	from, to, err := window(req.StartTime, req.EndTime)
+	if err != nil {
+		return &eventsv1.MemberAttendance{}, err
+	}
+
+	byUser, err := s.countAttendance(ctx,
+		"unit_id = ? AND user_id = ? AND occurrence_time >= ? AND occurrence_time < ?", req.UnitId, req.UserId, from, to)
+	if err != nil {
+		return &eventsv1.MemberAttendance{}, err
+	}
+
+	return &eventsv1.MemberAttendance{
+		UserId: req.UserId,
+		Totals: byUser[req.UserId].Proto(),
+	}, nil
+}
@@ -0,0 +1,78 @@
+package events
+
+import (
+	"context"
+	"errors"
+
+	"github.com/milsim-tools/pincer/internal/helpers"
+	eventsv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/events/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// attendanceFilter translates the filters of ListEventAttendance.
+var attendanceFilter = helpers.MustNewFilter(&eventsv1.EventAttendance{}, helpers.FilterColumns{
+	"user_id":     "user_id",
+	"status":      "status",
+	"recorded_by": "recorded_by",
+	"updated_at":  "updated_at",
+})
+
+func (s *Events) ListEventAttendance(ctx context.Context, req *eventsv1.ListEventAttendanceRequest) (*eventsv1.ListEventAttendanceResponse, error) {
+	where, err := attendanceFilter.Parse(req.Filter)
+	if err != nil {
+		return &eventsv1.ListEventAttendanceResponse{}, err
+	}
+
+	event, err := s.event(ctx, req.UnitId, req.EventId)
+	if err != nil {
+		return &eventsv1.ListEventAttendanceResponse{}, err
+	}
+
+	o, err := s.occurrence(ctx, event, req.OccurrenceTime, "occurrence_time")
+	if err != nil {
+		return &eventsv1.ListEventAttendanceResponse{}, err
+	}
+
+	qb := gorm.G[EventsAttendance](s.db.Replica).
+		Where("event_id = ? AND occurrence_time = ?", req.EventId, o.time)
+	if where != nil {
+		qb = qb.Where(where)
+	}
+
+	attendance, next, err := helpers.Paginate(ctx, s.pages, qb, helpers.Page{
+		Size:    int(req.PageSize),
+		Token:   req.PageToken,
+		Filters: []any{req.EventId, o.time, req.Filter},
+	})
+	if err != nil {
+		if errors.Is(err, helpers.ErrInvalidPageToken) {
+			return &eventsv1.ListEventAttendanceResponse{}, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return &eventsv1.ListEventAttendanceResponse{}, status.Error(
+			codes.Internal,
+			"failed to query attendance: "+err.Error(),
+		)
+	}
+
+	byUser, err := s.countAttendance(ctx, "event_id = ? AND occurrence_time = ?", req.EventId, o.time)
+	if err != nil {
+		return &eventsv1.ListEventAttendanceResponse{}, err
+	}
+
+	var totals attendanceTotals
+	for _, t := range byUser {
+		totals.add(t)
+	}
+
+	resp := &eventsv1.ListEventAttendanceResponse{
+		NextPageToken: next,
+		Totals:        totals.Proto(),
+	}
+	for _, a := range attendance {
+		resp.Attendance = append(resp.Attendance, a.Proto())
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,30 @@
+package events
+
+import (
+	"context"
+
+	eventsv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/events/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+func (s *Events) ListEventFeeds(ctx context.Context, req *eventsv1.ListEventFeedsRequest) (*eventsv1.ListEventFeedsResponse, error) {
+	feeds, err := gorm.G[EventsFeed](s.db.Db).
+		Where("user_id = ?", req.UserId).
+		Order("created_at DESC, id DESC").
+		Find(ctx)
+	if err != nil {
+		return &eventsv1.ListEventFeedsResponse{}, status.Error(
+			codes.Internal,
+			"failed to query feeds: "+err.Error(),
+		)
+	}
+
+	resp := &eventsv1.ListEventFeedsResponse{}
+	for _, feed := range feeds {
+		resp.Feeds = append(resp.Feeds, feed.Proto())
+	}
+
+	return resp, nil
+}
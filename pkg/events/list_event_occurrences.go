@@ -0,0 +1,47 @@
+package events
+
+import (
+	"context"
+
+	eventsv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/events/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+func (s *Events) ListEventOccurrences(ctx context.Context, req *eventsv1.ListEventOccurrencesRequest) (*eventsv1.ListEventOccurrencesResponse, error) {
+	from, to, err := window(req.StartTime, req.EndTime)
+	if err != nil {
+		return &eventsv1.ListEventOccurrencesResponse{}, err
+	}
+
+	unit, err := s.canViewUnit(ctx, req.UnitId)
+	if err != nil {
+		return &eventsv1.ListEventOccurrencesResponse{}, err
+	}
+
+	qb := gorm.G[EventsEvent](s.db.Replica).Where("unit_id = ?", req.UnitId)
+	if !unit {
+		qb = qb.Where("visibility = ?", int32(eventsv1.EventVisibility_EVENT_VISIBILITY_PUBLIC))
+	}
+
+	occurrences, err := s.occurrencesIn(ctx, qb, from, to)
+	if err != nil {
+		return &eventsv1.ListEventOccurrencesResponse{}, status.Error(
+			codes.Internal,
+			"failed to query events: "+err.Error(),
+		)
+	}
+
+	resp := &eventsv1.ListEventOccurrencesResponse{}
+	for _, o := range occurrences {
+		resp.Occurrences = append(resp.Occurrences, &eventsv1.EventOccurrence{
+			Event:          view(o.event, unit),
+			OccurrenceTime: timestamppb.New(o.time),
+			Overridden:     o.overridden,
+		})
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,92 @@
+package events
+
+import (
+	"context"
+	"errors"
+
+	"github.com/milsim-tools/pincer/internal/helpers"
+	eventsv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/events/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// responsesFilter translates the filters of ListEventResponses.
+var responsesFilter = helpers.MustNewFilter(&eventsv1.EventResponse{}, helpers.FilterColumns{
+	"user_id":    "user_id",
+	"status":     "status",
+	"updated_at": "updated_at",
+})
+
+func (s *Events) ListEventResponses(ctx context.Context, req *eventsv1.ListEventResponsesRequest) (*eventsv1.ListEventResponsesResponse, error) {
+	where, err := responsesFilter.Parse(req.Filter)
+	if err != nil {
+		return &eventsv1.ListEventResponsesResponse{}, err
+	}
+
+	event, err := s.event(ctx, req.UnitId, req.EventId)
+	if err != nil {
+		return &eventsv1.ListEventResponsesResponse{}, err
+	}
+
+	o, err := s.occurrence(ctx, event, req.OccurrenceTime, "occurrence_time")
+	if err != nil {
+		return &eventsv1.ListEventResponsesResponse{}, err
+	}
+
+	qb := gorm.G[EventsResponse](s.db.Replica).
+		Where("event_id = ? AND occurrence_time = ?", req.EventId, o.time)
+	if where != nil {
+		qb = qb.Where(where)
+	}
+
+	responses, next, err := helpers.Paginate(ctx, s.pages, qb, helpers.Page{
+		Size:    int(req.PageSize),
+		Token:   req.PageToken,
+		Filters: []any{req.EventId, o.time, req.Filter},
+	})
+	if err != nil {
+		if errors.Is(err, helpers.ErrInvalidPageToken) {
+			return &eventsv1.ListEventResponsesResponse{}, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return &eventsv1.ListEventResponsesResponse{}, status.Error(
+			codes.Internal,
+			"failed to query responses: "+err.Error(),
+		)
+	}
+
+	var counts []struct {
+		Status int32
+		Count  int32
+	}
+	if err := s.db.Replica.WithContext(ctx).
+		Model(&EventsResponse{}).
+		Select("status, COUNT(*) AS count").
+		Where("event_id = ? AND occurrence_time = ?", req.EventId, o.time).
+		Group("status").
+		Scan(&counts).Error; err != nil {
+		return &eventsv1.ListEventResponsesResponse{}, status.Error(
+			codes.Internal,
+			"failed to count responses: "+err.Error(),
+		)
+	}
+
+	resp := &eventsv1.ListEventResponsesResponse{
+		NextPageToken: next,
+	}
+	for _, response := range responses {
+		resp.Responses = append(resp.Responses, response.Proto())
+	}
+	for _, c := range counts {
+		switch eventsv1.EventResponseStatus(c.Status) {
+		case eventsv1.EventResponseStatus_EVENT_RESPONSE_STATUS_ATTENDING:
+			resp.Attending = c.Count
+		case eventsv1.EventResponseStatus_EVENT_RESPONSE_STATUS_MAYBE:
+			resp.Maybe = c.Count
+		case eventsv1.EventResponseStatus_EVENT_RESPONSE_STATUS_DECLINED:
+			resp.Declined = c.Count
+		}
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,89 @@
+package events
+
+import (
+	"context"
+	"errors"
+
+	"github.com/milsim-tools/pincer/internal/helpers"
+	eventsv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/events/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// orderByColumns are the fields events can be ordered by.
+var orderByColumns = helpers.OrderByColumns{
+	"start_time": "start_time",
+	"end_time":   "end_time",
+	"title":      "title",
+	"created_at": "created_at",
+}
+
+// defaultOrder lists events in the order they take place.
+var defaultOrder = []helpers.Order{{Column: "start_time"}}
+
+// filter translates the filters of ListEvents.
+var filter = helpers.MustNewFilter(&eventsv1.Event{}, helpers.FilterColumns{
+	"title":      "title",
+	"visibility": "visibility",
+	"start_time": "start_time",
+	"end_time":   "end_time",
+	"created_by": "created_by",
+	"created_at": "created_at",
+})
+
+func (s *Events) ListEvents(ctx context.Context, req *eventsv1.ListEventsRequest) (*eventsv1.ListEventsResponse, error) {
+	order, err := helpers.ParseOrderBy(req.OrderBy, orderByColumns)
+	if err != nil {
+		return &eventsv1.ListEventsResponse{}, err
+	}
+	if order == nil {
+		order = defaultOrder
+	}
+
+	where, err := filter.Parse(req.Filter)
+	if err != nil {
+		return &eventsv1.ListEventsResponse{}, err
+	}
+
+	unit, err := s.canViewUnit(ctx, req.UnitId)
+	if err != nil {
+		return &eventsv1.ListEventsResponse{}, err
+	}
+
+	qb := gorm.G[EventsEvent](s.db.Replica).Where("unit_id = ?", req.UnitId)
+	if where != nil {
+		qb = qb.Where(where)
+	}
+	if !unit {
+		qb = qb.Where("visibility = ?", int32(eventsv1.EventVisibility_EVENT_VISIBILITY_PUBLIC))
+	}
+
+	events, next, err := helpers.Paginate(ctx, s.pages, qb, helpers.Page{
+		Size:    int(req.PageSize),
+		Token:   req.PageToken,
+		Order:   order,
+		Filters: []any{req.UnitId, req.Filter, unit},
+	})
+	if err != nil {
+		if errors.Is(err, helpers.ErrInvalidPageToken) {
+			return &eventsv1.ListEventsResponse{}, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return &eventsv1.ListEventsResponse{}, status.Error(
+			codes.Internal,
+			"failed to query events: "+err.Error(),
+		)
+	}
+
+	var eventViews []*eventsv1.Event
+	for _, event := range events {
+		eventViews = append(eventViews, view(event, unit))
+	}
+
+	resp := &eventsv1.ListEventsResponse{
+		Events:        eventViews,
+		NextPageToken: next,
+	}
+
+	return resp, nil
+}
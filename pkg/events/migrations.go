@@ -0,0 +1,17 @@
+package events
+
+import (
+	"embed"
+
+	"github.com/milsim-tools/pincer/pkg/db/migrate"
+)
+
+//go:embed migrations/*.sql
+var migrationsFS embed.FS
+
+// Migrations are the schema migrations of the events module.
+var Migrations = migrate.Source{
+	Module: "events",
+	FS:     migrationsFS,
+	Dir:    "migrations",
+}
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS events_responses;
+DROP TABLE IF EXISTS events_events;
@@ -0,0 +1,33 @@
+CREATE TABLE IF NOT EXISTS events_events (
+  id text PRIMARY KEY,
+  created_at timestamptz,
+  updated_at timestamptz,
+  unit_id text NOT NULL,
+  title text NOT NULL,
+  briefing text NOT NULL,
+  start_time timestamptz NOT NULL,
+  end_time timestamptz NOT NULL,
+  time_zone text NOT NULL,
+  visibility integer NOT NULL DEFAULT 1,
+  server_game text NOT NULL,
+  server_address text NOT NULL,
+  server_port integer NOT NULL,
+  server_password text NOT NULL,
+  server_modset text NOT NULL,
+  created_by text NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_events_events_unit_id ON events_events (unit_id);
+
+CREATE TABLE IF NOT EXISTS events_responses (
+  id text PRIMARY KEY,
+  created_at timestamptz,
+  updated_at timestamptz,
+  event_id text NOT NULL,
+  user_id text NOT NULL,
+  status integer NOT NULL,
+  comment text NOT NULL
+);
+
+-- A user responds to an event at most once, responding again replaces it.
+CREATE UNIQUE INDEX IF NOT EXISTS idx_events_responses_event_user ON events_responses (event_id, user_id);
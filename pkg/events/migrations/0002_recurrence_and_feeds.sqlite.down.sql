@@ -0,0 +1,12 @@
+DROP TABLE IF EXISTS events_feeds;
+DROP TABLE IF EXISTS events_overrides;
+DROP INDEX IF EXISTS idx_events_events_unit_start_time;
+DROP INDEX IF EXISTS idx_events_responses_event_occurrence_user;
+ALTER TABLE events_responses DROP COLUMN occurrence_time;
+-- Keep a single response of each user to an event.
+DELETE FROM events_responses
+WHERE id NOT IN (SELECT MIN(id) FROM events_responses GROUP BY event_id, user_id);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_events_responses_event_user ON events_responses (event_id, user_id);
+ALTER TABLE events_events DROP COLUMN last_end_time;
+ALTER TABLE events_events DROP COLUMN exceptions;
+ALTER TABLE events_events DROP COLUMN recurrence;
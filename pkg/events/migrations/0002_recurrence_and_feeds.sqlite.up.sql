@@ -0,0 +1,40 @@
+ALTER TABLE events_events ADD COLUMN recurrence text NOT NULL DEFAULT '';
+ALTER TABLE events_events ADD COLUMN exceptions text NOT NULL DEFAULT '[]';
+ALTER TABLE events_events ADD COLUMN last_end_time datetime;
+UPDATE events_events SET last_end_time = end_time WHERE last_end_time IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_events_events_unit_start_time ON events_events (unit_id, start_time);
+
+-- Members respond to each occurrence of recurring events. Responses so far
+-- were to events that take place once, so to their start time.
+ALTER TABLE events_responses ADD COLUMN occurrence_time datetime;
+UPDATE events_responses SET occurrence_time = (
+  SELECT start_time FROM events_events WHERE events_events.id = events_responses.event_id
+);
+DROP INDEX IF EXISTS idx_events_responses_event_user;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_events_responses_event_occurrence_user ON events_responses (event_id, occurrence_time, user_id);
+
+CREATE TABLE IF NOT EXISTS events_overrides (
+  event_id text NOT NULL,
+  occurrence_time datetime NOT NULL,
+  title text,
+  briefing text,
+  start_time datetime,
+  end_time datetime,
+  created_at datetime,
+  updated_at datetime,
+  PRIMARY KEY (event_id, occurrence_time)
+);
+
+CREATE TABLE IF NOT EXISTS events_feeds (
+  id text PRIMARY KEY,
+  created_at datetime,
+  updated_at datetime,
+  user_id text NOT NULL,
+  unit_id text NOT NULL,
+  token_hash text NOT NULL,
+  last_used_at datetime
+);
+
+CREATE INDEX IF NOT EXISTS idx_events_feeds_user_id ON events_feeds (user_id);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_events_feeds_token_hash ON events_feeds (token_hash);
@@ -0,0 +1,41 @@
+ALTER TABLE events_events ADD COLUMN IF NOT EXISTS recurrence text NOT NULL DEFAULT '';
+ALTER TABLE events_events ADD COLUMN IF NOT EXISTS exceptions text NOT NULL DEFAULT '[]';
+ALTER TABLE events_events ADD COLUMN IF NOT EXISTS last_end_time timestamptz;
+UPDATE events_events SET last_end_time = end_time WHERE last_end_time IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_events_events_unit_start_time ON events_events (unit_id, start_time);
+
+-- Members respond to each occurrence of recurring events. Responses so far
+-- were to events that take place once, so to their start time.
+ALTER TABLE events_responses ADD COLUMN IF NOT EXISTS occurrence_time timestamptz;
+UPDATE events_responses SET occurrence_time = (
+  SELECT start_time FROM events_events WHERE events_events.id = events_responses.event_id
+);
+ALTER TABLE events_responses ALTER COLUMN occurrence_time SET NOT NULL;
+DROP INDEX IF EXISTS idx_events_responses_event_user;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_events_responses_event_occurrence_user ON events_responses (event_id, occurrence_time, user_id);
+
+CREATE TABLE IF NOT EXISTS events_overrides (
+  event_id text NOT NULL,
+  occurrence_time timestamptz NOT NULL,
+  title text,
+  briefing text,
+  start_time timestamptz,
+  end_time timestamptz,
+  created_at timestamptz,
+  updated_at timestamptz,
+  PRIMARY KEY (event_id, occurrence_time)
+);
+
+CREATE TABLE IF NOT EXISTS events_feeds (
+  id text PRIMARY KEY,
+  created_at timestamptz,
+  updated_at timestamptz,
+  user_id text NOT NULL,
+  unit_id text NOT NULL,
+  token_hash text NOT NULL,
+  last_used_at timestamptz
+);
+
+CREATE INDEX IF NOT EXISTS idx_events_feeds_user_id ON events_feeds (user_id);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_events_feeds_token_hash ON events_feeds (token_hash);
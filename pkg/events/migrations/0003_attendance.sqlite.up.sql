@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS events_attendances (
+  id text PRIMARY KEY,
+  created_at datetime,
+  updated_at datetime,
+  unit_id text NOT NULL,
+  event_id text NOT NULL,
+  occurrence_time datetime NOT NULL,
+  user_id text NOT NULL,
+  status integer NOT NULL,
+  note text NOT NULL DEFAULT '',
+  recorded_by text NOT NULL DEFAULT ''
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_events_attendances_event_occurrence_user ON events_attendances (event_id, occurrence_time, user_id);
+CREATE INDEX IF NOT EXISTS idx_events_attendances_unit_occurrence ON events_attendances (unit_id, occurrence_time);
@@ -0,0 +1,208 @@
+package events
+
+import (
+	"time"
+
+	"github.com/milsim-tools/pincer/internal/models"
+	eventsv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/events/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type EventsEvent struct {
+	models.Model
+
+	UnitID     string    `gorm:"notNull;index"`
+	Title      string    `gorm:"notNull"`
+	Briefing   string    `gorm:"notNull"`
+	StartTime  time.Time `gorm:"notNull"`
+	EndTime    time.Time `gorm:"notNull"`
+	TimeZone   string    `gorm:"notNull"`
+	Visibility int32     `gorm:"notNull;default:1"`
+	Server     Server    `gorm:"embedded;embeddedPrefix:server_"`
+	CreatedBy  string    `gorm:"notNull"`
+
+	// Recurrence is the recurrence rule of recurring events, and Exceptions
+	// the start times of their cancelled occurrences.
+	Recurrence string      `gorm:"notNull"`
+	Exceptions []time.Time `gorm:"serializer:json;notNull"`
+
+	// LastEndTime is the time the last occurrence of the event ends, or nil
+	// if the event recurs forever.
+	LastEndTime *time.Time
+}
+
+func (e EventsEvent) Proto() *eventsv1.Event {
+	return &eventsv1.Event{
+		Id:         e.ID,
+		UnitId:     e.UnitID,
+		Title:      e.Title,
+		Briefing:   e.Briefing,
+		StartTime:  timestamppb.New(e.StartTime),
+		EndTime:    timestamppb.New(e.EndTime),
+		TimeZone:   e.TimeZone,
+		Visibility: eventsv1.EventVisibility(e.Visibility),
+		Server:     e.Server.Proto(),
+		CreatedBy:  e.CreatedBy,
+		CreatedAt:  timestamppb.New(e.CreatedAt),
+		UpdatedAt:  timestamppb.New(e.UpdatedAt),
+		Recurrence: e.recurrence(),
+	}
+}
+
+// setRecurrence sets the recurrence of the event, which no longer recurs if
+// r is nil.
+func (e *EventsEvent) setRecurrence(r *eventsv1.EventRecurrence) {
+	e.Recurrence = r.GetRule()
+	e.Exceptions = make([]time.Time, 0, len(r.GetExceptions()))
+	for _, t := range r.GetExceptions() {
+		e.Exceptions = append(e.Exceptions, seconds(t))
+	}
+}
+
+func (e EventsEvent) recurrence() *eventsv1.EventRecurrence {
+	if e.Recurrence == "" {
+		return nil
+	}
+
+	exceptions := make([]*timestamppb.Timestamp, 0, len(e.Exceptions))
+	for _, t := range e.Exceptions {
+		exceptions = append(exceptions, timestamppb.New(t))
+	}
+
+	return &eventsv1.EventRecurrence{
+		Rule:       e.Recurrence,
+		Exceptions: exceptions,
+	}
+}
+
+// Server is the game server of an EventsEvent, stored in the event's server_
+// columns.
+type Server struct {
+	Game     string `gorm:"notNull"`
+	Address  string `gorm:"notNull"`
+	Port     uint32 `gorm:"notNull"`
+	Password string `gorm:"notNull"`
+	Modset   string `gorm:"notNull"`
+}
+
+func newServer(server *eventsv1.EventServer) Server {
+	return Server{
+		Game:     server.GetGame(),
+		Address:  server.GetAddress(),
+		Port:     server.GetPort(),
+		Password: server.GetPassword(),
+		Modset:   server.GetModset(),
+	}
+}
+
+func (s Server) Proto() *eventsv1.EventServer {
+	return &eventsv1.EventServer{
+		Game:     s.Game,
+		Address:  s.Address,
+		Port:     s.Port,
+		Password: s.Password,
+		Modset:   s.Modset,
+	}
+}
+
+type EventsResponse struct {
+	models.Model
+
+	EventID        string    `gorm:"notNull;uniqueIndex:idx_events_responses_event_occurrence_user"`
+	OccurrenceTime time.Time `gorm:"notNull;uniqueIndex:idx_events_responses_event_occurrence_user"`
+	UserID         string    `gorm:"notNull;uniqueIndex:idx_events_responses_event_occurrence_user"`
+	Status         int32     `gorm:"notNull"`
+	Comment        string    `gorm:"notNull"`
+}
+
+func (r EventsResponse) Proto() *eventsv1.EventResponse {
+	return &eventsv1.EventResponse{
+		EventId:        r.EventID,
+		UserId:         r.UserID,
+		Status:         eventsv1.EventResponseStatus(r.Status),
+		Comment:        r.Comment,
+		CreatedAt:      timestamppb.New(r.CreatedAt),
+		UpdatedAt:      timestamppb.New(r.UpdatedAt),
+		OccurrenceTime: timestamppb.New(r.OccurrenceTime),
+	}
+}
+
+// EventsAttendance is the attendance of a member at an occurrence of an
+// event. The unit of the event is kept alongside for reports.
+type EventsAttendance struct {
+	models.Model
+
+	UnitID         string    `gorm:"notNull;index:idx_events_attendances_unit_occurrence"`
+	EventID        string    `gorm:"notNull;uniqueIndex:idx_events_attendances_event_occurrence_user"`
+	OccurrenceTime time.Time `gorm:"notNull;uniqueIndex:idx_events_attendances_event_occurrence_user;index:idx_events_attendances_unit_occurrence"`
+	UserID         string    `gorm:"notNull;uniqueIndex:idx_events_attendances_event_occurrence_user"`
+	Status         int32     `gorm:"notNull"`
+	Note           string    `gorm:"notNull"`
+	RecordedBy     string    `gorm:"notNull"`
+}
+
+func (a EventsAttendance) Proto() *eventsv1.EventAttendance {
+	return &eventsv1.EventAttendance{
+		EventId:        a.EventID,
+		OccurrenceTime: timestamppb.New(a.OccurrenceTime),
+		UserId:         a.UserID,
+		Status:         eventsv1.AttendanceStatus(a.Status),
+		Note:           a.Note,
+		RecordedBy:     a.RecordedBy,
+		CreatedAt:      timestamppb.New(a.CreatedAt),
+		UpdatedAt:      timestamppb.New(a.UpdatedAt),
+	}
+}
+
+type EventsOverride struct {
+	EventID        string    `gorm:"primaryKey"`
+	OccurrenceTime time.Time `gorm:"primaryKey"`
+	Title          *string
+	Briefing       *string
+	StartTime      *time.Time
+	EndTime        *time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+func (o EventsOverride) Proto() *eventsv1.EventOccurrenceOverride {
+	override := &eventsv1.EventOccurrenceOverride{
+		EventId:        o.EventID,
+		OccurrenceTime: timestamppb.New(o.OccurrenceTime),
+		Title:          o.Title,
+		Briefing:       o.Briefing,
+		CreatedAt:      timestamppb.New(o.CreatedAt),
+		UpdatedAt:      timestamppb.New(o.UpdatedAt),
+	}
+	if o.StartTime != nil {
+		override.StartTime = timestamppb.New(*o.StartTime)
+	}
+	if o.EndTime != nil {
+		override.EndTime = timestamppb.New(*o.EndTime)
+	}
+
+	return override
+}
+
+type EventsFeed struct {
+	models.Model
+
+	UserID     string `gorm:"notNull;index"`
+	UnitID     string `gorm:"notNull"`
+	TokenHash  string `gorm:"notNull;uniqueIndex"`
+	LastUsedAt *time.Time
+}
+
+func (f EventsFeed) Proto() *eventsv1.EventFeed {
+	feed := &eventsv1.EventFeed{
+		Id:        f.ID,
+		UserId:    f.UserID,
+		UnitId:    f.UnitID,
+		CreatedAt: timestamppb.New(f.CreatedAt),
+	}
+	if f.LastUsedAt != nil {
+		feed.LastUsedAt = timestamppb.New(*f.LastUsedAt)
+	}
+
+	return feed
+}
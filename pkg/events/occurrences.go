@@ -0,0 +1,117 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/milsim-tools/pincer/internal/helpers"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+// occurrence returns the occurrence of the event starting at the time, as
+// given by its recurrence, read from the request field. Events that take
+// place once have a single occurrence, at their start time, which the field
+// may leave out.
+func (s *Events) occurrence(ctx context.Context, event EventsEvent, ts *timestamppb.Timestamp, field string) (occurrence, error) {
+	if event.Recurrence == "" {
+		if ts != nil && !seconds(ts).Equal(event.StartTime) {
+			return occurrence{}, helpers.InvalidArgument(field, "the event takes place once, at its start_time")
+		}
+		return occurrence{event: event, time: event.StartTime}, nil
+	}
+
+	if ts == nil {
+		return occurrence{}, helpers.InvalidArgument(field, "value is required for recurring events")
+	}
+	t := seconds(ts)
+	if !isOccurrence(event, t) || slices.ContainsFunc(event.Exceptions, t.Equal) {
+		return occurrence{}, helpers.InvalidArgument(field, "the event has no occurrence starting at "+t.Format(time.RFC3339))
+	}
+
+	duration := event.EndTime.Sub(event.StartTime)
+	o := occurrence{event: event, time: t}
+	o.event.StartTime = t
+	o.event.EndTime = t.Add(duration)
+
+	override, err := gorm.G[EventsOverride](s.db.Db).
+		Where("event_id = ? AND occurrence_time = ?", event.ID, t).
+		First(ctx)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return o, nil
+		}
+		return occurrence{}, status.Error(
+			codes.Internal,
+			"failed to query occurrence: "+err.Error(),
+		)
+	}
+	override.apply(&o.event, duration)
+	o.overridden = true
+
+	return o, nil
+}
+
+// occurrencesIn returns the occurrences of the events of the query that end
+// after from and start before to, in the order they start.
+func (s *Events) occurrencesIn(ctx context.Context, qb gorm.ChainInterface[EventsEvent], from, to time.Time) ([]occurrence, error) {
+	// Events whose occurrences were moved may have occurrences in the window
+	// that their own times do not tell of.
+	moved := s.db.Db.Model(&EventsOverride{}).
+		Select("event_id").
+		Where("start_time IS NOT NULL OR end_time IS NOT NULL")
+
+	events, err := qb.
+		Where("(start_time < ? AND (last_end_time IS NULL OR last_end_time > ?)) OR id IN (?)", to, from, moved).
+		Find(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(events))
+	for _, event := range events {
+		if event.Recurrence != "" {
+			ids = append(ids, event.ID)
+		}
+	}
+
+	byEvent := map[string][]EventsOverride{}
+	if len(ids) > 0 {
+		overrides, err := gorm.G[EventsOverride](s.db.Db).Where("event_id IN ?", ids).Find(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, o := range overrides {
+			byEvent[o.EventID] = append(byEvent[o.EventID], o)
+		}
+	}
+
+	var occurrences []occurrence
+	for _, event := range events {
+		occurrences = append(occurrences, expand(event, byEvent[event.ID], from, to)...)
+	}
+
+	slices.SortStableFunc(occurrences, func(a, b occurrence) int {
+		return a.event.StartTime.Compare(b.event.StartTime)
+	})
+
+	return occurrences, nil
+}
+
+// window returns the window between the start and end times of a request,
+// which spans at most maxWindow.
+func window(start, end *timestamppb.Timestamp) (time.Time, time.Time, error) {
+	from, to := start.AsTime(), end.AsTime()
+	if !to.After(from) {
+		return time.Time{}, time.Time{}, helpers.InvalidArgument("end_time", "the window must end after it starts")
+	}
+	if to.Sub(from) > maxWindow {
+		return time.Time{}, time.Time{}, helpers.InvalidArgument("end_time", "the window spans more than 366 days")
+	}
+
+	return from, to, nil
+}
@@ -0,0 +1,81 @@
+package events
+
+import (
+	"context"
+	"time"
+
+	"github.com/milsim-tools/pincer/internal/helpers"
+	eventsv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/events/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func (s *Events) OverrideEventOccurrence(ctx context.Context, req *eventsv1.OverrideEventOccurrenceRequest) (*eventsv1.EventOccurrenceOverride, error) {
+	event, err := s.event(ctx, req.UnitId, req.Override.EventId)
+	if err != nil {
+		return &eventsv1.EventOccurrenceOverride{}, err
+	}
+	if event.Recurrence == "" {
+		return &eventsv1.EventOccurrenceOverride{}, status.Error(
+			codes.FailedPrecondition,
+			"the event takes place once, update the event instead",
+		)
+	}
+
+	o, err := s.occurrence(ctx, event, req.Override.OccurrenceTime, "override.occurrence_time")
+	if err != nil {
+		return &eventsv1.EventOccurrenceOverride{}, err
+	}
+
+	now := time.Now()
+	override := &EventsOverride{
+		EventID:        event.ID,
+		OccurrenceTime: o.time,
+		Title:          req.Override.Title,
+		Briefing:       req.Override.Briefing,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+	if req.Override.StartTime != nil {
+		start := seconds(req.Override.StartTime)
+		override.StartTime = &start
+	}
+	if req.Override.EndTime != nil {
+		end := seconds(req.Override.EndTime)
+		override.EndTime = &end
+	}
+
+	duration := event.EndTime.Sub(event.StartTime)
+	changed := event
+	changed.StartTime = o.time
+	changed.EndTime = o.time.Add(duration)
+	override.apply(&changed, duration)
+	if !changed.EndTime.After(changed.StartTime) {
+		return &eventsv1.EventOccurrenceOverride{}, helpers.InvalidArgument("override.end_time", "end_time must be after start_time")
+	}
+
+	// Overriding again replaces the previous changes to the occurrence.
+	if err := gorm.G[EventsOverride](s.db.Db, clause.OnConflict{
+		Columns:   []clause.Column{{Name: "event_id"}, {Name: "occurrence_time"}},
+		DoUpdates: clause.AssignmentColumns([]string{"title", "briefing", "start_time", "end_time", "updated_at"}),
+	}).Create(ctx, override); err != nil {
+		return &eventsv1.EventOccurrenceOverride{}, status.Error(
+			codes.Internal,
+			"failed to save occurrence: "+err.Error(),
+		)
+	}
+
+	saved, err := gorm.G[EventsOverride](s.db.Db).
+		Where("event_id = ? AND occurrence_time = ?", event.ID, o.time).
+		First(ctx)
+	if err != nil {
+		return &eventsv1.EventOccurrenceOverride{}, status.Error(
+			codes.Internal,
+			"failed to query occurrence: "+err.Error(),
+		)
+	}
+
+	return saved.Proto(), nil
+}
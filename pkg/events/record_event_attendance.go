@@ -0,0 +1,112 @@
+package events
+
+import (
+	"context"
+	"time"
+
+	"github.com/milsim-tools/pincer/internal/helpers"
+	"github.com/milsim-tools/pincer/internal/models"
+	eventsv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/events/v1"
+	"github.com/milsim-tools/pincer/pkg/authz"
+	"github.com/oklog/ulid/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func (s *Events) RecordEventAttendance(ctx context.Context, req *eventsv1.RecordEventAttendanceRequest) (*eventsv1.RecordEventAttendanceResponse, error) {
+	event, err := s.event(ctx, req.UnitId, req.EventId)
+	if err != nil {
+		return &eventsv1.RecordEventAttendanceResponse{}, err
+	}
+
+	o, err := s.occurrence(ctx, event, req.OccurrenceTime, "occurrence_time")
+	if err != nil {
+		return &eventsv1.RecordEventAttendanceResponse{}, err
+	}
+
+	now := time.Now()
+	if o.event.StartTime.After(now) {
+		return &eventsv1.RecordEventAttendanceResponse{}, status.Error(
+			codes.FailedPrecondition,
+			"the event has not started",
+		)
+	}
+
+	users := make([]string, 0, len(req.Records))
+	seen := map[string]bool{}
+	for _, record := range req.Records {
+		if seen[record.UserId] {
+			return &eventsv1.RecordEventAttendanceResponse{}, helpers.InvalidArgument("records", "the user "+record.UserId+" has more than one record")
+		}
+		seen[record.UserId] = true
+		users = append(users, record.UserId)
+
+		// Attendance is taken of the members expected at events, the same as
+		// those who can respond to them.
+		can, err := s.authorizer.HasUnitPermission(ctx, req.UnitId, record.UserId, authz.PermissionRespondEvents)
+		if err != nil {
+			return &eventsv1.RecordEventAttendanceResponse{}, err
+		}
+		if !can {
+			return &eventsv1.RecordEventAttendanceResponse{}, status.Error(
+				codes.FailedPrecondition,
+				"the user "+record.UserId+" cannot respond to the unit's events",
+			)
+		}
+	}
+
+	attendance := make([]EventsAttendance, 0, len(req.Records))
+	for _, record := range req.Records {
+		attendance = append(attendance, EventsAttendance{
+			Model: models.Model{
+				ID:        ulid.Make().String(),
+				CreatedAt: now,
+				UpdatedAt: now,
+			},
+			UnitID:         req.UnitId,
+			EventID:        req.EventId,
+			OccurrenceTime: o.time,
+			UserID:         record.UserId,
+			Status:         int32(record.Status),
+			Note:           record.Note,
+			RecordedBy:     caller(ctx),
+		})
+	}
+
+	// Recording again replaces the members' attendance.
+	if err := gorm.G[EventsAttendance](s.db.Db, clause.OnConflict{
+		Columns:   []clause.Column{{Name: "event_id"}, {Name: "occurrence_time"}, {Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"status", "note", "recorded_by", "updated_at"}),
+	}).CreateInBatches(ctx, &attendance, 100); err != nil {
+		return &eventsv1.RecordEventAttendanceResponse{}, status.Error(
+			codes.Internal,
+			"failed to save attendance: "+err.Error(),
+		)
+	}
+
+	// The attendance is read back, as it keeps the ID and creation time of
+	// the first record of each member.
+	saved, err := gorm.G[EventsAttendance](s.db.Db).
+		Where("event_id = ? AND occurrence_time = ? AND user_id IN ?", req.EventId, o.time, users).
+		Find(ctx)
+	if err != nil {
+		return &eventsv1.RecordEventAttendanceResponse{}, status.Error(
+			codes.Internal,
+			"failed to query attendance: "+err.Error(),
+		)
+	}
+
+	byUser := make(map[string]EventsAttendance, len(saved))
+	for _, a := range saved {
+		byUser[a.UserID] = a
+	}
+
+	resp := &eventsv1.RecordEventAttendanceResponse{}
+	for _, user := range users {
+		resp.Attendance = append(resp.Attendance, byUser[user].Proto())
+	}
+
+	return resp, nil
+}
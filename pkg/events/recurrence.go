@@ -0,0 +1,194 @@
+package events
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/milsim-tools/pincer/internal/helpers"
+	"github.com/teambition/rrule-go"
+)
+
+const (
+	// maxOccurrences bounds the occurrences of recurrence rules that end, and
+	// the occurrences of an event expanded at once.
+	maxOccurrences = 1000
+
+	// maxWindow bounds the windows occurrences are listed in.
+	maxWindow = 366 * 24 * time.Hour
+)
+
+// rule returns the recurrence rule of a recurring event, which repeats the
+// event's start time in its time zone.
+func rule(event EventsEvent) (*rrule.RRule, error) {
+	loc, err := time.LoadLocation(event.TimeZone)
+	if err != nil {
+		return nil, err
+	}
+
+	opt, err := rrule.StrToROptionInLocation(event.Recurrence, loc)
+	if err != nil {
+		return nil, err
+	}
+	if !opt.Dtstart.IsZero() {
+		return nil, errors.New("DTSTART is taken from the start_time of the event")
+	}
+	// Without these, an event occurs at most once a day, at its start time.
+	if opt.Freq > rrule.DAILY || len(opt.Byhour) > 0 || len(opt.Byminute) > 0 || len(opt.Bysecond) > 0 {
+		return nil, errors.New("events repeat at most daily, at their start time")
+	}
+	opt.Dtstart = event.StartTime.In(loc)
+
+	return rrule.NewRRule(*opt)
+}
+
+// schedule validates the recurrence of the event and sets the end of its last
+// occurrence.
+func schedule(event *EventsEvent) error {
+	if event.Recurrence == "" {
+		event.Exceptions = []time.Time{}
+		event.LastEndTime = &event.EndTime
+		return nil
+	}
+
+	r, err := rule(*event)
+	if err != nil {
+		return helpers.InvalidArgument("event.recurrence.rule", err.Error())
+	}
+
+	event.LastEndTime = nil
+	if r.OrigOptions.Count == 0 && r.OrigOptions.Until.IsZero() {
+		return nil
+	}
+
+	var last time.Time
+	next := r.Iterator()
+	for n := 0; ; n++ {
+		t, ok := next()
+		if !ok {
+			break
+		}
+		if n == maxOccurrences {
+			return helpers.InvalidArgument("event.recurrence.rule", fmt.Sprintf("the rule has more than %d occurrences", maxOccurrences))
+		}
+		last = t
+	}
+	if last.IsZero() {
+		return helpers.InvalidArgument("event.recurrence.rule", "the rule has no occurrences")
+	}
+
+	end := last.Add(event.EndTime.Sub(event.StartTime)).UTC()
+	event.LastEndTime = &end
+	return nil
+}
+
+// occurrence is an occurrence of an event.
+type occurrence struct {
+	// event is the event, with the title, briefing and times of the
+	// occurrence.
+	event EventsEvent
+
+	// time is the start time of the occurrence as given by the recurrence.
+	time time.Time
+
+	overridden bool
+}
+
+// expand returns the occurrences of the event that end after from and start
+// before to, in the order they start, with the overrides of the event applied.
+// At most maxOccurrences are returned.
+func expand(event EventsEvent, overrides []EventsOverride, from, to time.Time) []occurrence {
+	if event.Recurrence == "" {
+		if event.EndTime.After(from) && event.StartTime.Before(to) {
+			return []occurrence{{event: event, time: event.StartTime}}
+		}
+		return nil
+	}
+
+	r, err := rule(event)
+	if err != nil {
+		// Rules are validated when they are saved.
+		return nil
+	}
+
+	duration := event.EndTime.Sub(event.StartTime)
+	times := r.Between(from.Add(-duration), to, true)
+
+	// Occurrences moved into the window from outside it.
+	byTime := make(map[int64]EventsOverride, len(overrides))
+	for _, o := range overrides {
+		byTime[o.OccurrenceTime.Unix()] = o
+		if o.StartTime == nil && o.EndTime == nil {
+			continue
+		}
+		if !slices.ContainsFunc(times, o.OccurrenceTime.Equal) && len(r.Between(o.OccurrenceTime, o.OccurrenceTime, true)) == 1 {
+			times = append(times, o.OccurrenceTime)
+		}
+	}
+
+	var occurrences []occurrence
+	for _, t := range times {
+		t = t.UTC()
+		if slices.ContainsFunc(event.Exceptions, t.Equal) {
+			continue
+		}
+
+		o := occurrence{event: event, time: t}
+		o.event.StartTime = t
+		o.event.EndTime = t.Add(duration)
+		if override, ok := byTime[t.Unix()]; ok {
+			override.apply(&o.event, duration)
+			o.overridden = true
+		}
+
+		if o.event.EndTime.After(from) && o.event.StartTime.Before(to) {
+			occurrences = append(occurrences, o)
+		}
+	}
+
+	slices.SortFunc(occurrences, func(a, b occurrence) int {
+		return a.event.StartTime.Compare(b.event.StartTime)
+	})
+	if len(occurrences) > maxOccurrences {
+		occurrences = occurrences[:maxOccurrences]
+	}
+
+	return occurrences
+}
+
+// isOccurrence reports whether the event has an occurrence starting at t, by
+// its recurrence.
+func isOccurrence(event EventsEvent, t time.Time) bool {
+	r, err := rule(event)
+	if err != nil {
+		return false
+	}
+	return len(r.Between(t, t, true)) == 1
+}
+
+// takesPlace reports whether the event takes place at t, either as its only
+// occurrence or as an occurrence of its recurrence that is not cancelled.
+func takesPlace(event EventsEvent, t time.Time) bool {
+	if event.Recurrence == "" {
+		return t.Equal(event.StartTime)
+	}
+	return isOccurrence(event, t) && !slices.ContainsFunc(event.Exceptions, t.Equal)
+}
+
+// apply changes the occurrence of an event lasting duration by the override.
+func (o EventsOverride) apply(event *EventsEvent, duration time.Duration) {
+	if o.Title != nil {
+		event.Title = *o.Title
+	}
+	if o.Briefing != nil {
+		event.Briefing = *o.Briefing
+	}
+	if o.StartTime != nil {
+		event.StartTime = *o.StartTime
+		event.EndTime = o.StartTime.Add(duration)
+	}
+	if o.EndTime != nil {
+		event.EndTime = *o.EndTime
+	}
+}
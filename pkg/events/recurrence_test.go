@@ -0,0 +1,419 @@
+package events
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// monday is the start of the events of the tests, a Monday evening.
+var monday = time.Date(2026, 1, 5, 19, 0, 0, 0, time.UTC)
+
+// testEvent returns a two hour event starting on monday, recurring by the
+// rule.
+func testEvent(rule string) EventsEvent {
+	return EventsEvent{
+		Title:      "Operation",
+		StartTime:  monday,
+		EndTime:    monday.Add(2 * time.Hour),
+		TimeZone:   "UTC",
+		Recurrence: rule,
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+// week returns the time n weeks after monday.
+func week(n int) time.Time {
+	return monday.AddDate(0, 0, 7*n)
+}
+
+func TestExpand(t *testing.T) {
+	london := testEvent("FREQ=WEEKLY;COUNT=3")
+	london.TimeZone = "Europe/London"
+	london.StartTime = time.Date(2026, 3, 16, 19, 0, 0, 0, time.UTC)
+	london.EndTime = london.StartTime.Add(2 * time.Hour)
+
+	tests := []struct {
+		name      string
+		event     EventsEvent
+		overrides []EventsOverride
+		from, to  time.Time
+		want      []string
+	}{
+		{
+			name:  "one-off",
+			event: testEvent(""),
+			from:  monday.Add(-time.Hour),
+			to:    monday.Add(time.Hour),
+			want:  []string{"2026-01-05T19:00:00Z-21:00 Operation"},
+		},
+		{
+			name:  "one-off ended",
+			event: testEvent(""),
+			from:  monday.Add(2 * time.Hour),
+			to:    week(1),
+		},
+		{
+			name:  "one-off in progress",
+			event: testEvent(""),
+			from:  monday.Add(time.Hour),
+			to:    week(1),
+			want:  []string{"2026-01-05T19:00:00Z-21:00 Operation"},
+		},
+		{
+			name:  "weekly",
+			event: testEvent("FREQ=WEEKLY"),
+			from:  monday,
+			to:    week(3),
+			want: []string{
+				"2026-01-05T19:00:00Z-21:00 Operation",
+				"2026-01-12T19:00:00Z-21:00 Operation",
+				"2026-01-19T19:00:00Z-21:00 Operation",
+			},
+		},
+		{
+			name:  "weekly in progress",
+			event: testEvent("FREQ=WEEKLY"),
+			from:  week(1).Add(time.Hour),
+			to:    week(2),
+			want:  []string{"2026-01-12T19:00:00Z-21:00 Operation"},
+		},
+		{
+			name:  "count",
+			event: testEvent("FREQ=WEEKLY;COUNT=2"),
+			from:  monday,
+			to:    week(5),
+			want: []string{
+				"2026-01-05T19:00:00Z-21:00 Operation",
+				"2026-01-12T19:00:00Z-21:00 Operation",
+			},
+		},
+		{
+			name: "exceptions",
+			event: func() EventsEvent {
+				e := testEvent("FREQ=WEEKLY")
+				e.Exceptions = []time.Time{week(1), week(1).Add(time.Hour)}
+				return e
+			}(),
+			from: monday,
+			to:   week(3),
+			want: []string{
+				"2026-01-05T19:00:00Z-21:00 Operation",
+				"2026-01-19T19:00:00Z-21:00 Operation",
+			},
+		},
+		{
+			name:  "overridden",
+			event: testEvent("FREQ=WEEKLY"),
+			overrides: []EventsOverride{
+				{OccurrenceTime: week(1), Title: ptr("Night operation"), EndTime: ptr(week(1).Add(4 * time.Hour))},
+			},
+			from: monday,
+			to:   week(2),
+			want: []string{
+				"2026-01-05T19:00:00Z-21:00 Operation",
+				"2026-01-12T19:00:00Z-23:00 Night operation*",
+			},
+		},
+		{
+			name:  "moved later",
+			event: testEvent("FREQ=WEEKLY"),
+			overrides: []EventsOverride{
+				{OccurrenceTime: week(1), StartTime: ptr(week(1).Add(24 * time.Hour))},
+			},
+			from: monday,
+			to:   week(2),
+			want: []string{
+				"2026-01-05T19:00:00Z-21:00 Operation",
+				"2026-01-13T19:00:00Z-21:00 Operation*",
+			},
+		},
+		{
+			name:  "moved before an earlier occurrence",
+			event: testEvent("FREQ=WEEKLY"),
+			overrides: []EventsOverride{
+				{OccurrenceTime: week(1), StartTime: ptr(monday.Add(-24 * time.Hour))},
+			},
+			from: monday.Add(-48 * time.Hour),
+			to:   week(2),
+			want: []string{
+				"2026-01-04T19:00:00Z-21:00 Operation*",
+				"2026-01-05T19:00:00Z-21:00 Operation",
+			},
+		},
+		{
+			name:  "moved into the window",
+			event: testEvent("FREQ=WEEKLY"),
+			overrides: []EventsOverride{
+				{OccurrenceTime: week(4), StartTime: ptr(week(1).Add(24 * time.Hour))},
+			},
+			from: week(1),
+			to:   week(2),
+			want: []string{
+				"2026-01-12T19:00:00Z-21:00 Operation",
+				"2026-01-13T19:00:00Z-21:00 Operation*",
+			},
+		},
+		{
+			name:  "moved out of the window",
+			event: testEvent("FREQ=WEEKLY"),
+			overrides: []EventsOverride{
+				{OccurrenceTime: week(1), StartTime: ptr(week(4))},
+			},
+			from: week(1),
+			to:   week(2),
+		},
+		{
+			name: "moved and cancelled",
+			event: func() EventsEvent {
+				e := testEvent("FREQ=WEEKLY")
+				e.Exceptions = []time.Time{week(4)}
+				return e
+			}(),
+			overrides: []EventsOverride{
+				{OccurrenceTime: week(4), StartTime: ptr(week(1).Add(24 * time.Hour))},
+			},
+			from: week(1),
+			to:   week(2),
+			want: []string{"2026-01-12T19:00:00Z-21:00 Operation"},
+		},
+		{
+			name:  "override of no occurrence",
+			event: testEvent("FREQ=WEEKLY;COUNT=2"),
+			overrides: []EventsOverride{
+				{OccurrenceTime: week(1).Add(time.Hour), StartTime: ptr(week(1).Add(24 * time.Hour))},
+				{OccurrenceTime: week(3), StartTime: ptr(week(1).Add(48 * time.Hour))},
+			},
+			from: monday,
+			to:   week(5),
+			want: []string{
+				"2026-01-05T19:00:00Z-21:00 Operation",
+				"2026-01-12T19:00:00Z-21:00 Operation",
+			},
+		},
+		{
+			name:  "local time",
+			event: london,
+			from:  london.StartTime,
+			to:    london.StartTime.AddDate(0, 0, 21),
+			want: []string{
+				"2026-03-16T19:00:00Z-21:00 Operation",
+				"2026-03-23T19:00:00Z-21:00 Operation",
+				"2026-03-30T18:00:00Z-20:00 Operation",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			occurrences := expand(tt.event, tt.overrides, tt.from, tt.to)
+
+			var got []string
+			for _, o := range occurrences {
+				got = append(got, describe(o))
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("expand() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// describe summarises an occurrence as its times and title, marking
+// overridden occurrences.
+func describe(o occurrence) string {
+	s := fmt.Sprintf("%s-%s %s", o.event.StartTime.UTC().Format(time.RFC3339), o.event.EndTime.UTC().Format("15:04"), o.event.Title)
+	if o.overridden {
+		s += "*"
+	}
+	return s
+}
+
+func TestExpandLimit(t *testing.T) {
+	event := testEvent("FREQ=DAILY")
+	from := monday
+	to := monday.AddDate(3, 0, 0)
+
+	occurrences := expand(event, []EventsOverride{
+		// An occurrence past the limit moved before the first one, which
+		// must be kept over the last of the limit.
+		{OccurrenceTime: monday.AddDate(0, 0, maxOccurrences+50), StartTime: ptr(monday.Add(-time.Hour))},
+	}, from, to)
+
+	if len(occurrences) != maxOccurrences {
+		t.Fatalf("expand() returned %d occurrences, want %d", len(occurrences), maxOccurrences)
+	}
+	if first := occurrences[0]; !first.overridden || !first.event.StartTime.Equal(monday.Add(-time.Hour)) {
+		t.Errorf("first occurrence = %s, want the moved occurrence", describe(first))
+	}
+	if last, want := occurrences[len(occurrences)-1].event.StartTime, monday.AddDate(0, 0, maxOccurrences-2); !last.Equal(want) {
+		t.Errorf("last occurrence starts at %s, want %s", last, want)
+	}
+}
+
+func TestSchedule(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    string
+		want    *time.Time
+		wantErr bool
+	}{
+		{name: "one-off", want: ptr(monday.Add(2 * time.Hour))},
+		{name: "forever", rule: "FREQ=WEEKLY"},
+		{name: "count", rule: "FREQ=WEEKLY;COUNT=3", want: ptr(week(2).Add(2 * time.Hour))},
+		{name: "until", rule: "FREQ=WEEKLY;UNTIL=20260126T000000Z", want: ptr(week(2).Add(2 * time.Hour))},
+		{name: "until first occurrence", rule: "FREQ=DAILY;UNTIL=20260105T190000Z", want: ptr(monday.Add(2 * time.Hour))},
+		{name: "interval", rule: "FREQ=DAILY;INTERVAL=2;COUNT=3", want: ptr(monday.AddDate(0, 0, 4).Add(2 * time.Hour))},
+		{name: "by day", rule: "FREQ=WEEKLY;BYDAY=MO,WE;COUNT=3", want: ptr(week(1).Add(2 * time.Hour))},
+		{name: "limit", rule: fmt.Sprintf("FREQ=DAILY;COUNT=%d", maxOccurrences), want: ptr(monday.AddDate(0, 0, maxOccurrences-1).Add(2 * time.Hour))},
+		{name: "over the limit", rule: fmt.Sprintf("FREQ=DAILY;COUNT=%d", maxOccurrences+1), wantErr: true},
+		{name: "until over the limit", rule: "FREQ=DAILY;UNTIL=20300101T000000Z", wantErr: true},
+		{name: "no occurrences", rule: "FREQ=WEEKLY;UNTIL=20260101T000000Z", wantErr: true},
+		{name: "hourly", rule: "FREQ=HOURLY;COUNT=3", wantErr: true},
+		{name: "by hour", rule: "FREQ=DAILY;BYHOUR=9,19", wantErr: true},
+		{name: "by minute", rule: "FREQ=DAILY;BYMINUTE=30", wantErr: true},
+		{name: "start", rule: "DTSTART:20260105T190000Z\nRRULE:FREQ=WEEKLY", wantErr: true},
+		{name: "invalid", rule: "FREQ=FORTNIGHTLY", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := testEvent(tt.rule)
+			event.LastEndTime = ptr(time.Time{})
+
+			err := schedule(&event)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("schedule() set the last end time to %v, want an error", event.LastEndTime)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("schedule(): %v", err)
+			}
+			if !reflect.DeepEqual(event.LastEndTime, tt.want) {
+				t.Errorf("schedule() set the last end time to %v, want %v", deref(event.LastEndTime), deref(tt.want))
+			}
+		})
+	}
+}
+
+func deref(t *time.Time) any {
+	if t == nil {
+		return nil
+	}
+	return *t
+}
+
+func TestTakesPlace(t *testing.T) {
+	event := testEvent("FREQ=WEEKLY;COUNT=3")
+	event.Exceptions = []time.Time{week(1)}
+
+	tests := []struct {
+		name  string
+		event EventsEvent
+		t     time.Time
+		want  bool
+	}{
+		{name: "one-off", event: testEvent(""), t: monday, want: true},
+		{name: "one-off at another time", event: testEvent(""), t: week(1)},
+		{name: "first occurrence", event: event, t: monday, want: true},
+		{name: "last occurrence", event: event, t: week(2), want: true},
+		{name: "cancelled", event: event, t: week(1)},
+		{name: "after the last", event: event, t: week(3)},
+		{name: "between occurrences", event: event, t: monday.Add(24 * time.Hour)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := takesPlace(tt.event, tt.t); got != tt.want {
+				t.Errorf("takesPlace(%s) = %v, want %v", tt.t, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestShift(t *testing.T) {
+	weekly := testEvent("FREQ=WEEKLY;COUNT=3")
+
+	moved := func(event EventsEvent, d time.Duration) EventsEvent {
+		event.StartTime = event.StartTime.Add(d)
+		event.EndTime = event.EndTime.Add(d)
+		return event
+	}
+	other := func(event EventsEvent, rule, zone string) EventsEvent {
+		event.Recurrence, event.TimeZone = rule, zone
+		return event
+	}
+
+	type mapping struct {
+		from time.Time
+		to   time.Time
+		ok   bool
+	}
+	tests := []struct {
+		name      string
+		previous  EventsEvent
+		event     EventsEvent
+		unshifted bool
+		want      []mapping
+	}{
+		{name: "unchanged", previous: weekly, event: weekly, unshifted: true},
+		{name: "other end", previous: weekly, event: func() EventsEvent { e := weekly; e.EndTime = e.EndTime.Add(time.Hour); return e }(), unshifted: true},
+		{
+			name:     "one-off",
+			previous: testEvent(""),
+			event:    moved(testEvent(""), time.Hour),
+			want: []mapping{
+				{from: monday, to: monday.Add(time.Hour), ok: true},
+				{from: week(1)},
+			},
+		},
+		{
+			name:     "later",
+			previous: weekly,
+			event:    moved(weekly, 24*time.Hour),
+			want: []mapping{
+				{from: monday, to: monday.Add(24 * time.Hour), ok: true},
+				{from: week(2), to: week(2).Add(24 * time.Hour), ok: true},
+				{from: week(3)},
+				{from: monday.Add(time.Hour)},
+			},
+		},
+		{
+			name:     "by occurrence",
+			previous: weekly,
+			event:    moved(weekly, -7*24*time.Hour),
+			want: []mapping{
+				{from: monday, to: week(-1), ok: true},
+				{from: week(1), to: monday, ok: true},
+			},
+		},
+		{name: "made recurring", previous: testEvent(""), event: moved(weekly, time.Hour), unshifted: true},
+		{name: "made one-off", previous: weekly, event: moved(testEvent(""), time.Hour), unshifted: true},
+		{name: "other rule", previous: weekly, event: moved(other(weekly, "FREQ=DAILY;COUNT=3", "UTC"), time.Hour), unshifted: true},
+		{name: "other time zone", previous: weekly, event: moved(other(weekly, weekly.Recurrence, "Europe/London"), time.Hour), unshifted: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			move := shift(tt.previous, tt.event)
+			if tt.unshifted {
+				if move != nil {
+					t.Fatal("shift() mapped occurrences, want nil")
+				}
+				return
+			}
+			if move == nil {
+				t.Fatal("shift() = nil, want a mapping")
+			}
+
+			for _, m := range tt.want {
+				to, ok := move(m.from)
+				if ok != m.ok || (ok && !to.Equal(m.to)) {
+					t.Errorf("shift()(%s) = %s, %v, want %s, %v", m.from, to, ok, m.to, m.ok)
+				}
+			}
+		})
+	}
+}
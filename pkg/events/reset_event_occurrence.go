@@ -0,0 +1,28 @@
+package events
+
+import (
+	"context"
+
+	eventsv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/events/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"gorm.io/gorm"
+)
+
+func (s *Events) ResetEventOccurrence(ctx context.Context, req *eventsv1.ResetEventOccurrenceRequest) (*emptypb.Empty, error) {
+	if _, err := s.event(ctx, req.UnitId, req.EventId); err != nil {
+		return &emptypb.Empty{}, err
+	}
+
+	if _, err := gorm.G[EventsOverride](s.db.Db).
+		Where("event_id = ? AND occurrence_time = ?", req.EventId, seconds(req.OccurrenceTime)).
+		Delete(ctx); err != nil {
+		return &emptypb.Empty{}, status.Error(
+			codes.Internal,
+			"failed to reset occurrence: "+err.Error(),
+		)
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,90 @@
+package events
+
+import (
+	"context"
+	"time"
+
+	"github.com/milsim-tools/pincer/internal/models"
+	eventsv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/events/v1"
+	"github.com/milsim-tools/pincer/pkg/authz"
+	"github.com/oklog/ulid/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func (s *Events) RespondEvent(ctx context.Context, req *eventsv1.RespondEventRequest) (*eventsv1.EventResponse, error) {
+	// Members respond for themselves, staff also on behalf of members who can
+	// respond to events.
+	if !s.authorizer.IsSelf(ctx, req.UserId) {
+		if err := s.authorizer.RequireUnitPermission(ctx, req.UnitId, authz.PermissionManageEvents); err != nil {
+			return &eventsv1.EventResponse{}, err
+		}
+
+		can, err := s.authorizer.HasUnitPermission(ctx, req.UnitId, req.UserId, authz.PermissionRespondEvents)
+		if err != nil {
+			return &eventsv1.EventResponse{}, err
+		}
+		if !can {
+			return &eventsv1.EventResponse{}, status.Error(
+				codes.FailedPrecondition,
+				"the user cannot respond to the unit's events",
+			)
+		}
+	}
+
+	event, err := s.event(ctx, req.UnitId, req.EventId)
+	if err != nil {
+		return &eventsv1.EventResponse{}, err
+	}
+
+	o, err := s.occurrence(ctx, event, req.OccurrenceTime, "occurrence_time")
+	if err != nil {
+		return &eventsv1.EventResponse{}, err
+	}
+
+	now := time.Now()
+	if !o.event.EndTime.After(now) {
+		return &eventsv1.EventResponse{}, status.Error(
+			codes.FailedPrecondition,
+			"the event has ended",
+		)
+	}
+
+	// Responding again replaces the user's response.
+	if err := gorm.G[EventsResponse](s.db.Db, clause.OnConflict{
+		Columns:   []clause.Column{{Name: "event_id"}, {Name: "occurrence_time"}, {Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"status", "comment", "updated_at"}),
+	}).Create(ctx, &EventsResponse{
+		Model: models.Model{
+			ID:        ulid.Make().String(),
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+		EventID:        req.EventId,
+		OccurrenceTime: o.time,
+		UserID:         req.UserId,
+		Status:         int32(req.Status),
+		Comment:        req.Comment,
+	}); err != nil {
+		return &eventsv1.EventResponse{}, status.Error(
+			codes.Internal,
+			"failed to save response: "+err.Error(),
+		)
+	}
+
+	// The response is read back, as it keeps the ID and creation time of the
+	// user's first response.
+	response, err := gorm.G[EventsResponse](s.db.Db).
+		Where("event_id = ? AND occurrence_time = ? AND user_id = ?", req.EventId, o.time, req.UserId).
+		First(ctx)
+	if err != nil {
+		return &eventsv1.EventResponse{}, status.Error(
+			codes.Internal,
+			"failed to query response: "+err.Error(),
+		)
+	}
+
+	return response.Proto(), nil
+}
@@ -0,0 +1,239 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/milsim-tools/pincer/internal/helpers"
+	eventsv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/events/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+func (s *Events) UpdateEvent(ctx context.Context, req *eventsv1.UpdateEventRequest) (*eventsv1.Event, error) {
+	event, err := s.event(ctx, req.Event.GetUnitId(), req.Event.GetId())
+	if err != nil {
+		return &eventsv1.Event{}, err
+	}
+
+	previous := event
+
+	// Paths are accepted both relative to the event, as the REST gateway
+	// sends them, and prefixed with `event.`.
+	for _, path := range req.UpdateMask.GetPaths() {
+		switch strings.TrimPrefix(path, "event.") {
+		case "title":
+			event.Title = req.Event.Title
+		case "briefing":
+			event.Briefing = req.Event.Briefing
+		case "start_time":
+			event.StartTime = seconds(req.Event.StartTime)
+		case "end_time":
+			event.EndTime = seconds(req.Event.EndTime)
+		case "time_zone":
+			event.TimeZone = req.Event.TimeZone
+			if event.TimeZone == "" {
+				event.TimeZone = "UTC"
+			}
+		case "visibility":
+			event.Visibility = int32(req.Event.Visibility)
+			if event.Visibility == int32(eventsv1.EventVisibility_EVENT_VISIBILITY_UNSPECIFIED) {
+				event.Visibility = int32(eventsv1.EventVisibility_EVENT_VISIBILITY_UNIT)
+			}
+		case "server":
+			event.Server = newServer(req.Event.Server)
+		case "recurrence":
+			event.setRecurrence(req.Event.Recurrence)
+		case "id", "unit_id", "created_by", "created_at", "updated_at":
+			return &eventsv1.Event{}, helpers.InvalidArgument("update_mask", path+" cannot be updated")
+		default:
+			return &eventsv1.Event{}, helpers.InvalidArgument("update_mask", "unknown field "+path)
+		}
+	}
+
+	if err := validate(&event); err != nil {
+		return &eventsv1.Event{}, err
+	}
+
+	// Occurrences cancelled before the event moved stay cancelled, unless
+	// the request sets which are.
+	if move := shift(previous, event); move != nil && slices.EqualFunc(event.Exceptions, previous.Exceptions, time.Time.Equal) {
+		var exceptions []time.Time
+		for _, t := range previous.Exceptions {
+			if moved, ok := move(t); ok {
+				exceptions = append(exceptions, moved)
+			}
+		}
+		event.Exceptions = exceptions
+	}
+
+	err = s.db.Db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Selecting the columns also writes fields cleared to their zero
+		// value.
+		if _, err := gorm.G[EventsEvent](tx).
+			Where("id = ?", event.ID).
+			Select(
+				"title", "briefing", "start_time", "end_time", "time_zone", "visibility",
+				"server_game", "server_address", "server_port", "server_password", "server_modset",
+				"recurrence", "exceptions", "last_end_time", "updated_at",
+			).
+			Updates(ctx, event); err != nil {
+			return err
+		}
+
+		return reschedule(ctx, tx, previous, event)
+	})
+	if err != nil {
+		if stranded := (strandedError{}); errors.As(err, &stranded) {
+			return &eventsv1.Event{}, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return &eventsv1.Event{}, status.Error(
+			codes.Internal,
+			"failed to update event: "+err.Error(),
+		)
+	}
+
+	return event.Proto(), nil
+}
+
+// strandedError is returned by reschedule when attendance was recorded at
+// occurrences the event no longer has.
+type strandedError struct {
+	times []time.Time
+}
+
+func (e strandedError) Error() string {
+	times := make([]string, len(e.times))
+	for i, t := range e.times {
+		times[i] = t.Format(time.RFC3339)
+	}
+	return "attendance was recorded at occurrences the change removes: " + strings.Join(times, ", ")
+}
+
+// shift returns the function moving the occurrences of the previous event to
+// those of the event, if the change moves the event's start and nothing else
+// about its occurrences. The nth occurrence of a recurring event moves to its
+// new nth occurrence. It returns nil if the occurrences do not move.
+func shift(previous, event EventsEvent) func(time.Time) (time.Time, bool) {
+	if event.StartTime.Equal(previous.StartTime) {
+		return nil
+	}
+
+	if previous.Recurrence == "" && event.Recurrence == "" {
+		return func(t time.Time) (time.Time, bool) {
+			return event.StartTime, t.Equal(previous.StartTime)
+		}
+	}
+
+	if previous.Recurrence == "" || previous.Recurrence != event.Recurrence || previous.TimeZone != event.TimeZone {
+		return nil
+	}
+
+	before, err := rule(previous)
+	if err != nil {
+		return nil
+	}
+	after, err := rule(event)
+	if err != nil {
+		return nil
+	}
+
+	return func(t time.Time) (time.Time, bool) {
+		if len(before.Between(t, t, true)) != 1 {
+			return time.Time{}, false
+		}
+
+		n := len(before.Between(previous.StartTime, t, true))
+		next := after.Iterator()
+		var moved time.Time
+		for range n {
+			var ok bool
+			if moved, ok = next(); !ok {
+				return time.Time{}, false
+			}
+		}
+		return moved.UTC(), true
+	}
+}
+
+// reschedule carries the overrides of, responses to and attendance at the
+// occurrences of the event over to its new times. When the change only moves
+// the event's start, they move with their occurrences. Overrides of and
+// responses to occurrences the event no longer has are dropped, while
+// attendance at them makes the change fail.
+func reschedule(ctx context.Context, tx *gorm.DB, previous, event EventsEvent) error {
+	move := shift(previous, event)
+	carry := func(t time.Time) (time.Time, bool) {
+		t = t.UTC()
+		if move != nil {
+			var ok bool
+			if t, ok = move(t); !ok {
+				return time.Time{}, false
+			}
+		}
+		return t, takesPlace(event, t)
+	}
+
+	stranded, err := carryOver(ctx, tx, event.ID, func(a *EventsAttendance) *time.Time { return &a.OccurrenceTime }, carry)
+	if err != nil {
+		return err
+	}
+	if len(stranded) > 0 {
+		return strandedError{times: stranded}
+	}
+
+	if _, err := carryOver(ctx, tx, event.ID, func(r *EventsResponse) *time.Time { return &r.OccurrenceTime }, carry); err != nil {
+		return err
+	}
+
+	_, err = carryOver(ctx, tx, event.ID, func(o *EventsOverride) *time.Time { return &o.OccurrenceTime }, carry)
+	return err
+}
+
+// carryOver moves the rows of the event to the occurrences carry gives for
+// their occurrence, and deletes those whose occurrence carry drops, returning
+// the times of the dropped occurrences.
+func carryOver[T any](ctx context.Context, tx *gorm.DB, eventID string, occurrence func(*T) *time.Time, carry func(time.Time) (time.Time, bool)) ([]time.Time, error) {
+	rows, err := gorm.G[T](tx).Where("event_id = ?", eventID).Find(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		kept      []T
+		dropped   []time.Time
+		unchanged = true
+	)
+	for i := range rows {
+		at := occurrence(&rows[i])
+		t, ok := carry(*at)
+		if !ok {
+			dropped = append(dropped, at.UTC())
+			continue
+		}
+		unchanged = unchanged && t.Equal(*at)
+		*at = t
+		kept = append(kept, rows[i])
+	}
+	if unchanged && len(dropped) == 0 {
+		return nil, nil
+	}
+
+	// The rows are recreated rather than updated in place, as a row may move
+	// to the occurrence of another that has yet to move.
+	if _, err := gorm.G[T](tx).Where("event_id = ?", eventID).Delete(ctx); err != nil {
+		return nil, err
+	}
+	if len(kept) > 0 {
+		if err := gorm.G[T](tx).CreateInBatches(ctx, &kept, 100); err != nil {
+			return nil, err
+		}
+	}
+
+	slices.SortFunc(dropped, time.Time.Compare)
+	return slices.CompactFunc(dropped, time.Time.Equal), nil
+}
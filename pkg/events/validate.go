@@ -0,0 +1,36 @@
+package events
+
+import (
+	"time"
+	_ "time/tzdata"
+
+	"buf.build/go/protovalidate"
+	"github.com/milsim-tools/pincer/internal/helpers"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// validate checks the event as it is to be saved, and schedules its
+// occurrences. The time zone database is embedded, so that time zones do not
+// depend on the host.
+func validate(event *EventsEvent) error {
+	if event.TimeZone == "Local" {
+		return helpers.InvalidArgument("event.time_zone", "unknown time zone Local")
+	}
+	if _, err := time.LoadLocation(event.TimeZone); err != nil {
+		return helpers.InvalidArgument("event.time_zone", "unknown time zone "+event.TimeZone)
+	}
+
+	if err := protovalidate.Validate(event.Proto()); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return schedule(event)
+}
+
+// seconds returns the time of the timestamp, to the second, as recurrence
+// rules repeat times to the second.
+func seconds(ts *timestamppb.Timestamp) time.Time {
+	return ts.AsTime().Truncate(time.Second)
+}
@@ -0,0 +1,57 @@
+package grpcclient
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sony/gobreaker/v2"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// breaker stops calling a module after consecutive failures, so that callers
+// fail fast rather than piling up on a module that is down.
+type breaker struct {
+	addr string
+	cb   *gobreaker.CircuitBreaker[struct{}]
+}
+
+func newBreaker(addr string, cfg Config) *breaker {
+	return &breaker{
+		addr: addr,
+		cb: gobreaker.NewCircuitBreaker[struct{}](gobreaker.Settings{
+			Name:    addr,
+			Timeout: cfg.BreakerTimeout,
+			ReadyToTrip: func(counts gobreaker.Counts) bool {
+				return counts.ConsecutiveFailures >= cfg.BreakerFailures
+			},
+			IsSuccessful: func(err error) bool {
+				return !isModuleFailure(err)
+			},
+		}),
+	}
+}
+
+// isModuleFailure reports whether an error indicates that the module itself is
+// failing, rather than rejecting the particular request.
+func isModuleFailure(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Internal, codes.Unknown:
+		return true
+	default:
+		return false
+	}
+}
+
+func (b *breaker) UnaryClientInterceptor(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	_, err := b.cb.Execute(func() (struct{}, error) {
+		return struct{}{}, invoker(ctx, method, req, reply, cc, opts...)
+	})
+
+	if errors.Is(err, gobreaker.ErrOpenState) || errors.Is(err, gobreaker.ErrTooManyRequests) {
+		return status.Error(codes.Unavailable, "circuit to "+b.addr+" is open: "+err.Error())
+	}
+
+	return err
+}
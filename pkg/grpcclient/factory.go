@@ -0,0 +1,56 @@
+package grpcclient
+
+import (
+	"errors"
+	"sync"
+
+	"google.golang.org/grpc"
+)
+
+// Factory lazily dials the services of modules running in other processes,
+// sharing one connection per address. It is safe for concurrent use.
+type Factory struct {
+	cfg Config
+
+	mu    sync.Mutex
+	conns map[string]*grpc.ClientConn
+}
+
+func NewFactory(cfg Config) *Factory {
+	return &Factory{
+		cfg:   cfg,
+		conns: map[string]*grpc.ClientConn{},
+	}
+}
+
+// Conn returns the connection to addr, creating it on first use.
+func (f *Factory) Conn(addr string) (*grpc.ClientConn, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if conn, ok := f.conns[addr]; ok {
+		return conn, nil
+	}
+
+	conn, err := New(addr, f.cfg)
+	if err != nil {
+		return nil, err
+	}
+	f.conns[addr] = conn
+
+	return conn, nil
+}
+
+// Close closes every connection created by the factory.
+func (f *Factory) Close() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var errs []error
+	for addr, conn := range f.conns {
+		errs = append(errs, conn.Close())
+		delete(f.conns, addr)
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,190 @@
+package grpcclient
+
+import (
+	"context"
+	"time"
+
+	"github.com/milsim-tools/pincer/internal/middleware"
+	"github.com/milsim-tools/pincer/internal/tlsutil"
+	"github.com/urfave/cli/v2"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const (
+	FlagTLSEnabled            = "grpc-client-tls-enabled"
+	FlagTLSCAFile             = "grpc-client-tls-ca-file"
+	FlagTLSCertFile           = "grpc-client-tls-cert-file"
+	FlagTLSKeyFile            = "grpc-client-tls-key-file"
+	FlagTLSServerName         = "grpc-client-tls-server-name"
+	FlagTLSInsecureSkipVerify = "grpc-client-tls-insecure-skip-verify"
+
+	FlagTimeout         = "grpc-client-timeout"
+	FlagMaxAttempts     = "grpc-client-max-attempts"
+	FlagBreakerFailures = "grpc-client-breaker-failures"
+	FlagBreakerTimeout  = "grpc-client-breaker-timeout"
+)
+
+var Flags = []cli.Flag{
+	&cli.BoolFlag{
+		Name:    FlagTLSEnabled,
+		Usage:   "Connect to the gRPC services of other modules over TLS.",
+		EnvVars: []string{"PINCER_GRPC_CLIENT_TLS_ENABLED"},
+	},
+
+	&cli.StringFlag{
+		Name:    FlagTLSCAFile,
+		Usage:   "The CA bundle used to verify other modules' certificates. The system roots are used if unset.",
+		EnvVars: []string{"PINCER_GRPC_CLIENT_TLS_CA_FILE"},
+	},
+
+	&cli.StringFlag{
+		Name:    FlagTLSCertFile,
+		Usage:   "The client certificate presented to modules that require mutual TLS.",
+		EnvVars: []string{"PINCER_GRPC_CLIENT_TLS_CERT_FILE"},
+	},
+
+	&cli.StringFlag{
+		Name:    FlagTLSKeyFile,
+		Usage:   "The private key of the client certificate.",
+		EnvVars: []string{"PINCER_GRPC_CLIENT_TLS_KEY_FILE"},
+	},
+
+	&cli.StringFlag{
+		Name:    FlagTLSServerName,
+		Usage:   "Overrides the server name other modules' certificates are verified against.",
+		EnvVars: []string{"PINCER_GRPC_CLIENT_TLS_SERVER_NAME"},
+	},
+
+	&cli.BoolFlag{
+		Name:    FlagTLSInsecureSkipVerify,
+		Usage:   "Skip verifying other modules' certificates. Only use this for testing.",
+		EnvVars: []string{"PINCER_GRPC_CLIENT_TLS_INSECURE_SKIP_VERIFY"},
+	},
+
+	&cli.DurationFlag{
+		Name:    FlagTimeout,
+		Value:   10 * time.Second,
+		Usage:   "The deadline of calls to other modules that are not already bound by one. Zero disables it.",
+		EnvVars: []string{"PINCER_GRPC_CLIENT_TIMEOUT"},
+	},
+
+	&cli.IntFlag{
+		Name:    FlagMaxAttempts,
+		Value:   3,
+		Usage:   "How many times idempotent calls to other modules are attempted while they are unavailable (at most 5).",
+		EnvVars: []string{"PINCER_GRPC_CLIENT_MAX_ATTEMPTS"},
+	},
+
+	&cli.UintFlag{
+		Name:    FlagBreakerFailures,
+		Value:   5,
+		Usage:   "How many consecutive failed calls open the circuit to a module, failing calls fast. Zero disables it.",
+		EnvVars: []string{"PINCER_GRPC_CLIENT_BREAKER_FAILURES"},
+	},
+
+	&cli.DurationFlag{
+		Name:    FlagBreakerTimeout,
+		Value:   30 * time.Second,
+		Usage:   "How long the circuit to a module stays open before calls are let through again.",
+		EnvVars: []string{"PINCER_GRPC_CLIENT_BREAKER_TIMEOUT"},
+	},
+}
+
+// Config configures the connections modules make to each other.
+type Config struct {
+	TLSEnabled bool
+	TLS        tlsutil.ClientConfig
+
+	Timeout         time.Duration
+	MaxAttempts     int
+	BreakerFailures uint32
+	BreakerTimeout  time.Duration
+}
+
+func ConfigFromFlags(ctx *cli.Context) Config {
+	var config Config
+
+	config.TLSEnabled = ctx.Bool(FlagTLSEnabled)
+	config.TLS.CAFile = ctx.String(FlagTLSCAFile)
+	config.TLS.CertFile = ctx.String(FlagTLSCertFile)
+	config.TLS.KeyFile = ctx.String(FlagTLSKeyFile)
+	config.TLS.ServerName = ctx.String(FlagTLSServerName)
+	config.TLS.InsecureSkipVerify = ctx.Bool(FlagTLSInsecureSkipVerify)
+
+	config.Timeout = ctx.Duration(FlagTimeout)
+	config.MaxAttempts = ctx.Int(FlagMaxAttempts)
+	config.BreakerFailures = uint32(ctx.Uint(FlagBreakerFailures))
+	config.BreakerTimeout = ctx.Duration(FlagBreakerTimeout)
+
+	return config
+}
+
+// TransportCredentials returns the credentials to dial other modules with.
+func (c Config) TransportCredentials() (credentials.TransportCredentials, error) {
+	if !c.TLSEnabled {
+		return insecure.NewCredentials(), nil
+	}
+
+	tlsConfig, err := tlsutil.NewClientConfig(c.TLS)
+	if err != nil {
+		return nil, err
+	}
+
+	return credentials.NewTLS(tlsConfig), nil
+}
+
+// New creates a client connection to the module serving at addr.
+//
+// Addresses resolving to several backends are balanced round-robin, and
+// idempotent calls are retried while the module is unavailable. Calls are
+// bound by a default deadline, fail fast while the module keeps failing, are
+// traced, and carry the credentials of the incoming request they are made on
+// behalf of.
+func New(addr string, cfg Config) (*grpc.ClientConn, error) {
+	creds, err := cfg.TransportCredentials()
+	if err != nil {
+		return nil, err
+	}
+
+	serviceConfig, err := newServiceConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	unary := []grpc.UnaryClientInterceptor{
+		middleware.ForwardAuthorizationUnaryClientInterceptor,
+		middleware.ForwardRequestIDUnaryClientInterceptor,
+		timeoutUnaryClientInterceptor(cfg.Timeout),
+	}
+	if cfg.BreakerFailures > 0 {
+		unary = append(unary, newBreaker(addr, cfg).UnaryClientInterceptor)
+	}
+
+	return grpc.NewClient(addr,
+		grpc.WithTransportCredentials(creds),
+		grpc.WithDefaultServiceConfig(serviceConfig),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+		grpc.WithChainUnaryInterceptor(unary...),
+		grpc.WithChainStreamInterceptor(
+			middleware.ForwardAuthorizationStreamClientInterceptor,
+			middleware.ForwardRequestIDStreamClientInterceptor,
+		),
+	)
+}
+
+// timeoutUnaryClientInterceptor bounds calls that have no deadline yet by the
+// given timeout.
+func timeoutUnaryClientInterceptor(timeout time.Duration) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if _, ok := ctx.Deadline(); !ok && timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
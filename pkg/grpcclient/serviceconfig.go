@@ -0,0 +1,107 @@
+package grpcclient
+
+import (
+	"encoding/json"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// servicePrefix is the package prefix of the services modules call each other
+// through.
+const servicePrefix = "milsimtools."
+
+// grpc-go ignores retry policies allowing more attempts than this.
+const maxRetryAttempts = 5
+
+type serviceConfig struct {
+	LoadBalancingConfig []map[string]struct{} `json:"loadBalancingConfig"`
+	MethodConfig        []methodConfig        `json:"methodConfig,omitempty"`
+}
+
+type methodConfig struct {
+	Name        []methodName `json:"name"`
+	RetryPolicy *retryPolicy `json:"retryPolicy,omitempty"`
+}
+
+type methodName struct {
+	Service string `json:"service"`
+	Method  string `json:"method"`
+}
+
+type retryPolicy struct {
+	MaxAttempts          int      `json:"maxAttempts"`
+	InitialBackoff       string   `json:"initialBackoff"`
+	MaxBackoff           string   `json:"maxBackoff"`
+	BackoffMultiplier    float64  `json:"backoffMultiplier"`
+	RetryableStatusCodes []string `json:"retryableStatusCodes"`
+}
+
+// newServiceConfig creates the gRPC service config of connections to other
+// modules. It balances calls round-robin over every address the target
+// resolves to, and retries the RPCs declared free of side effects (using the
+// idempotency_level method option) while the module is unavailable.
+func newServiceConfig(cfg Config) (string, error) {
+	config := serviceConfig{
+		LoadBalancingConfig: []map[string]struct{}{
+			{"round_robin": {}},
+		},
+	}
+
+	if cfg.MaxAttempts > 1 {
+		if names := idempotentMethods(); len(names) > 0 {
+			config.MethodConfig = append(config.MethodConfig, methodConfig{
+				Name: names,
+				RetryPolicy: &retryPolicy{
+					MaxAttempts:          min(cfg.MaxAttempts, maxRetryAttempts),
+					InitialBackoff:       "0.1s",
+					MaxBackoff:           "1s",
+					BackoffMultiplier:    2,
+					RetryableStatusCodes: []string{"UNAVAILABLE"},
+				},
+			})
+		}
+	}
+
+	bytes, err := json.Marshal(config)
+	if err != nil {
+		return "", err
+	}
+
+	return string(bytes), nil
+}
+
+// idempotentMethods lists the methods of Pincer's services that have no side
+// effects, and are therefore always safe to retry.
+func idempotentMethods() []methodName {
+	var names []methodName
+
+	protoregistry.GlobalFiles.RangeFiles(func(file protoreflect.FileDescriptor) bool {
+		services := file.Services()
+		for i := range services.Len() {
+			service := services.Get(i)
+			if !strings.HasPrefix(string(service.FullName()), servicePrefix) {
+				continue
+			}
+
+			methods := service.Methods()
+			for j := range methods.Len() {
+				method := methods.Get(j)
+				options, ok := method.Options().(*descriptorpb.MethodOptions)
+				if !ok || options.GetIdempotencyLevel() != descriptorpb.MethodOptions_NO_SIDE_EFFECTS {
+					continue
+				}
+
+				names = append(names, methodName{
+					Service: string(service.FullName()),
+					Method:  string(method.Name()),
+				})
+			}
+		}
+		return true
+	})
+
+	return names
+}
@@ -0,0 +1,124 @@
+package members
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/milsim-tools/pincer/internal/helpers"
+	"github.com/milsim-tools/pincer/internal/models"
+	membersv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/members/v1"
+	"github.com/milsim-tools/pincer/pkg/authz"
+	"github.com/oklog/ulid/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+func (m *Members) AdmitMember(ctx context.Context, req *membersv1.AdmitMemberRequest) (*membersv1.UnitMember, error) {
+	approved := membersv1.UnitMemberStatus_UNIT_MEMBER_STATUS_APPROVED
+
+	// Admission is not a way to appoint administrators, which only owners do
+	// by updating a member.
+	if authz.Can(req.Permissions, authz.PermissionAdministrator) {
+		return &membersv1.UnitMember{}, helpers.InvalidArgument("permissions", "members cannot be admitted as administrators")
+	}
+	if err := m.checkGrant(ctx, req.UnitId, req.Permissions); err != nil {
+		return &membersv1.UnitMember{}, err
+	}
+
+	member, err := gorm.G[MembersUnitMember](m.db.Db).
+		Where("unit_id = ? AND user_id = ?", req.UnitId, req.UserId).
+		First(ctx)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return &membersv1.UnitMember{}, status.Error(
+			codes.Internal,
+			"failed to query member: "+err.Error(),
+		)
+	}
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		if err := m.checkUnitAndUser(ctx, req.UnitId, req.UserId); err != nil {
+			return &membersv1.UnitMember{}, err
+		}
+
+		member = MembersUnitMember{
+			Model: models.Model{
+				ID: ulid.Make().String(),
+			},
+			UnitID:      req.UnitId,
+			UserID:      req.UserId,
+			Permissions: req.Permissions,
+		}
+
+		err := m.db.Db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			if err := transition(ctx, tx, &member, approved, time.Now()); err != nil {
+				return err
+			}
+
+			return gorm.G[MembersUnitMember](tx).Create(ctx, &member)
+		})
+		if err != nil {
+			// The user may have joined the unit since the lookup above.
+			if errors.Is(err, gorm.ErrDuplicatedKey) {
+				return &membersv1.UnitMember{}, status.Error(
+					codes.Aborted,
+					"the user joined the unit concurrently, retry the request",
+				)
+			}
+			return &membersv1.UnitMember{}, status.Error(
+				codes.Internal,
+				"failed to create member: "+err.Error(),
+			)
+		}
+
+		return member.Proto(), nil
+	}
+
+	// Rejected members applying again are pending before being approved.
+	var path []membersv1.UnitMemberStatus
+	switch membersv1.UnitMemberStatus(member.Status) {
+	case approved:
+		return member.Proto(), nil
+	case membersv1.UnitMemberStatus_UNIT_MEMBER_STATUS_BANNED:
+		return &membersv1.UnitMember{}, status.Error(
+			codes.FailedPrecondition,
+			"banned users cannot be admitted",
+		)
+	case membersv1.UnitMemberStatus_UNIT_MEMBER_STATUS_REJECTED:
+		path = []membersv1.UnitMemberStatus{membersv1.UnitMemberStatus_UNIT_MEMBER_STATUS_PENDING, approved}
+	default:
+		path = []membersv1.UnitMemberStatus{approved}
+	}
+
+	from := membersv1.UnitMemberStatus(member.Status)
+	for _, to := range path {
+		if err := checkTransition(from, to); err != nil {
+			return &membersv1.UnitMember{}, err
+		}
+		from = to
+	}
+
+	member.Permissions = req.Permissions
+	err = m.db.Db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		for _, to := range path {
+			if err := transition(ctx, tx, &member, to, now); err != nil {
+				return err
+			}
+		}
+
+		_, err := gorm.G[MembersUnitMember](tx).
+			Select("permissions", "status", "status_changed_at", "updated_at").
+			Updates(ctx, member)
+		return err
+	})
+	if err != nil {
+		return &membersv1.UnitMember{}, status.Error(
+			codes.Internal,
+			"failed to update member: "+err.Error(),
+		)
+	}
+
+	return member.Proto(), nil
+}
@@ -0,0 +1,38 @@
+package members
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+)
+
+// MemberPermissions returns the permissions the user holds in the unit, for
+// authorization decisions, as Permissions gives them for the member's status.
+func (m *Members) MemberPermissions(ctx context.Context, unitID string, userID string) (int32, bool, error) {
+	member, err := gorm.G[MembersUnitMember](m.db.Db).
+		Where("unit_id = ? AND user_id = ?", unitID, userID).
+		First(ctx)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, false, nil
+		}
+		return 0, false, err
+	}
+
+	return Permissions(member.Proto()), true, nil
+}
+
+// MemberUnits returns the IDs of the units the user is a member of, whatever
+// their status, for authorization decisions.
+func (m *Members) MemberUnits(ctx context.Context, userID string) ([]string, error) {
+	var units []string
+	if err := m.db.Db.WithContext(ctx).
+		Model(&MembersUnitMember{}).
+		Where("user_id = ?", userID).
+		Pluck("unit_id", &units).Error; err != nil {
+		return nil, err
+	}
+
+	return units, nil
+}
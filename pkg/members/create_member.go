@@ -2,12 +2,117 @@ package members
 
 import (
 	"context"
+	"errors"
+	"time"
 
+	"github.com/milsim-tools/pincer/internal/helpers"
+	"github.com/milsim-tools/pincer/internal/models"
 	membersv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/members/v1"
+	unitsv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/units/v1"
+	usersv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/users/v1"
+	"github.com/oklog/ulid/v2"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
 )
 
 func (m *Members) CreateMember(ctx context.Context, req *membersv1.CreateMemberRequest) (*membersv1.UnitMember, error) {
-	return &membersv1.UnitMember{}, status.Errorf(codes.Unimplemented, "method CreateMember not implemented")
+	initial := req.Member.Status
+	if initial == membersv1.UnitMemberStatus_UNIT_MEMBER_STATUS_UNSPECIFIED {
+		initial = membersv1.UnitMemberStatus_UNIT_MEMBER_STATUS_PENDING
+	}
+	if checkTransition(membersv1.UnitMemberStatus_UNIT_MEMBER_STATUS_UNSPECIFIED, initial) != nil {
+		return &membersv1.UnitMember{}, helpers.InvalidArgument("member.status", "members are created pending or approved")
+	}
+
+	if err := m.checkGrant(ctx, req.Member.UnitId, req.Member.Permissions); err != nil {
+		return &membersv1.UnitMember{}, err
+	}
+
+	if err := m.checkUnitAndUser(ctx, req.Member.UnitId, req.Member.UserId); err != nil {
+		return &membersv1.UnitMember{}, err
+	}
+
+	_, err := gorm.G[MembersUnitMember](m.db.Db).
+		Where("unit_id = ? AND user_id = ?", req.Member.UnitId, req.Member.UserId).
+		First(ctx)
+	if err == nil {
+		return &membersv1.UnitMember{}, status.Error(
+			codes.AlreadyExists,
+			"the user is already a member of the unit",
+		)
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return &membersv1.UnitMember{}, status.Error(
+			codes.Internal,
+			"failed to query member: "+err.Error(),
+		)
+	}
+
+	member := &MembersUnitMember{
+		Model: models.Model{
+			ID: ulid.Make().String(),
+		},
+		UnitID:      req.Member.UnitId,
+		UserID:      req.Member.UserId,
+		Permissions: req.Member.Permissions,
+	}
+
+	err = m.db.Db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := transition(ctx, tx, member, initial, time.Now()); err != nil {
+			return err
+		}
+
+		return gorm.G[MembersUnitMember](tx).Create(ctx, member)
+	})
+	if err != nil {
+		// The user may have joined the unit since the check above.
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return &membersv1.UnitMember{}, status.Error(
+				codes.AlreadyExists,
+				"the user is already a member of the unit",
+			)
+		}
+		return &membersv1.UnitMember{}, status.Error(
+			codes.Internal,
+			"failed to create member: "+err.Error(),
+		)
+	}
+
+	return member.Proto(), nil
+}
+
+// checkUnitAndUser ensures that the unit and user of a new member exist.
+func (m *Members) checkUnitAndUser(ctx context.Context, unitID string, userID string) error {
+	if _, err := m.units.GetUnit(ctx, &unitsv1.GetUnitRequest{
+		Value: &unitsv1.GetUnitRequest_Id{Id: unitID},
+	}); err != nil {
+		if status.Code(err) == codes.NotFound {
+			return status.Error(
+				codes.InvalidArgument,
+				"unit_id does not correspond to an existing unit",
+			)
+		}
+		return status.Error(
+			codes.Internal,
+			"failed to call units service: "+err.Error(),
+		)
+	}
+
+	if _, err := m.users.GetUser(ctx, &usersv1.GetUserRequest{
+		Value: &usersv1.GetUserRequest_Id{Id: userID},
+	}); err != nil {
+		if status.Code(err) == codes.NotFound {
+			return status.Error(
+				codes.InvalidArgument,
+				"user_id does not correspond to an existing user",
+			)
+		}
+		return status.Error(
+			codes.Internal,
+			"failed to call users service: "+err.Error(),
+		)
+	}
+
+	return nil
 }
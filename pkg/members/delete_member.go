@@ -2,13 +2,62 @@ package members
 
 import (
 	"context"
+	"errors"
 
 	membersv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/members/v1"
+	"github.com/milsim-tools/pincer/pkg/authz"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"gorm.io/gorm"
 )
 
 func (m *Members) DeleteMember(ctx context.Context, req *membersv1.DeleteMemberRequest) (*emptypb.Empty, error) {
-	return &emptypb.Empty{}, status.Errorf(codes.Unimplemented, "method DeleteMember not implemented")
+	member, err := gorm.G[MembersUnitMember](m.db.Db).
+		Where("unit_id = ? AND user_id = ?", req.UnitId, req.UserId).
+		First(ctx)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &emptypb.Empty{}, status.Error(
+				codes.NotFound,
+				"member not found",
+			)
+		}
+
+		return &emptypb.Empty{}, status.Error(
+			codes.Internal,
+			"failed to query member: "+err.Error(),
+		)
+	}
+
+	identity, _ := authz.FromContext(ctx)
+	if identity.UserID != "" && identity.UserID == req.UserId {
+		// Deleting the member would erase the ban, letting the user apply
+		// again.
+		if member.Status == int32(membersv1.UnitMemberStatus_UNIT_MEMBER_STATUS_BANNED) {
+			return &emptypb.Empty{}, status.Error(
+				codes.FailedPrecondition,
+				"banned members cannot leave the unit",
+			)
+		}
+	} else if err := m.checkManage(ctx, member); err != nil {
+		return &emptypb.Empty{}, err
+	}
+
+	err = m.db.Db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if _, err := gorm.G[MembersUnitMemberTransition](tx).Where("member_id = ?", member.ID).Delete(ctx); err != nil {
+			return err
+		}
+
+		_, err := gorm.G[MembersUnitMember](tx).Where("id = ?", member.ID).Delete(ctx)
+		return err
+	})
+	if err != nil {
+		return &emptypb.Empty{}, status.Error(
+			codes.Internal,
+			"failed to delete member: "+err.Error(),
+		)
+	}
+
+	return &emptypb.Empty{}, nil
 }
@@ -0,0 +1,31 @@
+package members
+
+import (
+	"context"
+
+	membersv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/members/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"gorm.io/gorm"
+)
+
+func (m *Members) DeleteUnitMembers(ctx context.Context, req *membersv1.DeleteUnitMembersRequest) (*emptypb.Empty, error) {
+	err := m.db.Db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		members := tx.Model(&MembersUnitMember{}).Select("id").Where("unit_id = ?", req.UnitId)
+		if _, err := gorm.G[MembersUnitMemberTransition](tx).Where("member_id IN (?)", members).Delete(ctx); err != nil {
+			return err
+		}
+
+		_, err := gorm.G[MembersUnitMember](tx).Where("unit_id = ?", req.UnitId).Delete(ctx)
+		return err
+	})
+	if err != nil {
+		return &emptypb.Empty{}, status.Error(
+			codes.Internal,
+			"failed to delete members: "+err.Error(),
+		)
+	}
+
+	return &emptypb.Empty{}, nil
+}
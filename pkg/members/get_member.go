@@ -2,12 +2,31 @@ package members
 
 import (
 	"context"
+	"errors"
 
 	membersv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/members/v1"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
 )
 
 func (m *Members) GetMember(ctx context.Context, req *membersv1.GetMemberRequest) (*membersv1.UnitMember, error) {
-	return &membersv1.UnitMember{}, status.Errorf(codes.Unimplemented, "method GetMember not implemented")
+	member, err := gorm.G[MembersUnitMember](m.db.Db).
+		Where("unit_id = ? AND user_id = ?", req.UnitId, req.UserId).
+		First(ctx)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &membersv1.UnitMember{}, status.Error(
+				codes.NotFound,
+				"member not found",
+			)
+		}
+
+		return &membersv1.UnitMember{}, status.Error(
+			codes.Internal,
+			"failed to query member: "+err.Error(),
+		)
+	}
+
+	return member.Proto(), nil
 }
@@ -0,0 +1,81 @@
+package members
+
+import (
+	"context"
+
+	unitsv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/units/v1"
+	"github.com/milsim-tools/pincer/pkg/authz"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// checkGrant ensures that the caller may give or take the permissions of
+// members of the unit. Only unit owners and administrators change who
+// administers the unit or manages its members, and other callers only change
+// permissions they hold themselves.
+func (m *Members) checkGrant(ctx context.Context, unitID string, permissions int32) error {
+	if permissions == 0 {
+		return nil
+	}
+
+	if permissions&(authz.PermissionAdministrator|authz.PermissionManageMembers) != 0 {
+		if err := m.authorizer.RequireUnitPermission(ctx, unitID, authz.PermissionAdministrator); err != nil {
+			if status.Code(err) == codes.PermissionDenied {
+				return status.Error(
+					codes.PermissionDenied,
+					"only unit owners and administrators can grant ADMINISTRATOR or MANAGE_MEMBERS",
+				)
+			}
+			return err
+		}
+	}
+
+	if err := m.authorizer.RequireUnitPermission(ctx, unitID, permissions); err != nil {
+		if status.Code(err) == codes.PermissionDenied {
+			return status.Error(
+				codes.PermissionDenied,
+				"callers can only grant permissions they hold",
+			)
+		}
+		return err
+	}
+
+	return nil
+}
+
+// checkManage ensures that the caller may change the status of, or delete, the
+// member. Members who administer the unit or manage its members, and the unit
+// owner, are only managed by unit owners and administrators.
+func (m *Members) checkManage(ctx context.Context, member MembersUnitMember) error {
+	privileged := member.Permissions&(authz.PermissionAdministrator|authz.PermissionManageMembers) != 0
+	if !privileged {
+		view, err := m.units.GetUnit(ctx, &unitsv1.GetUnitRequest{
+			Value: &unitsv1.GetUnitRequest_Id{Id: member.UnitID},
+		})
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				return status.Error(codes.NotFound, "unit not found")
+			}
+			return status.Error(
+				codes.Internal,
+				"failed to call units service: "+err.Error(),
+			)
+		}
+		privileged = view.Unit.OwnerId == member.UserID
+	}
+	if !privileged {
+		return nil
+	}
+
+	if err := m.authorizer.RequireUnitPermission(ctx, member.UnitID, authz.PermissionAdministrator); err != nil {
+		if status.Code(err) == codes.PermissionDenied {
+			return status.Error(
+				codes.PermissionDenied,
+				"only unit owners and administrators can manage the owner, administrators or member managers",
+			)
+		}
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package members
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	membersv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/members/v1"
+	"github.com/milsim-tools/pincer/pkg/authz"
+	"github.com/oklog/ulid/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// transitions are the statuses each status of a member can change to.
+var transitions = map[membersv1.UnitMemberStatus][]membersv1.UnitMemberStatus{
+	// Members are created pending, when they apply, or approved, when the
+	// unit adds them itself.
+	membersv1.UnitMemberStatus_UNIT_MEMBER_STATUS_UNSPECIFIED: {
+		membersv1.UnitMemberStatus_UNIT_MEMBER_STATUS_PENDING,
+		membersv1.UnitMemberStatus_UNIT_MEMBER_STATUS_APPROVED,
+	},
+	membersv1.UnitMemberStatus_UNIT_MEMBER_STATUS_PENDING: {
+		membersv1.UnitMemberStatus_UNIT_MEMBER_STATUS_APPROVED,
+		membersv1.UnitMemberStatus_UNIT_MEMBER_STATUS_REJECTED,
+		membersv1.UnitMemberStatus_UNIT_MEMBER_STATUS_BANNED,
+	},
+	// Rejected members can apply again.
+	membersv1.UnitMemberStatus_UNIT_MEMBER_STATUS_REJECTED: {
+		membersv1.UnitMemberStatus_UNIT_MEMBER_STATUS_PENDING,
+		membersv1.UnitMemberStatus_UNIT_MEMBER_STATUS_BANNED,
+	},
+	membersv1.UnitMemberStatus_UNIT_MEMBER_STATUS_APPROVED: {
+		membersv1.UnitMemberStatus_UNIT_MEMBER_STATUS_BANNED,
+	},
+	// Lifting a ban restores the membership.
+	membersv1.UnitMemberStatus_UNIT_MEMBER_STATUS_BANNED: {
+		membersv1.UnitMemberStatus_UNIT_MEMBER_STATUS_APPROVED,
+	},
+}
+
+// checkTransition returns a FailedPrecondition status error unless a member
+// can change from one status to the other.
+func checkTransition(from, to membersv1.UnitMemberStatus) error {
+	if !slices.Contains(transitions[from], to) {
+		return status.Error(
+			codes.FailedPrecondition,
+			"cannot change the status of the member from "+from.String()+" to "+to.String(),
+		)
+	}
+	return nil
+}
+
+// transition changes the status of the member, recording the transition.
+func transition(ctx context.Context, tx *gorm.DB, member *MembersUnitMember, to membersv1.UnitMemberStatus, at time.Time) error {
+	if err := gorm.G[MembersUnitMemberTransition](tx).Create(ctx, &MembersUnitMemberTransition{
+		ID:         ulid.Make().String(),
+		MemberID:   member.ID,
+		FromStatus: member.Status,
+		ToStatus:   int32(to),
+		CreatedAt:  at,
+	}); err != nil {
+		return err
+	}
+
+	member.Status = int32(to)
+	member.StatusChangedAt = at
+	return nil
+}
+
+// Permissions returns the permissions a member holds given its status. Only
+// approved members hold their permissions, and banned members hold nothing
+// but PermissionBanned.
+func Permissions(member *membersv1.UnitMember) int32 {
+	switch member.Status {
+	case membersv1.UnitMemberStatus_UNIT_MEMBER_STATUS_APPROVED:
+		return member.Permissions
+	case membersv1.UnitMemberStatus_UNIT_MEMBER_STATUS_BANNED:
+		return authz.PermissionBanned
+	default:
+		return 0
+	}
+}
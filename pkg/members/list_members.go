@@ -2,12 +2,66 @@ package members
 
 import (
 	"context"
+	"errors"
 
+	"github.com/milsim-tools/pincer/internal/helpers"
 	membersv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/members/v1"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
 )
 
+// filter translates the filters of ListMembers.
+var filter = helpers.MustNewFilter(&membersv1.UnitMember{}, helpers.FilterColumns{
+	"unit_id":           "unit_id",
+	"user_id":           "user_id",
+	"status":            "status",
+	"status_changed_at": "status_changed_at",
+	"created_at":        "created_at",
+	"updated_at":        "updated_at",
+})
+
 func (m *Members) ListMembers(ctx context.Context, req *membersv1.ListMembersRequest) (*membersv1.ListMembersResponse, error) {
-	return &membersv1.ListMembersResponse{}, status.Errorf(codes.Unimplemented, "method ListMembers not implemented")
+	where, err := filter.Parse(req.Filter)
+	if err != nil {
+		return &membersv1.ListMembersResponse{}, err
+	}
+
+	qb := gorm.G[MembersUnitMember](m.db.Db).Select("*")
+	if where != nil {
+		qb = qb.Where(where)
+	}
+	if req.UnitId != "" {
+		qb = qb.Where("unit_id = ?", req.UnitId)
+	}
+	if req.UserId != "" {
+		qb = qb.Where("user_id = ?", req.UserId)
+	}
+
+	members, next, err := helpers.Paginate(ctx, m.pages, qb, helpers.Page{
+		Size:    int(req.PageSize),
+		Token:   req.PageToken,
+		Filters: []any{req.UnitId, req.UserId, req.Filter},
+	})
+	if err != nil {
+		if errors.Is(err, helpers.ErrInvalidPageToken) {
+			return &membersv1.ListMembersResponse{}, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return &membersv1.ListMembersResponse{}, status.Error(
+			codes.Internal,
+			"failed to query members: "+err.Error(),
+		)
+	}
+
+	var memberViews []*membersv1.UnitMember
+	for _, member := range members {
+		memberViews = append(memberViews, member.Proto())
+	}
+
+	resp := &membersv1.ListMembersResponse{
+		Members:       memberViews,
+		NextPageToken: next,
+	}
+
+	return resp, nil
 }
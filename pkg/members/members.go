@@ -1,16 +1,17 @@
 package members
 
 import (
+	"context"
 	"log/slog"
 
 	"github.com/grafana/dskit/services"
+	"github.com/milsim-tools/pincer/internal/helpers"
 	membersv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/members/v1"
 	unitsv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/units/v1"
 	usersv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/users/v1"
+	"github.com/milsim-tools/pincer/pkg/authz"
 	"github.com/milsim-tools/pincer/pkg/db"
 	"github.com/urfave/cli/v2"
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
 const (
@@ -22,12 +23,14 @@ var Flags = []cli.Flag{
 	&cli.StringFlag{
 		Name:    FlagUsersGrpcAddr,
 		Value:   "localhost:9000",
+		Usage:   "The users service, used when the users module runs in another process.",
 		EnvVars: []string{"PINCER_MEMBERS_USERS_GRPC_ADDR"},
 	},
 
 	&cli.StringFlag{
 		Name:    FlagUnitsGrpcAddr,
 		Value:   "localhost:9000",
+		Usage:   "The units service, used when the units module runs in another process.",
 		EnvVars: []string{"PINCER_MEMBERS_UNITS_GRPC_ADDR"},
 	},
 }
@@ -53,7 +56,9 @@ type Members struct {
 	cfg    Config
 	logger *slog.Logger
 
-	db *db.Db
+	db         *db.Db
+	pages      *helpers.Paginator
+	authorizer *authz.Authorizer
 
 	users usersv1.UsersServiceClient
 	units unitsv1.UnitsServiceClient
@@ -63,52 +68,27 @@ func New(
 	logger *slog.Logger,
 	cfg Config,
 	db *db.Db,
+	pages *helpers.Paginator,
+	authorizer *authz.Authorizer,
+	users usersv1.UsersServiceClient,
+	units unitsv1.UnitsServiceClient,
 ) (*Members, error) {
 	u := &Members{
-		cfg:    cfg,
-		logger: logger,
-		db:     db,
+		cfg:        cfg,
+		logger:     logger,
+		db:         db,
+		pages:      pages,
+		authorizer: authorizer,
+		users:      users,
+		units:      units,
 	}
 
-	if err := db.Db.AutoMigrate(&MembersUnitMember{}); err != nil {
-		return nil, err
-	}
-
-	u.Service = services.NewIdleService(nil, nil)
+	u.Service = services.NewIdleService(u.starting, nil)
 
 	return u, nil
 }
 
-func (u *Members) UsersClient() (usersv1.UsersServiceClient, error) {
-	if u.users != nil {
-		return u.users, nil
-	}
-
-	usersConn, err := grpc.NewClient(u.cfg.UsersGrpcAddr, grpc.WithTransportCredentials(
-		insecure.NewCredentials(),
-	))
-	if err != nil {
-		return nil, err
-	}
-	users := usersv1.NewUsersServiceClient(usersConn)
-
-	u.users = users
-	return u.users, nil
-}
-
-func (u *Members) UnitsClient() (unitsv1.UnitsServiceClient, error) {
-	if u.units != nil {
-		return u.units, nil
-	}
-
-	unitsConn, err := grpc.NewClient(u.cfg.UnitsGrpcAddr, grpc.WithTransportCredentials(
-		insecure.NewCredentials(),
-	))
-	if err != nil {
-		return nil, err
-	}
-	units := unitsv1.NewUnitsServiceClient(unitsConn)
-
-	u.units = units
-	return u.units, nil
+// starting migrates the database once it is reachable.
+func (s *Members) starting(ctx context.Context) error {
+	return s.db.Migrate(ctx, Migrations)
 }
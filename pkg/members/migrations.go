@@ -0,0 +1,17 @@
+package members
+
+import (
+	"embed"
+
+	"github.com/milsim-tools/pincer/pkg/db/migrate"
+)
+
+//go:embed migrations/*.sql
+var migrationsFS embed.FS
+
+// Migrations are the schema migrations of the members module.
+var Migrations = migrate.Source{
+	Module: "members",
+	FS:     migrationsFS,
+	Dir:    "migrations",
+}
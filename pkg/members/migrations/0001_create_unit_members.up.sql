@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS members_unit_members (
+  id text PRIMARY KEY,
+  created_at timestamptz,
+  updated_at timestamptz,
+  unit_id text NOT NULL,
+  user_id text NOT NULL,
+  permissions integer NOT NULL,
+  status integer NOT NULL
+);
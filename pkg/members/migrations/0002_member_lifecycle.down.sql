@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS members_unit_member_transitions;
+DROP INDEX IF EXISTS idx_members_unit_members_unit_user;
+INSERT INTO members_unit_members SELECT * FROM members_unit_members_duplicates;
+DROP TABLE IF EXISTS members_unit_members_duplicates;
+ALTER TABLE members_unit_members DROP COLUMN IF EXISTS status_changed_at;
+ALTER TABLE members_unit_members ALTER COLUMN status DROP DEFAULT;
@@ -0,0 +1,27 @@
+-- Members were never created with a status, as the default was not applied.
+-- SQLite cannot change the default of a column, the model sets it instead.
+UPDATE members_unit_members SET status = 1 WHERE status = 0;
+
+ALTER TABLE members_unit_members ADD COLUMN status_changed_at datetime;
+UPDATE members_unit_members SET status_changed_at = created_at WHERE status_changed_at IS NULL;
+
+-- Keep the oldest of any duplicate members before making them unique. The
+-- others are moved to members_unit_members_duplicates, for operators to
+-- resolve, and are restored by the down migration.
+CREATE TABLE IF NOT EXISTS members_unit_members_duplicates AS
+SELECT * FROM members_unit_members
+WHERE id NOT IN (SELECT MIN(id) FROM members_unit_members GROUP BY unit_id, user_id);
+
+DELETE FROM members_unit_members WHERE id IN (SELECT id FROM members_unit_members_duplicates);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_members_unit_members_unit_user ON members_unit_members (unit_id, user_id);
+
+CREATE TABLE IF NOT EXISTS members_unit_member_transitions (
+  id text PRIMARY KEY,
+  member_id text NOT NULL,
+  from_status integer NOT NULL,
+  to_status integer NOT NULL,
+  created_at datetime
+);
+
+CREATE INDEX IF NOT EXISTS idx_members_unit_member_transitions_member_id ON members_unit_member_transitions (member_id);
@@ -1,23 +1,43 @@
 package members
 
 import (
+	"time"
+
 	"github.com/milsim-tools/pincer/internal/models"
 	membersv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/members/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type MembersUnitMember struct {
 	models.Model
 
-	UnitID      string `gorm:"notNull"`
-	UserID      string `gorm:"notNull"`
-	Permissions int32  `gorm:"notNull"`
-	Status      int32  `gorm:"notNull;default=1"`
+	UnitID          string `gorm:"notNull;uniqueIndex:idx_members_unit_members_unit_user"`
+	UserID          string `gorm:"notNull;uniqueIndex:idx_members_unit_members_unit_user"`
+	Permissions     int32  `gorm:"notNull"`
+	Status          int32  `gorm:"notNull;default:1"`
+	StatusChangedAt time.Time
 }
 
 func (u MembersUnitMember) Proto() *membersv1.UnitMember {
 	return &membersv1.UnitMember{
-		UnitId:      u.UnitID,
-		UserId:      u.UserID,
-		Permissions: u.Permissions,
+		Id:              u.ID,
+		UnitId:          u.UnitID,
+		UserId:          u.UserID,
+		Permissions:     u.Permissions,
+		Status:          membersv1.UnitMemberStatus(u.Status),
+		CreatedAt:       timestamppb.New(u.CreatedAt),
+		UpdatedAt:       timestamppb.New(u.UpdatedAt),
+		StatusChangedAt: timestamppb.New(u.StatusChangedAt),
 	}
 }
+
+// MembersUnitMemberTransition records a change of the status of a member. A
+// member's first transition is from UNIT_MEMBER_STATUS_UNSPECIFIED, when it is
+// created.
+type MembersUnitMemberTransition struct {
+	ID         string `gorm:"primaryKey"`
+	MemberID   string `gorm:"notNull;index"`
+	FromStatus int32  `gorm:"notNull"`
+	ToStatus   int32  `gorm:"notNull"`
+	CreatedAt  time.Time
+}
@@ -2,12 +2,88 @@ package members
 
 import (
 	"context"
+	"errors"
+	"strings"
+	"time"
 
+	"github.com/milsim-tools/pincer/internal/helpers"
 	membersv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/members/v1"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
 )
 
 func (m *Members) UpdateMember(ctx context.Context, req *membersv1.UpdateMemberRequest) (*membersv1.UnitMember, error) {
-	return &membersv1.UnitMember{}, status.Errorf(codes.Unimplemented, "method UpdateMember not implemented")
+	member, err := gorm.G[MembersUnitMember](m.db.Db).
+		Where("unit_id = ? AND user_id = ?", req.Member.UnitId, req.Member.UserId).
+		First(ctx)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &membersv1.UnitMember{}, status.Error(
+				codes.NotFound,
+				"member not found",
+			)
+		}
+
+		return &membersv1.UnitMember{}, status.Error(
+			codes.Internal,
+			"failed to query member: "+err.Error(),
+		)
+	}
+
+	previousStatus := membersv1.UnitMemberStatus(member.Status)
+	previousPermissions := member.Permissions
+	newStatus := previousStatus
+
+	// Paths are accepted both relative to the member, as the REST gateway
+	// sends them, and prefixed with `member.`.
+	for _, path := range req.UpdateMask.GetPaths() {
+		switch strings.TrimPrefix(path, "member.") {
+		case "permissions":
+			member.Permissions = req.Member.Permissions
+		case "status":
+			newStatus = req.Member.Status
+		case "unit_id", "user_id":
+			return &membersv1.UnitMember{}, helpers.InvalidArgument("update_mask", path+" cannot be updated")
+		default:
+			return &membersv1.UnitMember{}, helpers.InvalidArgument("update_mask", "unknown field "+path)
+		}
+	}
+
+	// Taking permissions away is held to the same rules as granting them.
+	if err := m.checkGrant(ctx, member.UnitID, member.Permissions^previousPermissions); err != nil {
+		return &membersv1.UnitMember{}, err
+	}
+
+	if newStatus != previousStatus {
+		if err := checkTransition(previousStatus, newStatus); err != nil {
+			return &membersv1.UnitMember{}, err
+		}
+
+		if err := m.checkManage(ctx, member); err != nil {
+			return &membersv1.UnitMember{}, err
+		}
+	}
+
+	err = m.db.Db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if newStatus != previousStatus {
+			if err := transition(ctx, tx, &member, newStatus, time.Now()); err != nil {
+				return err
+			}
+		}
+
+		// Selecting the columns also writes permissions cleared to zero.
+		_, err := gorm.G[MembersUnitMember](tx).
+			Select("permissions", "status", "status_changed_at", "updated_at").
+			Updates(ctx, member)
+		return err
+	})
+	if err != nil {
+		return &membersv1.UnitMember{}, status.Error(
+			codes.Internal,
+			"failed to update member: "+err.Error(),
+		)
+	}
+
+	return member.Proto(), nil
 }
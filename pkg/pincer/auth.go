@@ -0,0 +1,181 @@
+package pincer
+
+import (
+	"context"
+	"slices"
+	"strconv"
+
+	membersv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/members/v1"
+	unitsv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/units/v1"
+	usersv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/users/v1"
+	"github.com/milsim-tools/pincer/pkg/authz"
+	"github.com/milsim-tools/pincer/pkg/members"
+	"github.com/milsim-tools/pincer/pkg/users"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// resolveIdentity links authenticated callers to users, using the users module
+// directly when it runs in this process and the users service otherwise.
+func (p *Pincer) resolveIdentity(ctx context.Context, claims authz.Claims) (string, error) {
+	if p.Users != nil {
+		return p.Users.ResolveIdentity(ctx, claims)
+	}
+
+	conn, err := p.clients.Conn(p.Config.Server.Auth.UsersGrpcAddr)
+	if err != nil {
+		return "", err
+	}
+
+	resolver := users.RemoteIdentityResolver{
+		Client: usersv1.NewUsersServiceClient(conn),
+	}
+
+	return resolver.ResolveIdentity(ctx, claims)
+}
+
+// authzStore implements authz.Store using the units and members modules,
+// calling their services when they run in another process.
+type authzStore struct {
+	p *Pincer
+}
+
+// serviceContext returns ctx carrying the service token, so that calls made
+// for authorization decisions do not depend on the caller's credentials,
+// which requests such as calendar feed fetches do not have.
+func (s authzStore) serviceContext(ctx context.Context) context.Context {
+	token := s.p.Config.Server.Auth.ServiceToken
+	if token == "" {
+		return ctx
+	}
+
+	return metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+token)
+}
+
+func (s authzStore) UnitOwner(ctx context.Context, unitID string) (string, error) {
+	if s.p.Units != nil {
+		return s.p.Units.UnitOwner(ctx, unitID)
+	}
+
+	conn, err := s.p.clients.Conn(s.p.Config.Authz.UnitsGrpcAddr)
+	if err != nil {
+		return "", err
+	}
+
+	view, err := unitsv1.NewUnitsServiceClient(conn).GetUnit(s.serviceContext(ctx), &unitsv1.GetUnitRequest{
+		Value: &unitsv1.GetUnitRequest_Id{Id: unitID},
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return "", err
+		}
+		return "", status.Error(codes.Internal, "failed to call units service: "+err.Error())
+	}
+
+	return view.Unit.OwnerId, nil
+}
+
+func (s authzStore) MemberPermissions(ctx context.Context, unitID string, userID string) (int32, bool, error) {
+	if s.p.Members != nil {
+		return s.p.Members.MemberPermissions(ctx, unitID, userID)
+	}
+
+	conn, err := s.p.clients.Conn(s.p.Config.Authz.MembersGrpcAddr)
+	if err != nil {
+		return 0, false, err
+	}
+
+	member, err := membersv1.NewMembersServiceClient(conn).GetMember(s.serviceContext(ctx), &membersv1.GetMemberRequest{
+		UnitId: unitID,
+		UserId: userID,
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return 0, false, nil
+		}
+		return 0, false, err
+	}
+
+	return members.Permissions(member), true, nil
+}
+
+func (s authzStore) UserUnits(ctx context.Context, userID string) ([]string, error) {
+	owned, err := s.ownedUnits(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	joined, err := s.memberUnits(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	units := append(owned, joined...)
+	slices.Sort(units)
+	return slices.Compact(units), nil
+}
+
+func (s authzStore) ownedUnits(ctx context.Context, userID string) ([]string, error) {
+	if s.p.Units != nil {
+		return s.p.Units.OwnedUnits(ctx, userID)
+	}
+
+	conn, err := s.p.clients.Conn(s.p.Config.Authz.UnitsGrpcAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	client := unitsv1.NewUnitsServiceClient(conn)
+	req := &unitsv1.ListUnitsRequest{
+		PageSize: 100,
+		Filter:   "owner_id == " + strconv.Quote(userID),
+	}
+
+	var units []string
+	for {
+		resp, err := client.ListUnits(s.serviceContext(ctx), req)
+		if err != nil {
+			return nil, status.Error(codes.Internal, "failed to call units service: "+err.Error())
+		}
+		for _, view := range resp.Units {
+			units = append(units, view.Unit.Id)
+		}
+		if resp.NextPageToken == "" {
+			return units, nil
+		}
+		req.PageToken = resp.NextPageToken
+	}
+}
+
+func (s authzStore) memberUnits(ctx context.Context, userID string) ([]string, error) {
+	if s.p.Members != nil {
+		return s.p.Members.MemberUnits(ctx, userID)
+	}
+
+	conn, err := s.p.clients.Conn(s.p.Config.Authz.MembersGrpcAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	client := membersv1.NewMembersServiceClient(conn)
+	req := &membersv1.ListMembersRequest{
+		UserId:   userID,
+		PageSize: 100,
+	}
+
+	var units []string
+	for {
+		resp, err := client.ListMembers(s.serviceContext(ctx), req)
+		if err != nil {
+			return nil, err
+		}
+		for _, member := range resp.Members {
+			units = append(units, member.UnitId)
+		}
+		if resp.NextPageToken == "" {
+			return units, nil
+		}
+		req.PageToken = resp.NextPageToken
+	}
+}
@@ -0,0 +1,15 @@
+package pincer
+
+import (
+	"google.golang.org/grpc"
+)
+
+// moduleConn returns a connection to the given module. Modules running in this
+// process are called in-process, and others are dialled at addr.
+func (p *Pincer) moduleConn(module string, addr string) (grpc.ClientConnInterface, error) {
+	if p.localModules[module] {
+		return p.Server.InProcessConn(), nil
+	}
+
+	return p.clients.Conn(addr)
+}
@@ -0,0 +1,45 @@
+package pincer
+
+import (
+	"github.com/grafana/dskit/services"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var moduleStates = []services.State{
+	services.New,
+	services.Starting,
+	services.Running,
+	services.Stopping,
+	services.Terminated,
+	services.Failed,
+}
+
+var moduleStateDesc = prometheus.NewDesc(
+	"pincer_module_state",
+	"The state of each running module, set to 1 for the state it is currently in.",
+	[]string{"module", "state"},
+	nil,
+)
+
+// moduleStateCollector reports the state of every module initialised by the
+// module manager.
+type moduleStateCollector struct {
+	p *Pincer
+}
+
+func (c moduleStateCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- moduleStateDesc
+}
+
+func (c moduleStateCollector) Collect(ch chan<- prometheus.Metric) {
+	for module, service := range c.p.serviceMap {
+		current := service.State()
+		for _, state := range moduleStates {
+			value := 0.0
+			if state == current {
+				value = 1
+			}
+			ch <- prometheus.MustNewConstMetric(moduleStateDesc, prometheus.GaugeValue, value, module, state.String())
+		}
+	}
+}
@@ -0,0 +1,19 @@
+package pincer
+
+import (
+	"github.com/milsim-tools/pincer/pkg/applications"
+	"github.com/milsim-tools/pincer/pkg/db/migrate"
+	"github.com/milsim-tools/pincer/pkg/events"
+	"github.com/milsim-tools/pincer/pkg/members"
+	"github.com/milsim-tools/pincer/pkg/units"
+	"github.com/milsim-tools/pincer/pkg/users"
+)
+
+// Migrations are the schema migrations of every module that has tables.
+var Migrations = []migrate.Source{
+	users.Migrations,
+	units.Migrations,
+	members.Migrations,
+	applications.Migrations,
+	events.Migrations,
+}
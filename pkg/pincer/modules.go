@@ -3,67 +3,243 @@ package pincer
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	"github.com/grafana/dskit/services"
+	applicationsv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/applications/v1"
+	eventsv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/events/v1"
 	membersv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/members/v1"
 	unitsv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/units/v1"
 	usersv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/users/v1"
+	"github.com/milsim-tools/pincer/pkg/applications"
 	"github.com/milsim-tools/pincer/pkg/db"
+	"github.com/milsim-tools/pincer/pkg/events"
 	"github.com/milsim-tools/pincer/pkg/members"
 	"github.com/milsim-tools/pincer/pkg/server"
+	"github.com/milsim-tools/pincer/pkg/tracing"
 	"github.com/milsim-tools/pincer/pkg/units"
 	"github.com/milsim-tools/pincer/pkg/users"
 )
 
 const (
-	Server = "server"
-	Db     = "db"
+	Server  = "server"
+	Tracing = "tracing"
+	Db      = "db"
 
-	Units   = "units"
-	Users   = "users"
-	Members = "members"
+	Units        = "units"
+	Users        = "users"
+	Members      = "members"
+	Applications = "applications"
+	Events       = "events"
 
 	All     = "all"
 	Backend = "backend"
 )
 
 func (p *Pincer) initUnits() (services.Service, error) {
-	units, err := units.New(p.logger.With("module", Units), p.Config.Units, p.Db)
+	usersConn, err := p.moduleConn(Users, p.Config.Units.UsersGrpcAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	membersConn, err := p.moduleConn(Members, p.Config.Units.MembersGrpcAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	applicationsConn, err := p.moduleConn(Applications, p.Config.Units.ApplicationsGrpcAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	eventsConn, err := p.moduleConn(Events, p.Config.Units.EventsGrpcAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	logger := p.logger.With("module", Units)
+
+	db, err := p.Db.Module(Units, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	units, err := units.New(
+		logger,
+		p.Config.Units,
+		db,
+		p.pages,
+		usersv1.NewUsersServiceClient(usersConn),
+		membersv1.NewMembersServiceClient(membersConn),
+		applicationsv1.NewApplicationsServiceClient(applicationsConn),
+		eventsv1.NewEventsServiceClient(eventsConn),
+	)
 	if err != nil {
 		return nil, err
 	}
 	p.Units = units
 
-	unitsv1.RegisterUnitsServiceServer(p.Server.GRPCServer, p.Units)
+	unitsv1.RegisterUnitsServiceServer(p.Server, p.Units)
+	if err := p.Server.RegisterGateway(unitsv1.RegisterUnitsServiceHandler); err != nil {
+		return nil, err
+	}
 
 	return p.Units, nil
 }
 
 func (p *Pincer) initUsers() (_ services.Service, err error) {
-	p.Users, err = users.New(p.logger.With("module", Users), p.Config.Users, p.Db)
+	logger := p.logger.With("module", Users)
+
+	db, err := p.Db.Module(Users, logger)
 	if err != nil {
 		return nil, err
 	}
 
-	usersv1.RegisterUsersServiceServer(p.Server.GRPCServer, p.Users)
+	p.Users, err = users.New(logger, p.Config.Users, db, p.pages, p.Authorizer)
+	if err != nil {
+		return nil, err
+	}
+
+	usersv1.RegisterUsersServiceServer(p.Server, p.Users)
+	if err := p.Server.RegisterGateway(usersv1.RegisterUsersServiceHandler); err != nil {
+		return nil, err
+	}
 
 	return p.Users, nil
 }
 
 func (p *Pincer) initMembers() (services.Service, error) {
-	members, err := members.New(p.logger.With("module", Members), p.Config.Members, p.Db)
+	usersConn, err := p.moduleConn(Users, p.Config.Members.UsersGrpcAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	unitsConn, err := p.moduleConn(Units, p.Config.Members.UnitsGrpcAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	logger := p.logger.With("module", Members)
+
+	db, err := p.Db.Module(Members, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := members.New(
+		logger,
+		p.Config.Members,
+		db,
+		p.pages,
+		p.Authorizer,
+		usersv1.NewUsersServiceClient(usersConn),
+		unitsv1.NewUnitsServiceClient(unitsConn),
+	)
 	if err != nil {
 		return nil, err
 	}
 	p.Members = members
 
-	membersv1.RegisterMembersServiceServer(p.Server.GRPCServer, p.Members)
+	membersv1.RegisterMembersServiceServer(p.Server, p.Members)
+	if err := p.Server.RegisterGateway(membersv1.RegisterMembersServiceHandler); err != nil {
+		return nil, err
+	}
+
+	return p.Members, nil
+}
 
-	return p.Units, nil
+func (p *Pincer) initApplications() (services.Service, error) {
+	usersConn, err := p.moduleConn(Users, p.Config.Applications.UsersGrpcAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	unitsConn, err := p.moduleConn(Units, p.Config.Applications.UnitsGrpcAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	membersConn, err := p.moduleConn(Members, p.Config.Applications.MembersGrpcAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	logger := p.logger.With("module", Applications)
+
+	db, err := p.Db.Module(Applications, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	applications, err := applications.New(
+		logger,
+		p.Config.Applications,
+		db,
+		p.pages,
+		usersv1.NewUsersServiceClient(usersConn),
+		unitsv1.NewUnitsServiceClient(unitsConn),
+		membersv1.NewMembersServiceClient(membersConn),
+	)
+	if err != nil {
+		return nil, err
+	}
+	p.Applications = applications
+
+	applicationsv1.RegisterApplicationsServiceServer(p.Server, p.Applications)
+	if err := p.Server.RegisterGateway(applicationsv1.RegisterApplicationsServiceHandler); err != nil {
+		return nil, err
+	}
+
+	return p.Applications, nil
+}
+
+func (p *Pincer) initEvents() (services.Service, error) {
+	unitsConn, err := p.moduleConn(Units, p.Config.Events.UnitsGrpcAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	logger := p.logger.With("module", Events)
+
+	db, err := p.Db.Module(Events, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := events.New(
+		logger,
+		p.Config.Events,
+		db,
+		p.pages,
+		p.Authorizer,
+		unitsv1.NewUnitsServiceClient(unitsConn),
+	)
+	if err != nil {
+		return nil, err
+	}
+	p.Events = events
+
+	eventsv1.RegisterEventsServiceServer(p.Server, p.Events)
+	if err := p.Server.RegisterGateway(eventsv1.RegisterEventsServiceHandler); err != nil {
+		return nil, err
+	}
+	p.Events.RegisterFeeds(p.Server.HTTP)
+
+	return p.Events, nil
+}
+
+func (p *Pincer) initTracing() (services.Service, error) {
+	tracing, err := tracing.New(p.logger.With("module", Tracing), p.Config.Tracing, p.Config.Version)
+	if err != nil {
+		return nil, err
+	}
+	p.Tracing = tracing
+
+	return p.Tracing, nil
 }
 
 func (p *Pincer) initDb() (services.Service, error) {
-	db, err := db.New(p.logger.With("module", Db), p.Config.Db)
+	db, err := db.New(p.logger.With("module", Db), p.Config.Db, p.Registry)
 	if err != nil {
 		return nil, err
 	}
@@ -73,17 +249,19 @@ func (p *Pincer) initDb() (services.Service, error) {
 }
 
 func (p *Pincer) initServer() (services.Service, error) {
-	server, err := server.New(p.logger.With("module", Server), p.Config.Server)
+	server, err := server.New(p.logger.With("module", Server), p.Config.Server, server.IdentityResolverFunc(p.resolveIdentity), p.Authorizer, p.Registry)
 	if err != nil {
 		return nil, err
 	}
 	p.Server = server
 
 	servicesToWaitFor := func() []services.Service {
+		deps := p.ModuleManager.DependenciesForModule(Server)
 		svs := []services.Service(nil)
 		for m, s := range p.serviceMap {
-			// Server should not wait for itself.
-			if m != Server {
+			// Server should not wait for itself, nor for the modules it
+			// depends on, as those wait for the server to stop first.
+			if m != Server && !slices.Contains(deps, m) {
 				svs = append(svs, s)
 			}
 		}
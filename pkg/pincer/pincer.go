@@ -11,13 +11,24 @@ import (
 
 	"github.com/grafana/dskit/grpcutil"
 	"github.com/grafana/dskit/services"
+	"github.com/milsim-tools/pincer/internal/helpers"
 	"github.com/milsim-tools/pincer/internal/modules"
 	"github.com/milsim-tools/pincer/internal/signals"
+	"github.com/milsim-tools/pincer/pkg/api/openapi"
+	"github.com/milsim-tools/pincer/pkg/applications"
+	"github.com/milsim-tools/pincer/pkg/authz"
 	"github.com/milsim-tools/pincer/pkg/db"
+	"github.com/milsim-tools/pincer/pkg/events"
+	"github.com/milsim-tools/pincer/pkg/grpcclient"
 	"github.com/milsim-tools/pincer/pkg/members"
 	"github.com/milsim-tools/pincer/pkg/server"
+	"github.com/milsim-tools/pincer/pkg/tracing"
 	"github.com/milsim-tools/pincer/pkg/units"
 	"github.com/milsim-tools/pincer/pkg/users"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/swaggest/swgui/v5emb"
 	"github.com/urfave/cli/v2"
 	"go.uber.org/atomic"
 	"google.golang.org/grpc/health/grpc_health_v1"
@@ -26,6 +37,9 @@ import (
 const (
 	FlagTarget        = "target"
 	FlagShutdownDelay = "shutdown-delay"
+	FlagAPIDocs       = "api-docs"
+
+	FlagPageTokenSecret = "page-token-secret"
 )
 
 var Flags = []cli.Flag{
@@ -42,27 +56,54 @@ var Flags = []cli.Flag{
 		Usage:   "How long to wait before shutting down services",
 		EnvVars: []string{"PINCER_SHUTDOWN_DELAY"},
 	},
+
+	&cli.BoolFlag{
+		Name:    FlagAPIDocs,
+		Value:   true,
+		Usage:   "Serve the OpenAPI document and API explorer from the HTTP listener",
+		EnvVars: []string{"PINCER_API_DOCS"},
+	},
+
+	&cli.StringFlag{
+		Name:    FlagPageTokenSecret,
+		Usage:   "The secret page tokens are signed with. Share it between processes so tokens stay valid across them and restarts; a random one is used if unset",
+		EnvVars: []string{"PINCER_PAGE_TOKEN_SECRET"},
+	},
 }
 
 func init() {
 	Flags = append(Flags, server.Flags...)
+	Flags = append(Flags, server.AuthFlags...)
+	Flags = append(Flags, grpcclient.Flags...)
+	Flags = append(Flags, authz.Flags...)
+	Flags = append(Flags, tracing.Flags...)
 	Flags = append(Flags, db.Flags...)
 	Flags = append(Flags, units.Flags...)
 	Flags = append(Flags, users.Flags...)
 	Flags = append(Flags, members.Flags...)
+	Flags = append(Flags, applications.Flags...)
+	Flags = append(Flags, events.Flags...)
 }
 
 type Config struct {
 	Target        []string
 	ShutdownDelay time.Duration
+	APIDocs       bool
 	Version       string
 
-	Server server.Config
-	Db     db.Config
+	PageTokenSecret string
 
-	Units   units.Config
-	Users   users.Config
-	Members members.Config
+	Server     server.Config
+	GRPCClient grpcclient.Config
+	Authz      authz.Config
+	Tracing    tracing.Config
+	Db         db.Config
+
+	Units        units.Config
+	Users        users.Config
+	Members      members.Config
+	Applications applications.Config
+	Events       events.Config
 }
 
 func ConfigFromFlags(version string, ctx *cli.Context) Config {
@@ -70,13 +111,20 @@ func ConfigFromFlags(version string, ctx *cli.Context) Config {
 
 	config.Target = ctx.StringSlice(FlagTarget)
 	config.ShutdownDelay = ctx.Duration(FlagShutdownDelay)
+	config.APIDocs = ctx.Bool(FlagAPIDocs)
 	config.Version = version
+	config.PageTokenSecret = ctx.String(FlagPageTokenSecret)
 
 	config.Server = server.ConfigFromFlags(ctx)
+	config.Authz = authz.ConfigFromFlags(ctx)
+	config.GRPCClient = grpcclient.ConfigFromFlags(ctx)
+	config.Tracing = tracing.ConfigFromFlags(ctx)
 	config.Db = db.ConfigFromFlags(ctx)
 	config.Units = units.ConfigFromFlags(ctx)
 	config.Users = users.ConfigFromFlags(ctx)
 	config.Members = members.ConfigFromFlags(ctx)
+	config.Applications = applications.ConfigFromFlags(ctx)
+	config.Events = events.ConfigFromFlags(ctx)
 
 	return config
 }
@@ -88,15 +136,25 @@ type Pincer struct {
 
 	ModuleManager *modules.Manager
 	serviceMap    map[string]services.Service
+	localModules  map[string]bool
 	deps          map[string][]string
 	SignalHandler *signals.Handler
 
-	Server *server.Server
-	Db     *db.Db
+	Server  *server.Server
+	Tracing *tracing.Tracing
+	Db      *db.Db
+
+	Units        *units.Units
+	Users        *users.Users
+	Members      *members.Members
+	Applications *applications.Applications
+	Events       *events.Events
+
+	Authorizer *authz.Authorizer
+	clients    *grpcclient.Factory
+	pages      *helpers.Paginator
 
-	Units   *units.Units
-	Users   *users.Users
-	Members *members.Members
+	Registry *prometheus.Registry
 }
 
 func New(logger *slog.Logger, cfg Config) (*Pincer, error) {
@@ -105,6 +163,25 @@ func New(logger *slog.Logger, cfg Config) (*Pincer, error) {
 		logger: logger,
 	}
 
+	pincer.clients = grpcclient.NewFactory(cfg.GRPCClient)
+	pincer.Authorizer = authz.NewAuthorizer(authzStore{pincer}, cfg.Server.Auth.Enabled())
+
+	if cfg.PageTokenSecret == "" {
+		logger.Warn("no page token secret is set, page tokens will not be valid across processes or restarts", "flag", FlagPageTokenSecret)
+	}
+	pages, err := helpers.NewPaginator(cfg.PageTokenSecret)
+	if err != nil {
+		return nil, err
+	}
+	pincer.pages = pages
+
+	pincer.Registry = prometheus.NewRegistry()
+	pincer.Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		moduleStateCollector{pincer},
+	)
+
 	if err := pincer.setupModuleManager(); err != nil {
 		return nil, err
 	}
@@ -116,22 +193,30 @@ func (p *Pincer) setupModuleManager() error {
 	mm := modules.NewManager(p.logger.WithGroup("module-manager"))
 
 	mm.RegisterModule(Server, p.initServer, modules.UserInvisibleModule)
+	mm.RegisterModule(Tracing, p.initTracing, modules.UserInvisibleModule)
 	mm.RegisterModule(Db, p.initDb, modules.UserInvisibleModule)
 
 	mm.RegisterModule(Units, p.initUnits)
 	mm.RegisterModule(Users, p.initUsers)
 	mm.RegisterModule(Members, p.initMembers)
+	mm.RegisterModule(Applications, p.initApplications)
+	mm.RegisterModule(Events, p.initEvents)
 
 	mm.RegisterModule(All, nil)
 	mm.RegisterModule(Backend, nil)
 
 	deps := map[string][]string{
-		Units:   {Db, Server},
-		Users:   {Db, Server},
-		Members: {Db, Server},
+		Server: {Tracing},
+		Db:     {Tracing},
+
+		Units:        {Db, Server},
+		Users:        {Db, Server},
+		Members:      {Db, Server},
+		Applications: {Db, Server},
+		Events:       {Db, Server},
 
 		// Groups
-		All:     {Units, Users},
+		All:     {Units, Users, Members, Applications, Events},
 		Backend: {},
 	}
 
@@ -150,6 +235,14 @@ func (p *Pincer) setupModuleManager() error {
 func (p *Pincer) Run(ctx context.Context) error {
 	startTime := time.Now()
 
+	p.localModules = map[string]bool{}
+	for _, target := range p.Config.Target {
+		p.localModules[target] = true
+		for _, dep := range p.ModuleManager.DependenciesForModule(target) {
+			p.localModules[dep] = true
+		}
+	}
+
 	serviceMap, err := p.ModuleManager.InitModuleServices(p.Config.Target...)
 	if err != nil {
 		return err
@@ -171,7 +264,13 @@ func (p *Pincer) Run(ctx context.Context) error {
 	shutdownRequested := atomic.NewBool(false)
 
 	p.Server.HTTP.Path("/ready").Methods("GET").Handler(p.readyHandler(sm, shutdownRequested))
-	grpc_health_v1.RegisterHealthServer(p.Server.GRPCServer, grpcutil.NewHealthCheck(sm))
+	p.Server.HTTP.Path("/metrics").Methods("GET").Handler(promhttp.HandlerFor(p.Registry, promhttp.HandlerOpts{}))
+	if p.Config.APIDocs {
+		if err := p.registerAPIDocs(); err != nil {
+			return err
+		}
+	}
+	grpc_health_v1.RegisterHealthServer(p.Server, grpcutil.NewHealthCheckFrom(grpcutil.WithManager(sm), p.databaseHealthy))
 
 	// Let's listen for events from this manager, and log them.
 	logHook := func(msg, key string) func() {
@@ -239,6 +338,9 @@ func (p *Pincer) Run(ctx context.Context) error {
 			}
 		}
 	}
+
+	_ = p.clients.Close()
+
 	return err
 }
 
@@ -261,7 +363,36 @@ func (p *Pincer) readyHandler(sm *services.Manager, shutdownRequested *atomic.Bo
 			http.Error(w, msg.String(), http.StatusServiceUnavailable)
 			return
 		}
+		if !p.databaseHealthy(r.Context()) {
+			http.Error(w, "database is unreachable", http.StatusServiceUnavailable)
+			return
+		}
 
 		http.Error(w, "ready", http.StatusOK)
 	}
 }
+
+// databaseHealthy reports whether the database is reachable, if any module
+// running in this process uses one.
+func (p *Pincer) databaseHealthy(context.Context) bool {
+	return p.Db == nil || p.Db.Healthy()
+}
+
+// registerAPIDocs serves the OpenAPI document for the REST gateway, and an API
+// explorer that renders it.
+func (p *Pincer) registerAPIDocs() error {
+	spec, err := openapi.Spec(p.Config.Version)
+	if err != nil {
+		return err
+	}
+
+	p.Server.HTTP.Path("/openapi.json").Methods("GET").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(spec)
+	})
+
+	p.Server.HTTP.Path("/docs").Handler(http.RedirectHandler("/docs/", http.StatusMovedPermanently))
+	p.Server.HTTP.PathPrefix("/docs/").Handler(v5emb.New("Pincer API", "/openapi.json", "/docs/"))
+
+	return nil
+}
@@ -0,0 +1,242 @@
+package server
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/go-jose/go-jose/v4"
+	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors"
+	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/auth"
+	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/selector"
+	"github.com/milsim-tools/pincer/pkg/authz"
+	"github.com/urfave/cli/v2"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	FlagAuthIssuer        = "auth-issuer"
+	FlagAuthAudience      = "auth-audience"
+	FlagAuthJWKSURL       = "auth-jwks-url"
+	FlagAuthJWKSFile      = "auth-jwks-file"
+	FlagAuthUsersGrpcAddr = "auth-users-grpc-addr"
+	FlagAuthServiceToken  = "auth-service-token"
+)
+
+var AuthFlags = []cli.Flag{
+	&cli.StringFlag{
+		Name:    FlagAuthIssuer,
+		Usage:   "The issuer bearer tokens must be issued by. Authentication is disabled if unset.",
+		EnvVars: []string{"PINCER_AUTH_ISSUER"},
+	},
+
+	&cli.StringFlag{
+		Name:    FlagAuthAudience,
+		Usage:   "The audience bearer tokens must be issued for. The audience is not checked if unset.",
+		EnvVars: []string{"PINCER_AUTH_AUDIENCE"},
+	},
+
+	&cli.StringFlag{
+		Name:    FlagAuthJWKSURL,
+		Usage:   "The URL of the JWKS used to verify token signatures. Discovered from the issuer if unset.",
+		EnvVars: []string{"PINCER_AUTH_JWKS_URL"},
+	},
+
+	&cli.StringFlag{
+		Name:    FlagAuthJWKSFile,
+		Usage:   "A local JWKS file used to verify token signatures, instead of fetching one.",
+		EnvVars: []string{"PINCER_AUTH_JWKS_FILE"},
+	},
+
+	&cli.StringFlag{
+		Name:    FlagAuthUsersGrpcAddr,
+		Value:   "localhost:9000",
+		Usage:   "The users service used to resolve callers when the users module runs elsewhere.",
+		EnvVars: []string{"PINCER_AUTH_USERS_GRPC_ADDR"},
+	},
+
+	&cli.StringFlag{
+		Name:    FlagAuthServiceToken,
+		Usage:   "A secret bearer token modules send each other for lookups made without a caller, such as serving calendar feeds. Required when modules run in separate processes.",
+		EnvVars: []string{"PINCER_AUTH_SERVICE_TOKEN"},
+	},
+}
+
+type AuthConfig struct {
+	Issuer        string
+	Audience      string
+	JWKSURL       string
+	JWKSFile      string
+	UsersGrpcAddr string
+	ServiceToken  string
+}
+
+func AuthConfigFromFlags(ctx *cli.Context) AuthConfig {
+	var config AuthConfig
+
+	config.Issuer = ctx.String(FlagAuthIssuer)
+	config.Audience = ctx.String(FlagAuthAudience)
+	config.JWKSURL = ctx.String(FlagAuthJWKSURL)
+	config.JWKSFile = ctx.String(FlagAuthJWKSFile)
+	config.UsersGrpcAddr = ctx.String(FlagAuthUsersGrpcAddr)
+	config.ServiceToken = ctx.String(FlagAuthServiceToken)
+
+	return config
+}
+
+// Enabled reports whether callers must authenticate.
+func (c AuthConfig) Enabled() bool {
+	return c.Issuer != ""
+}
+
+// IdentityResolver links the subject of a verified token to a Pincer user.
+type IdentityResolver interface {
+	// ResolveIdentity returns the ID of the user the claims belong to, or an
+	// empty string if there is no such user.
+	ResolveIdentity(ctx context.Context, claims authz.Claims) (string, error)
+}
+
+// IdentityResolverFunc adapts a function to an IdentityResolver.
+type IdentityResolverFunc func(ctx context.Context, claims authz.Claims) (string, error)
+
+func (f IdentityResolverFunc) ResolveIdentity(ctx context.Context, claims authz.Claims) (string, error) {
+	return f(ctx, claims)
+}
+
+// Authenticator verifies the bearer tokens of incoming requests, and places
+// the identity of the caller into the request context.
+type Authenticator struct {
+	logger       *slog.Logger
+	verifier     *oidc.IDTokenVerifier
+	resolver     IdentityResolver
+	serviceToken string
+}
+
+func NewAuthenticator(logger *slog.Logger, config AuthConfig, resolver IdentityResolver) (*Authenticator, error) {
+	verifierConfig := &oidc.Config{
+		ClientID:          config.Audience,
+		SkipClientIDCheck: config.Audience == "",
+		SupportedSigningAlgs: []string{
+			oidc.RS256, oidc.RS384, oidc.RS512,
+			oidc.ES256, oidc.ES384, oidc.ES512,
+			oidc.PS256, oidc.PS384, oidc.PS512,
+			oidc.EdDSA,
+		},
+	}
+
+	var verifier *oidc.IDTokenVerifier
+	switch {
+	case config.JWKSFile != "":
+		keySet, err := loadKeySet(config.JWKSFile)
+		if err != nil {
+			return nil, err
+		}
+		verifier = oidc.NewVerifier(config.Issuer, keySet, verifierConfig)
+	case config.JWKSURL != "":
+		keySet := oidc.NewRemoteKeySet(context.Background(), config.JWKSURL)
+		verifier = oidc.NewVerifier(config.Issuer, keySet, verifierConfig)
+	default:
+		provider, err := oidc.NewProvider(context.Background(), config.Issuer)
+		if err != nil {
+			return nil, fmt.Errorf("failed to discover issuer %s: %w", config.Issuer, err)
+		}
+		verifier = provider.Verifier(verifierConfig)
+	}
+
+	return &Authenticator{
+		logger:       logger,
+		verifier:     verifier,
+		resolver:     resolver,
+		serviceToken: config.ServiceToken,
+	}, nil
+}
+
+// loadKeySet reads a JWKS document from disk.
+func loadKeySet(path string) (*oidc.StaticKeySet, error) {
+	bytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var jwks jose.JSONWebKeySet
+	if err := json.Unmarshal(bytes, &jwks); err != nil {
+		return nil, fmt.Errorf("failed to parse JWKS file %s: %w", path, err)
+	}
+
+	keySet := &oidc.StaticKeySet{}
+	for _, key := range jwks.Keys {
+		if !key.IsPublic() {
+			key = key.Public()
+		}
+		keySet.PublicKeys = append(keySet.PublicKeys, key.Key)
+	}
+
+	return keySet, nil
+}
+
+// Authenticate verifies the bearer token of the request, returning a context
+// carrying the caller's identity.
+func (a *Authenticator) Authenticate(ctx context.Context) (context.Context, error) {
+	token, err := auth.AuthFromMD(ctx, "bearer")
+	if err != nil {
+		return nil, err
+	}
+
+	if a.serviceToken != "" && subtle.ConstantTimeCompare([]byte(token), []byte(a.serviceToken)) == 1 {
+		return authz.NewContext(ctx, authz.Identity{Service: true}), nil
+	}
+
+	idToken, err := a.verifier.Verify(ctx, token)
+	if err != nil {
+		a.logger.Debug("rejected bearer token", "err", err)
+		return nil, status.Error(codes.Unauthenticated, "invalid bearer token")
+	}
+
+	var claims authz.Claims
+	if err := idToken.Claims(&claims); err != nil {
+		return nil, status.Error(codes.Unauthenticated, "invalid bearer token claims")
+	}
+
+	userID, err := a.resolver.ResolveIdentity(ctx, claims)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to resolve identity: "+err.Error())
+	}
+
+	return authz.NewContext(ctx, authz.Identity{
+		UserID: userID,
+		Claims: claims,
+	}), nil
+}
+
+// UnaryServerInterceptor returns an interceptor that authenticates gRPC
+// requests.
+func (a *Authenticator) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return selector.UnaryServerInterceptor(
+		auth.UnaryServerInterceptor(a.Authenticate),
+		selector.MatchFunc(requiresAuthentication),
+	)
+}
+
+// StreamServerInterceptor returns an interceptor that authenticates gRPC
+// streams.
+func (a *Authenticator) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return selector.StreamServerInterceptor(
+		auth.StreamServerInterceptor(a.Authenticate),
+		selector.MatchFunc(requiresAuthentication),
+	)
+}
+
+// requiresAuthentication excludes infrastructure services (health checks and
+// reflection) from authentication.
+func requiresAuthentication(_ context.Context, callMeta interceptors.CallMeta) bool {
+	return callMeta.Service != grpc_health_v1.Health_ServiceDesc.ServiceName &&
+		!strings.HasPrefix(callMeta.Service, "grpc.reflection.")
+}
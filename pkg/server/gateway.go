@@ -0,0 +1,123 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/milsim-tools/pincer/internal/middleware"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// GatewayPathPrefix is the path prefix under which the REST gateway is
+// mounted on the HTTP router. It matches the prefix of every
+// `google.api.http` binding in the API definitions.
+const GatewayPathPrefix = "/v1/"
+
+// LocationKey is the metadata key of the location a requested resource has
+// moved to, see SetLocation.
+const LocationKey = "location"
+
+// GatewayRegisterFunc is the signature of the generated
+// `Register<Service>Handler` functions.
+type GatewayRegisterFunc func(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error
+
+// newGateway creates the grpc-gateway mux that translates REST calls into
+// gRPC calls against this server.
+func newGateway(logger *slog.Logger, instrument middleware.HTTPInstrument) *runtime.ServeMux {
+	marshaler := &runtime.HTTPBodyMarshaler{
+		Marshaler: &runtime.JSONPb{
+			MarshalOptions: protojson.MarshalOptions{
+				EmitUnpopulated: true,
+			},
+			UnmarshalOptions: protojson.UnmarshalOptions{
+				DiscardUnknown: true,
+			},
+		},
+	}
+
+	return runtime.NewServeMux(
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, marshaler),
+		runtime.WithErrorHandler(gatewayErrorHandler(logger)),
+		runtime.WithMiddlewares(instrument.GatewayMiddleware),
+		runtime.WithIncomingHeaderMatcher(gatewayIncomingHeader),
+		runtime.WithOutgoingHeaderMatcher(gatewayOutgoingHeader),
+		runtime.WithForwardResponseOption(gatewayRedirect),
+	)
+}
+
+// SetLocation tells the caller that the requested resource has moved
+// permanently to the REST path, such as after a rename. The resource is still
+// returned, while REST callers are redirected with a 301 Moved Permanently.
+func SetLocation(ctx context.Context, path string) error {
+	return grpc.SetHeader(ctx, metadata.Pairs(LocationKey, path))
+}
+
+// gatewayRedirect answers REST calls with a redirect to the location set by
+// SetLocation, if any.
+func gatewayRedirect(ctx context.Context, w http.ResponseWriter, _ proto.Message) error {
+	md, ok := runtime.ServerMetadataFromContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	if location := md.HeaderMD.Get(LocationKey); len(location) > 0 {
+		w.Header().Set("Location", location[0])
+		w.WriteHeader(http.StatusMovedPermanently)
+	}
+
+	return nil
+}
+
+// gatewayIncomingHeader passes the request ID header of REST requests on to
+// the gRPC call, along with the headers forwarded by default.
+func gatewayIncomingHeader(key string) (string, bool) {
+	if strings.EqualFold(key, middleware.RequestIDKey) {
+		return middleware.RequestIDKey, true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// gatewayOutgoingHeader returns the request ID of the gRPC call as a plain
+// header of the REST response, along with the headers forwarded by default.
+func gatewayOutgoingHeader(key string) (string, bool) {
+	if key == middleware.RequestIDKey {
+		return http.CanonicalHeaderKey(key), true
+	}
+	if key == LocationKey {
+		// Set by gatewayRedirect along with the status code.
+		return "", false
+	}
+	return fmt.Sprintf("%s%s", runtime.MetadataHeaderPrefix, key), true
+}
+
+// gatewayErrorHandler maps gRPC status errors onto HTTP responses using the
+// default gateway mapping, logging any that result in a server error.
+func gatewayErrorHandler(logger *slog.Logger) runtime.ErrorHandlerFunc {
+	return func(ctx context.Context, mux *runtime.ServeMux, m runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+		if code := runtime.HTTPStatusFromCode(status.Code(err)); code >= http.StatusInternalServerError {
+			logger.Warn("gateway request failed", "method", r.Method, "path", r.URL.Path, "status", code, "err", err)
+		}
+
+		runtime.DefaultHTTPErrorHandler(ctx, mux, m, w, r, err)
+	}
+}
+
+// gatewaySpanName names the spans of REST requests after the method and path
+// of the request.
+func gatewaySpanName(_ string, r *http.Request) string {
+	return r.Method + " " + r.URL.Path
+}
+
+// RegisterGateway registers a generated REST handler with the gateway, so that
+// the service's HTTP bindings are served from the HTTP listener.
+func (s *Server) RegisterGateway(register GatewayRegisterFunc) error {
+	return register(context.Background(), s.Gateway, s.inProcess.conn)
+}
@@ -0,0 +1,70 @@
+package server
+
+import (
+	"context"
+	"net"
+
+	"github.com/milsim-tools/pincer/internal/middleware"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const inProcessBufferSize = 1024 * 1024
+
+// inProcess serves the registered services to modules running in this
+// process over an in-memory listener, so that they never depend on the
+// network listener being reachable.
+//
+// It runs the same interceptors as the network listener, but never uses TLS,
+// since traffic does not leave the process.
+type inProcess struct {
+	server   *grpc.Server
+	listener *bufconn.Listener
+	conn     *grpc.ClientConn
+}
+
+func newInProcess(options ...grpc.ServerOption) (*inProcess, error) {
+	listener := bufconn.Listen(inProcessBufferSize)
+
+	// The target is never resolved, as every connection is made by the
+	// context dialer.
+	conn, err := grpc.NewClient("passthrough:///pincer",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+		grpc.WithChainUnaryInterceptor(
+			middleware.ForwardAuthorizationUnaryClientInterceptor,
+			middleware.ForwardRequestIDUnaryClientInterceptor,
+		),
+		grpc.WithChainStreamInterceptor(
+			middleware.ForwardAuthorizationStreamClientInterceptor,
+			middleware.ForwardRequestIDStreamClientInterceptor,
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &inProcess{
+		server:   grpc.NewServer(options...),
+		listener: listener,
+		conn:     conn,
+	}, nil
+}
+
+// RegisterService registers a service with both the network and in-process
+// gRPC servers. It implements grpc.ServiceRegistrar.
+func (s *Server) RegisterService(desc *grpc.ServiceDesc, impl any) {
+	s.GRPCServer.RegisterService(desc, impl)
+	s.inProcess.server.RegisterService(desc, impl)
+}
+
+// InProcessConn returns a connection to the services registered with this
+// server that never leaves the process. Calls block until the server runs.
+func (s *Server) InProcessConn() *grpc.ClientConn {
+	return s.inProcess.conn
+}
@@ -2,19 +2,27 @@ package server
 
 import (
 	"context"
+	"crypto/tls"
 	"log/slog"
 	"net"
 	"net/http"
 	"time"
 
+	"buf.build/go/protovalidate"
 	"github.com/gorilla/mux"
 	"github.com/grafana/dskit/services"
-	"buf.build/go/protovalidate"
 	protovalidate_middleware "github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/protovalidate"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/milsim-tools/pincer/internal/middleware"
 	"github.com/milsim-tools/pincer/internal/signals"
+	"github.com/milsim-tools/pincer/internal/tlsutil"
+	"github.com/milsim-tools/pincer/pkg/authz"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/urfave/cli/v2"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/reflection"
 )
 
@@ -28,6 +36,10 @@ const (
 	FlagGRPCReadTimeout  = "grpc-read-timeout"
 	FlagGRPCWriteTimeout = "grpc-write-timeout"
 	FlagGRPCIdleTimeout  = "grpc-idle-timeout"
+
+	FlagTLSCertFile     = "tls-cert-file"
+	FlagTLSKeyFile      = "tls-key-file"
+	FlagTLSClientCAFile = "tls-client-ca-file"
 )
 
 // SignalHandler used by Server.
@@ -96,6 +108,24 @@ var Flags = []cli.Flag{
 		Usage:   "Maximum time to wait for another request when keep-alives are used.",
 		EnvVars: []string{"PINCER_GRPC_IDLE_TIMEOUT"},
 	},
+
+	&cli.StringFlag{
+		Name:    FlagTLSCertFile,
+		Usage:   "The certificate served by the HTTP and gRPC listeners. TLS is disabled if unset.",
+		EnvVars: []string{"PINCER_TLS_CERT_FILE"},
+	},
+
+	&cli.StringFlag{
+		Name:    FlagTLSKeyFile,
+		Usage:   "The private key of the served certificate.",
+		EnvVars: []string{"PINCER_TLS_KEY_FILE"},
+	},
+
+	&cli.StringFlag{
+		Name:    FlagTLSClientCAFile,
+		Usage:   "The CA bundle client certificates are verified against. If set, gRPC clients must present a certificate signed by it.",
+		EnvVars: []string{"PINCER_TLS_CLIENT_CA_FILE"},
+	},
 }
 
 type Config struct {
@@ -108,6 +138,10 @@ type Config struct {
 	GRPCReadTimeout  time.Duration
 	GRPCWriteTimeout time.Duration
 	GRPCIdleTimeout  time.Duration
+
+	TLS tlsutil.ServerConfig
+
+	Auth AuthConfig
 }
 
 func ConfigFromFlags(ctx *cli.Context) Config {
@@ -123,6 +157,12 @@ func ConfigFromFlags(ctx *cli.Context) Config {
 	config.GRPCWriteTimeout = ctx.Duration(FlagGRPCWriteTimeout)
 	config.GRPCIdleTimeout = ctx.Duration(FlagGRPCIdleTimeout)
 
+	config.TLS.CertFile = ctx.String(FlagTLSCertFile)
+	config.TLS.KeyFile = ctx.String(FlagTLSKeyFile)
+	config.TLS.ClientCAFile = ctx.String(FlagTLSClientCAFile)
+
+	config.Auth = AuthConfigFromFlags(ctx)
+
 	return config
 }
 
@@ -138,10 +178,16 @@ type Server struct {
 	HTTPServer *http.Server
 	GRPCServer *grpc.Server
 
+	// Gateway serves the REST bindings of registered services under
+	// GatewayPathPrefix on the HTTP listener.
+	Gateway *runtime.ServeMux
+
+	inProcess *inProcess
+
 	logger *slog.Logger
 }
 
-func New(logger *slog.Logger, config Config) (*Server, error) {
+func New(logger *slog.Logger, config Config, resolver IdentityResolver, authorizer *authz.Authorizer, reg prometheus.Registerer) (*Server, error) {
 	httpListener, err := net.Listen("tcp", config.HTTPBindAddr)
 	if err != nil {
 		return nil, err
@@ -154,7 +200,10 @@ func New(logger *slog.Logger, config Config) (*Server, error) {
 
 	logger.Info("server listening on addr", "http", httpListener.Addr(), "grpc", grpcListener.Addr())
 
+	httpInstrument := middleware.NewHTTPInstrument(reg)
+
 	mux := mux.NewRouter()
+	mux.Use(httpInstrument.Wrap)
 	httpServer := http.Server{
 		Handler:      mux,
 		ReadTimeout:  30 * time.Second,
@@ -173,22 +222,78 @@ func New(logger *slog.Logger, config Config) (*Server, error) {
 		return nil, err
 	}
 
+	grpcInstrument := middleware.NewGRPCServerInstrument(reg)
+
 	grpcMiddleware := []grpc.UnaryServerInterceptor{
+		grpcInstrument.UnaryServerInterceptor,
+		middleware.RequestIDUnaryServerInterceptor,
 		serverLog.UnaryServerInterceptor,
-		protovalidate_middleware.UnaryServerInterceptor(validator),
 	}
 	grpcStreamMiddleware := []grpc.StreamServerInterceptor{
+		grpcInstrument.StreamServerInterceptor,
+		middleware.RequestIDStreamServerInterceptor,
 		serverLog.StreamServerInterceptor,
-		protovalidate_middleware.StreamServerInterceptor(validator),
 	}
 
+	if config.Auth.Enabled() {
+		authenticator, err := NewAuthenticator(logger, config.Auth, resolver)
+		if err != nil {
+			return nil, err
+		}
+
+		grpcMiddleware = append(grpcMiddleware,
+			authenticator.UnaryServerInterceptor(),
+			authorizer.UnaryServerInterceptor,
+		)
+		grpcStreamMiddleware = append(grpcStreamMiddleware,
+			authenticator.StreamServerInterceptor(),
+			authorizer.StreamServerInterceptor,
+		)
+	} else {
+		logger.Warn("authentication is disabled, all requests are anonymous")
+	}
+
+	grpcMiddleware = append(grpcMiddleware, protovalidate_middleware.UnaryServerInterceptor(validator))
+	grpcStreamMiddleware = append(grpcStreamMiddleware, protovalidate_middleware.StreamServerInterceptor(validator))
+
 	grpcOptions := []grpc.ServerOption{
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
 		grpc.ChainUnaryInterceptor(grpcMiddleware...),
 		grpc.ChainStreamInterceptor(grpcStreamMiddleware...),
 	}
 
+	inProcess, err := newInProcess(grpcOptions...)
+	if err != nil {
+		return nil, err
+	}
+
+	if config.TLS.Enabled() {
+		// Module-to-module traffic may be required to use mutual TLS, which
+		// browsers and other REST clients cannot be expected to do, so client
+		// certificates are only verified on the HTTP listener if presented.
+		httpTLS := config.TLS
+		httpTLS.ClientAuth = tls.VerifyClientCertIfGiven
+		httpServer.TLSConfig, err = tlsutil.NewServerConfig(httpTLS)
+		if err != nil {
+			return nil, err
+		}
+
+		grpcTLS := config.TLS
+		grpcTLS.ClientAuth = tls.RequireAndVerifyClientCert
+		grpcTLSConfig, err := tlsutil.NewServerConfig(grpcTLS)
+		if err != nil {
+			return nil, err
+		}
+		grpcOptions = append(grpcOptions, grpc.Creds(credentials.NewTLS(grpcTLSConfig)))
+	}
+
 	grpcServer := grpc.NewServer(grpcOptions...)
 
+	gateway := newGateway(logger, httpInstrument)
+	mux.PathPrefix(GatewayPathPrefix).Handler(otelhttp.NewHandler(gateway, "gateway",
+		otelhttp.WithSpanNameFormatter(gatewaySpanName),
+	))
+
 	srv := Server{
 		config:       config,
 		logger:       logger,
@@ -200,6 +305,9 @@ func New(logger *slog.Logger, config Config) (*Server, error) {
 		HTTPServer: &httpServer,
 
 		GRPCServer: grpcServer,
+
+		Gateway:   gateway,
+		inProcess: inProcess,
 	}
 
 	return &srv, nil
@@ -218,7 +326,14 @@ func (s *Server) Run() error {
 	}()
 
 	go func() {
-		err := s.HTTPServer.Serve(s.httpListener)
+		var err error
+		if s.HTTPServer.TLSConfig != nil {
+			// The certificate is served by the TLS config, so no files are
+			// passed here.
+			err = s.HTTPServer.ServeTLS(s.httpListener, "", "")
+		} else {
+			err = s.HTTPServer.Serve(s.httpListener)
+		}
 		if err == http.ErrServerClosed {
 			err = nil
 		}
@@ -236,6 +351,11 @@ func (s *Server) Run() error {
 		handleGRPCError(err, errChan)
 	}()
 
+	go func() {
+		err := s.inProcess.server.Serve(s.inProcess.listener)
+		handleGRPCError(err, errChan)
+	}()
+
 	return <-errChan
 }
 
@@ -244,6 +364,8 @@ func (s *Server) Shutdown() {
 	defer cancel()
 
 	_ = s.HTTPServer.Shutdown(ctx)
+	_ = s.inProcess.conn.Close()
+	s.inProcess.server.GracefulStop()
 	s.GRPCServer.GracefulStop()
 }
 
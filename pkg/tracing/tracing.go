@@ -0,0 +1,169 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+
+	"github.com/grafana/dskit/services"
+	"github.com/urfave/cli/v2"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+)
+
+const (
+	FlagExporter     = "tracing-exporter"
+	FlagOTLPEndpoint = "tracing-otlp-endpoint"
+	FlagOTLPInsecure = "tracing-otlp-insecure"
+	FlagSampleRatio  = "tracing-sample-ratio"
+)
+
+const (
+	ExporterNone   = "none"
+	ExporterStdout = "stdout"
+	ExporterOTLP   = "otlp"
+)
+
+var Flags = []cli.Flag{
+	&cli.StringFlag{
+		Name:    FlagExporter,
+		Value:   ExporterNone,
+		Usage:   "Where to export traces to, one of none, stdout or otlp",
+		EnvVars: []string{"PINCER_TRACING_EXPORTER"},
+		Action: func(ctx *cli.Context, s string) error {
+			switch s {
+			case ExporterNone, ExporterStdout, ExporterOTLP:
+				return nil
+			default:
+				return fmt.Errorf("%s must be one of none, stdout, otlp", FlagExporter)
+			}
+		},
+	},
+
+	&cli.StringFlag{
+		Name:    FlagOTLPEndpoint,
+		Value:   "localhost:4317",
+		Usage:   "The OTLP/gRPC collector traces are exported to",
+		EnvVars: []string{"PINCER_TRACING_OTLP_ENDPOINT"},
+	},
+
+	&cli.BoolFlag{
+		Name:    FlagOTLPInsecure,
+		Usage:   "Export traces to the OTLP collector without TLS",
+		EnvVars: []string{"PINCER_TRACING_OTLP_INSECURE"},
+	},
+
+	&cli.Float64Flag{
+		Name:    FlagSampleRatio,
+		Value:   1,
+		Usage:   "The fraction of new traces that are sampled, between 0 and 1",
+		EnvVars: []string{"PINCER_TRACING_SAMPLE_RATIO"},
+	},
+}
+
+type Config struct {
+	Exporter     string
+	OTLPEndpoint string
+	OTLPInsecure bool
+	SampleRatio  float64
+}
+
+func ConfigFromFlags(ctx *cli.Context) Config {
+	var config Config
+
+	config.Exporter = ctx.String(FlagExporter)
+	config.OTLPEndpoint = ctx.String(FlagOTLPEndpoint)
+	config.OTLPInsecure = ctx.Bool(FlagOTLPInsecure)
+	config.SampleRatio = ctx.Float64(FlagSampleRatio)
+
+	return config
+}
+
+// Tracing installs the global OpenTelemetry tracer provider and propagator,
+// and flushes any buffered spans when stopped.
+type Tracing struct {
+	services.Service
+
+	cfg    Config
+	logger *slog.Logger
+
+	provider *sdktrace.TracerProvider
+}
+
+func New(
+	logger *slog.Logger,
+	cfg Config,
+	version string,
+) (*Tracing, error) {
+	t := &Tracing{
+		cfg:    cfg,
+		logger: logger,
+	}
+
+	// Trace context is always propagated, so that traces started by callers
+	// carry on through Pincer even if it does not export spans itself.
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	exporter, err := newExporter(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if exporter != nil {
+		res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(
+			semconv.SchemaURL,
+			semconv.ServiceName("pincer"),
+			semconv.ServiceVersion(version),
+		))
+		if err != nil {
+			return nil, err
+		}
+
+		t.provider = sdktrace.NewTracerProvider(
+			sdktrace.WithBatcher(exporter),
+			sdktrace.WithResource(res),
+			sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+		)
+		otel.SetTracerProvider(t.provider)
+
+		logger.Info("exporting traces", "exporter", cfg.Exporter)
+	}
+
+	t.Service = services.NewIdleService(nil, t.stopping)
+
+	return t, nil
+}
+
+func newExporter(cfg Config) (sdktrace.SpanExporter, error) {
+	switch cfg.Exporter {
+	case ExporterStdout:
+		return stdouttrace.New(stdouttrace.WithWriter(os.Stdout))
+	case ExporterOTLP:
+		opts := []otlptracegrpc.Option{
+			otlptracegrpc.WithEndpoint(cfg.OTLPEndpoint),
+		}
+		if cfg.OTLPInsecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		return otlptracegrpc.New(context.Background(), opts...)
+	default:
+		return nil, nil
+	}
+}
+
+func (t *Tracing) stopping(_ error) error {
+	if t.provider == nil {
+		return nil
+	}
+
+	return t.provider.Shutdown(context.Background())
+}
@@ -0,0 +1,48 @@
+package units
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// UnitOwner returns the ID of the user who owns the unit, for authorization
+// decisions.
+func (s *Units) UnitOwner(ctx context.Context, unitID string) (string, error) {
+	unit, err := gorm.G[UnitsUnit](s.db.Db).Where("id = ?", unitID).First(ctx)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return "", status.Error(
+				codes.NotFound,
+				"unit not found",
+			)
+		}
+
+		return "", status.Error(
+			codes.Internal,
+			"failed to query unit: "+err.Error(),
+		)
+	}
+
+	return unit.OwnerID, nil
+}
+
+// OwnedUnits returns the IDs of the units the user owns, for authorization
+// decisions.
+func (s *Units) OwnedUnits(ctx context.Context, userID string) ([]string, error) {
+	var units []string
+	if err := s.db.Db.WithContext(ctx).
+		Model(&UnitsUnit{}).
+		Where("owner_id = ?", userID).
+		Pluck("id", &units).Error; err != nil {
+		return nil, status.Error(
+			codes.Internal,
+			"failed to query units: "+err.Error(),
+		)
+	}
+
+	return units, nil
+}
@@ -14,18 +14,28 @@ import (
 )
 
 func (s *Units) CreateUnit(ctx context.Context, req *unitsv1.CreateUnitRequest) (*unitsv1.Unit, error) {
-	client, err := s.UsersClient()
+	if err := validateSlug(req.Unit.Slug); err != nil {
+		return &unitsv1.Unit{}, err
+	}
+
+	taken, err := slugTaken(ctx, s.db.Db, req.Unit.Slug, "")
 	if err != nil {
 		return &unitsv1.Unit{}, status.Error(
 			codes.Internal,
-			"failed to connect to users service: "+err.Error(),
+			"failed to query unit slugs: "+err.Error(),
+		)
+	}
+	if taken {
+		return &unitsv1.Unit{}, status.Error(
+			codes.AlreadyExists,
+			"slug is already taken",
 		)
 	}
 
-	if _, err := client.GetUser(ctx, &usersv1.GetUserRequest{
+	if _, err := s.users.GetUser(ctx, &usersv1.GetUserRequest{
 		Value: &usersv1.GetUserRequest_Id{Id: req.Unit.OwnerId},
 	}); err != nil {
-		if errors.Is(err, status.Error(codes.NotFound, "user not found")) {
+		if status.Code(err) == codes.NotFound {
 			return &unitsv1.Unit{}, status.Error(
 				codes.InvalidArgument,
 				"owner_id does not correspond to an existing user",
@@ -48,6 +58,13 @@ func (s *Units) CreateUnit(ctx context.Context, req *unitsv1.CreateUnitRequest)
 	}
 
 	if err := gorm.G[UnitsUnit](s.db.Db).Create(ctx, unit); err != nil {
+		// Another unit may have taken the slug since it was checked.
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return &unitsv1.Unit{}, status.Error(
+				codes.AlreadyExists,
+				"slug is already taken",
+			)
+		}
 		return &unitsv1.Unit{}, status.Error(
 			codes.Internal,
 			"failed to create unit: "+err.Error(),
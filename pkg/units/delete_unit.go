@@ -0,0 +1,89 @@
+package units
+
+import (
+	"context"
+	"errors"
+
+	applicationsv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/applications/v1"
+	eventsv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/events/v1"
+	membersv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/members/v1"
+	unitsv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/units/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"gorm.io/gorm"
+)
+
+func (s *Units) DeleteUnit(ctx context.Context, req *unitsv1.DeleteUnitRequest) (*emptypb.Empty, error) {
+	if _, err := gorm.G[UnitsUnit](s.db.Db).Where("id = ?", req.Id).First(ctx); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &emptypb.Empty{}, status.Error(
+				codes.NotFound,
+				"unit not found",
+			)
+		}
+
+		return &emptypb.Empty{}, status.Error(
+			codes.Internal,
+			"failed to query unit: "+err.Error(),
+		)
+	}
+
+	// Memberships, applications and events are deleted first, while the unit still
+	// exists for their services to authorize the calls against.
+	if _, err := s.members.DeleteUnitMembers(ctx, &membersv1.DeleteUnitMembersRequest{
+		UnitId: req.Id,
+	}); err != nil {
+		if err := s.dependentsNotDeleted(ctx, "members", req.Id, err); err != nil {
+			return &emptypb.Empty{}, err
+		}
+	}
+
+	if _, err := s.applications.DeleteUnitApplications(ctx, &applicationsv1.DeleteUnitApplicationsRequest{
+		UnitId: req.Id,
+	}); err != nil {
+		if err := s.dependentsNotDeleted(ctx, "applications", req.Id, err); err != nil {
+			return &emptypb.Empty{}, err
+		}
+	}
+
+	if _, err := s.events.DeleteUnitEvents(ctx, &eventsv1.DeleteUnitEventsRequest{
+		UnitId: req.Id,
+	}); err != nil {
+		if err := s.dependentsNotDeleted(ctx, "events", req.Id, err); err != nil {
+			return &emptypb.Empty{}, err
+		}
+	}
+
+	err := s.db.Db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if _, err := gorm.G[UnitsUnitSlug](tx).Where("unit_id = ?", req.Id).Delete(ctx); err != nil {
+			return err
+		}
+
+		_, err := gorm.G[UnitsUnit](tx).Where("id = ?", req.Id).Delete(ctx)
+		return err
+	})
+	if err != nil {
+		return &emptypb.Empty{}, status.Error(
+			codes.Internal,
+			"failed to delete unit: "+err.Error(),
+		)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// dependentsNotDeleted handles the failure of the service of another module to
+// delete what the unit's deletion leaves behind in it. Where no module serves
+// the service there is nothing to delete, so only a warning is logged.
+func (s *Units) dependentsNotDeleted(ctx context.Context, service string, unitID string, err error) error {
+	if status.Code(err) != codes.Unimplemented {
+		return status.Error(
+			codes.Internal,
+			"failed to call "+service+" service: "+err.Error(),
+		)
+	}
+
+	s.logger.WarnContext(ctx, service+" service is not available, the unit's "+service+" were not deleted", "unit_id", unitID)
+	return nil
+}
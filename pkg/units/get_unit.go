@@ -5,13 +5,24 @@ import (
 	"errors"
 
 	unitsv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/units/v1"
+	"github.com/milsim-tools/pincer/pkg/server"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"gorm.io/gorm"
 )
 
 func (s *Units) GetUnit(ctx context.Context, req *unitsv1.GetUnitRequest) (*unitsv1.UnitView, error) {
-	unit, err := gorm.G[UnitsUnit](s.db.Db).Where("id = ?", req.Id).First(ctx)
+	qb := gorm.G[UnitsUnit](s.db.Db).Select("*")
+
+	if id := req.GetId(); id != "" {
+		qb = qb.Where("id = ?", id)
+	} else if slug := req.GetSlug(); slug != "" {
+		// Units renamed since are found by their previous slugs.
+		qb = qb.Where("slug = ? OR id IN (?)", slug,
+			s.db.Db.Model(&UnitsUnitSlug{}).Select("unit_id").Where("slug = ?", slug))
+	}
+
+	unit, err := qb.First(ctx)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return &unitsv1.UnitView{}, status.Error(
@@ -22,13 +33,19 @@ func (s *Units) GetUnit(ctx context.Context, req *unitsv1.GetUnitRequest) (*unit
 
 		return &unitsv1.UnitView{}, status.Error(
 			codes.Internal,
-			"failed to query unit: "+ err.Error(),
+			"failed to query unit: "+err.Error(),
 		)
 	}
 
+	if slug := req.GetSlug(); slug != "" && slug != unit.Slug {
+		if err := server.SetLocation(ctx, "/v1/units/by-slug/"+unit.Slug); err != nil {
+			s.logger.WarnContext(ctx, "failed to redirect to the current slug", "err", err)
+		}
+	}
+
 	return &unitsv1.UnitView{
-		Unit: unit.Proto(),
+		Unit:        unit.Proto(),
 		MemberCount: 0,
-		RankCount: 0,
+		RankCount:   0,
 	}, nil
 }
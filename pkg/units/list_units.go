@@ -2,8 +2,7 @@ package units
 
 import (
 	"context"
-	"fmt"
-	"strings"
+	"errors"
 
 	"github.com/milsim-tools/pincer/internal/helpers"
 	unitsv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/units/v1"
@@ -12,34 +11,50 @@ import (
 	"gorm.io/gorm"
 )
 
+// orderByColumns are the fields units can be ordered by.
+var orderByColumns = helpers.OrderByColumns{
+	"display_name": "display_name",
+	"slug":         "slug",
+	"created_at":   "created_at",
+	"updated_at":   "updated_at",
+}
+
+// filter translates the filters of ListUnits.
+var filter = helpers.MustNewFilter(&unitsv1.Unit{}, helpers.FilterColumns{
+	"display_name": "display_name",
+	"slug":         "slug",
+	"owner_id":     "owner_id",
+})
+
 func (s *Units) ListUnits(ctx context.Context, req *unitsv1.ListUnitsRequest) (*unitsv1.ListUnitsResponse, error) {
-	qb := gorm.G[UnitsUnit](s.db.Db).Limit(int(req.PageSize))
+	order, err := helpers.ParseOrderBy(req.OrderBy, orderByColumns)
+	if err != nil {
+		return &unitsv1.ListUnitsResponse{}, err
+	}
 
-	if cursor, err := helpers.CursorFromString(req.PageToken); err != nil && cursor != nil {
-		qb = helpers.ApplyCursor(qb, cursor)
+	where, err := filter.Parse(req.Filter)
+	if err != nil {
+		return &unitsv1.ListUnitsResponse{}, err
 	}
 
-	// TODO: Cursor pagination
-
-	if req.OrderBy != "" {
-		parts := strings.SplitSeq(req.OrderBy, ",")
-		for part := range parts {
-			order := strings.Split(strings.TrimSpace(part), " ")
-			if len(order) != 2 {
-				return &unitsv1.ListUnitsResponse{}, status.Error(
-					codes.InvalidArgument,
-					"invalid order_by format",
-				)
-			}
-			qb = qb.Order(fmt.Sprintf("%s %s", order[0], order[1]))
-		}
+	qb := gorm.G[UnitsUnit](s.db.Replica).Select("*")
+	if where != nil {
+		qb = qb.Where(where)
 	}
 
-	units, err := qb.Find(ctx)
+	units, next, err := helpers.Paginate(ctx, s.pages, qb, helpers.Page{
+		Size:    int(req.PageSize),
+		Token:   req.PageToken,
+		Order:   order,
+		Filters: []any{req.Filter},
+	})
 	if err != nil {
+		if errors.Is(err, helpers.ErrInvalidPageToken) {
+			return &unitsv1.ListUnitsResponse{}, status.Error(codes.InvalidArgument, err.Error())
+		}
 		return &unitsv1.ListUnitsResponse{}, status.Error(
 			codes.Internal,
-			"failed to query unit: "+ err.Error(),
+			"failed to query units: "+err.Error(),
 		)
 	}
 
@@ -53,8 +68,8 @@ func (s *Units) ListUnits(ctx context.Context, req *unitsv1.ListUnitsRequest) (*
 	}
 
 	resp := &unitsv1.ListUnitsResponse{
-		Units: unitViews,
-		NextPageToken: "",
+		Units:         unitViews,
+		NextPageToken: next,
 	}
 
 	return resp, nil
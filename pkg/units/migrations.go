@@ -0,0 +1,17 @@
+package units
+
+import (
+	"embed"
+
+	"github.com/milsim-tools/pincer/pkg/db/migrate"
+)
+
+//go:embed migrations/*.sql
+var migrationsFS embed.FS
+
+// Migrations are the schema migrations of the units module.
+var Migrations = migrate.Source{
+	Module: "units",
+	FS:     migrationsFS,
+	Dir:    "migrations",
+}
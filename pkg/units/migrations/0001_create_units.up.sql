@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS units_units (
+  id text PRIMARY KEY,
+  created_at timestamptz,
+  updated_at timestamptz,
+  display_name text NOT NULL,
+  slug text NOT NULL,
+  description text NOT NULL,
+  owner_id text NOT NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_units_units_slug ON units_units (slug);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_units_units_search;
+
+ALTER TABLE units_units DROP COLUMN IF EXISTS search;
@@ -0,0 +1,9 @@
+CREATE EXTENSION IF NOT EXISTS pg_trgm WITH SCHEMA public;
+
+ALTER TABLE units_units ADD COLUMN IF NOT EXISTS search tsvector
+  GENERATED ALWAYS AS (
+    setweight(to_tsvector('simple', display_name || ' ' || slug), 'A') ||
+    setweight(to_tsvector('simple', description), 'B')
+  ) STORED;
+
+CREATE INDEX IF NOT EXISTS idx_units_units_search ON units_units USING gin (search);
@@ -0,0 +1 @@
+-- SQLite searches units with LIKE, which needs no index.
@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS units_unit_slugs (
+  slug text PRIMARY KEY,
+  unit_id text NOT NULL,
+  created_at timestamptz
+);
+
+CREATE INDEX IF NOT EXISTS idx_units_unit_slugs_unit_id ON units_unit_slugs (unit_id);
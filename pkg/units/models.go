@@ -1,6 +1,8 @@
 package units
 
 import (
+	"time"
+
 	"github.com/milsim-tools/pincer/internal/models"
 	unitsv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/units/v1"
 )
@@ -23,3 +25,11 @@ func (u UnitsUnit) Proto() *unitsv1.Unit {
 		OwnerId:     u.OwnerID,
 	}
 }
+
+// UnitsUnitSlug is a slug a unit had before being renamed, so that links
+// using it keep working.
+type UnitsUnitSlug struct {
+	Slug      string `gorm:"primaryKey"`
+	UnitID    string `gorm:"notNull;index"`
+	CreatedAt time.Time
+}
@@ -0,0 +1,88 @@
+package units
+
+import (
+	"context"
+	"errors"
+
+	"github.com/milsim-tools/pincer/internal/helpers"
+	unitsv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/units/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// search matches units by their names and descriptions, indexed by the
+// search column of the units_units table on Postgres.
+var search = helpers.Search{
+	Vector: "search",
+	Columns: []helpers.SearchColumn{
+		{Name: "display_name", Weight: 1, Fuzzy: true},
+		{Name: "slug", Weight: 1, Fuzzy: true},
+		{Name: "description", Weight: 0.4},
+	},
+}
+
+// unitsSearchResult is a unit matching a search, with its rank.
+type unitsSearchResult struct {
+	UnitsUnit
+
+	Rank float64
+}
+
+func (s *Units) SearchUnits(ctx context.Context, req *unitsv1.SearchUnitsRequest) (*unitsv1.SearchUnitsResponse, error) {
+	terms := helpers.SearchTerms(req.Query)
+	if len(terms) == 0 {
+		return &unitsv1.SearchUnitsResponse{}, helpers.InvalidArgument("query", "the query has no words to search for")
+	}
+
+	qb := gorm.G[unitsSearchResult](s.db.Replica).
+		Table("(?) AS results", search.Query(s.db.Replica, "units_units", terms)).
+		Select("*")
+
+	results, next, err := helpers.Paginate(ctx, s.pages, qb, helpers.Page{
+		Size:    int(req.PageSize),
+		Token:   req.PageToken,
+		Order:   []helpers.Order{{Column: helpers.RankColumn, Desc: true}},
+		Filters: []any{terms},
+	})
+	if err != nil {
+		if errors.Is(err, helpers.ErrInvalidPageToken) {
+			return &unitsv1.SearchUnitsResponse{}, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return &unitsv1.SearchUnitsResponse{}, status.Error(
+			codes.Internal,
+			"failed to search units: "+err.Error(),
+		)
+	}
+
+	var unitResults []*unitsv1.UnitSearchResult
+	for _, result := range results {
+		highlights := map[string]string{}
+		if highlight, ok := helpers.Highlight(result.DisplayName, terms); ok {
+			highlights["display_name"] = highlight
+		}
+		if highlight, ok := helpers.Highlight(result.Slug, terms); ok {
+			highlights["slug"] = highlight
+		}
+		if highlight, ok := helpers.Highlight(result.Description, terms); ok {
+			highlights["description"] = highlight
+		}
+
+		unitResults = append(unitResults, &unitsv1.UnitSearchResult{
+			Unit: &unitsv1.UnitView{
+				Unit:        result.Proto(),
+				MemberCount: 0,
+				RankCount:   0,
+			},
+			Rank:       result.Rank,
+			Highlights: highlights,
+		})
+	}
+
+	resp := &unitsv1.SearchUnitsResponse{
+		Results:       unitResults,
+		NextPageToken: next,
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,76 @@
+package units
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+
+	"github.com/milsim-tools/pincer/internal/helpers"
+	"gorm.io/gorm"
+)
+
+const (
+	minSlugLength = 3
+	maxSlugLength = 64
+)
+
+// slugPattern matches lowercase words of letters and digits joined by
+// hyphens, such as `1st-rangers`.
+var slugPattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// reservedSlugs name routes of the API and the web apps, which units may not
+// take over.
+var reservedSlugs = []string{
+	"about",
+	"admin",
+	"api",
+	"by-slug",
+	"create",
+	"edit",
+	"events",
+	"help",
+	"login",
+	"logout",
+	"me",
+	"members",
+	"new",
+	"search",
+	"settings",
+	"units",
+	"users",
+}
+
+// validateSlug returns an InvalidArgument status error if units cannot have
+// the slug.
+func validateSlug(slug string) error {
+	switch {
+	case len(slug) < minSlugLength || len(slug) > maxSlugLength:
+		return helpers.InvalidArgument("unit.slug", fmt.Sprintf("must be between %d and %d characters long", minSlugLength, maxSlugLength))
+	case !slugPattern.MatchString(slug):
+		return helpers.InvalidArgument("unit.slug", "must be lowercase letters and digits, with words separated by single hyphens")
+	case slices.Contains(reservedSlugs, slug):
+		return helpers.InvalidArgument("unit.slug", fmt.Sprintf("%q is reserved", slug))
+	}
+
+	return nil
+}
+
+// slugTaken reports whether a unit other than the given one has the slug, or
+// had it before being renamed.
+func slugTaken(ctx context.Context, db *gorm.DB, slug string, unitID string) (bool, error) {
+	units, err := gorm.G[UnitsUnit](db).Where("slug = ? AND id <> ?", slug, unitID).Count(ctx, "id")
+	if err != nil {
+		return false, err
+	}
+	if units > 0 {
+		return true, nil
+	}
+
+	previous, err := gorm.G[UnitsUnitSlug](db).Where("slug = ? AND unit_id <> ?", slug, unitID).Count(ctx, "slug")
+	if err != nil {
+		return false, err
+	}
+
+	return previous > 0, nil
+}
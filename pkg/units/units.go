@@ -1,37 +1,71 @@
 package units
 
 import (
+	"context"
 	"log/slog"
 
 	"github.com/grafana/dskit/services"
+	"github.com/milsim-tools/pincer/internal/helpers"
+	applicationsv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/applications/v1"
+	eventsv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/events/v1"
+	membersv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/members/v1"
 	unitsv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/units/v1"
 	usersv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/users/v1"
 	"github.com/milsim-tools/pincer/pkg/db"
 	"github.com/urfave/cli/v2"
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
 const (
-	FlagUsersGrpcAddr = "users-grpc-addr"
+	FlagUsersGrpcAddr        = "users-grpc-addr"
+	FlagMembersGrpcAddr      = "units-members-grpc-addr"
+	FlagApplicationsGrpcAddr = "units-applications-grpc-addr"
+	FlagEventsGrpcAddr       = "units-events-grpc-addr"
 )
 
 var Flags = []cli.Flag{
 	&cli.StringFlag{
 		Name:    FlagUsersGrpcAddr,
 		Value:   "localhost:9000",
+		Usage:   "The users service, used when the users module runs in another process.",
 		EnvVars: []string{"PINCER_UNITS_USERS_GRPC_ADDR"},
 	},
+
+	&cli.StringFlag{
+		Name:    FlagMembersGrpcAddr,
+		Value:   "localhost:9000",
+		Usage:   "The members service, used when the members module runs in another process.",
+		EnvVars: []string{"PINCER_UNITS_MEMBERS_GRPC_ADDR"},
+	},
+
+	&cli.StringFlag{
+		Name:    FlagApplicationsGrpcAddr,
+		Value:   "localhost:9000",
+		Usage:   "The applications service, used when the applications module runs in another process.",
+		EnvVars: []string{"PINCER_UNITS_APPLICATIONS_GRPC_ADDR"},
+	},
+
+	&cli.StringFlag{
+		Name:    FlagEventsGrpcAddr,
+		Value:   "localhost:9000",
+		Usage:   "The events service, used when the events module runs in another process.",
+		EnvVars: []string{"PINCER_UNITS_EVENTS_GRPC_ADDR"},
+	},
 }
 
 type Config struct {
-	UsersGrpcAddr string
+	UsersGrpcAddr        string
+	MembersGrpcAddr      string
+	ApplicationsGrpcAddr string
+	EventsGrpcAddr       string
 }
 
 func ConfigFromFlags(ctx *cli.Context) Config {
 	var config Config
 
 	config.UsersGrpcAddr = ctx.String(FlagUsersGrpcAddr)
+	config.MembersGrpcAddr = ctx.String(FlagMembersGrpcAddr)
+	config.ApplicationsGrpcAddr = ctx.String(FlagApplicationsGrpcAddr)
+	config.EventsGrpcAddr = ctx.String(FlagEventsGrpcAddr)
 
 	return config
 }
@@ -43,44 +77,42 @@ type Units struct {
 	cfg    Config
 	logger *slog.Logger
 
-	db *db.Db
+	db    *db.Db
+	pages *helpers.Paginator
 
-	users usersv1.UsersServiceClient
+	users        usersv1.UsersServiceClient
+	members      membersv1.MembersServiceClient
+	applications applicationsv1.ApplicationsServiceClient
+	events       eventsv1.EventsServiceClient
 }
 
 func New(
 	logger *slog.Logger,
 	cfg Config,
 	db *db.Db,
+	pages *helpers.Paginator,
+	users usersv1.UsersServiceClient,
+	members membersv1.MembersServiceClient,
+	applications applicationsv1.ApplicationsServiceClient,
+	events eventsv1.EventsServiceClient,
 ) (*Units, error) {
 	u := &Units{
-		cfg:    cfg,
-		logger: logger,
-		db:     db,
-	}
-
-	if err := db.Db.AutoMigrate(&UnitsUnit{}); err != nil {
-		return nil, err
+		cfg:          cfg,
+		logger:       logger,
+		db:           db,
+		pages:        pages,
+		users:        users,
+		members:      members,
+		applications: applications,
+		events:       events,
 	}
 
-	u.Service = services.NewIdleService(nil, nil)
+	u.Service = services.NewIdleService(u.starting, nil)
 
 	return u, nil
 }
 
-func (u *Units) UsersClient() (usersv1.UsersServiceClient, error) {
-	if u.users != nil {
-		return u.users, nil
-	}
-
-	usersConn, err := grpc.NewClient(u.cfg.UsersGrpcAddr, grpc.WithTransportCredentials(
-		insecure.NewCredentials(),
-	))
-	if err != nil {
-		return nil, err
-	}
-	users := usersv1.NewUsersServiceClient(usersConn)
-
-	u.users = users
-	return u.users, nil
+// starting migrates the database once it is reachable.
+func (s *Units) starting(ctx context.Context) error {
+	return s.db.Migrate(ctx, Migrations)
 }
@@ -0,0 +1,105 @@
+package units
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/milsim-tools/pincer/internal/helpers"
+	unitsv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/units/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+func (s *Units) UpdateUnit(ctx context.Context, req *unitsv1.UpdateUnitRequest) (*unitsv1.Unit, error) {
+	unit, err := gorm.G[UnitsUnit](s.db.Db).Where("id = ?", req.Unit.Id).First(ctx)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &unitsv1.Unit{}, status.Error(
+				codes.NotFound,
+				"unit not found",
+			)
+		}
+
+		return &unitsv1.Unit{}, status.Error(
+			codes.Internal,
+			"failed to query unit: "+err.Error(),
+		)
+	}
+
+	previousSlug := unit.Slug
+
+	// Paths are accepted both relative to the unit, as the REST gateway sends
+	// them, and prefixed with `unit.`, as UpdateUser takes them.
+	for _, path := range req.UpdateMask.GetPaths() {
+		switch strings.TrimPrefix(path, "unit.") {
+		case "display_name":
+			unit.DisplayName = req.Unit.DisplayName
+		case "description":
+			unit.Description = req.Unit.Description
+		case "slug":
+			unit.Slug = req.Unit.Slug
+		case "owner_id":
+			return &unitsv1.Unit{}, helpers.InvalidArgument("update_mask", "owner_id cannot be updated")
+		default:
+			return &unitsv1.Unit{}, helpers.InvalidArgument("update_mask", "unknown field "+path)
+		}
+	}
+
+	if unit.Slug != previousSlug {
+		if err := validateSlug(unit.Slug); err != nil {
+			return &unitsv1.Unit{}, err
+		}
+
+		taken, err := slugTaken(ctx, s.db.Db, unit.Slug, unit.ID)
+		if err != nil {
+			return &unitsv1.Unit{}, status.Error(
+				codes.Internal,
+				"failed to query unit slugs: "+err.Error(),
+			)
+		}
+		if taken {
+			return &unitsv1.Unit{}, status.Error(
+				codes.AlreadyExists,
+				"slug is already taken",
+			)
+		}
+	}
+
+	err = s.db.Db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if unit.Slug != previousSlug {
+			// Keep the previous slug, so that links using it keep working,
+			// and release the new one if the unit had it before.
+			if err := gorm.G[UnitsUnitSlug](tx).Create(ctx, &UnitsUnitSlug{
+				Slug:   previousSlug,
+				UnitID: unit.ID,
+			}); err != nil {
+				return err
+			}
+
+			if _, err := gorm.G[UnitsUnitSlug](tx).Where("slug = ?", unit.Slug).Delete(ctx); err != nil {
+				return err
+			}
+		}
+
+		// Selecting the columns also writes fields cleared to their zero value.
+		_, err := gorm.G[UnitsUnit](tx).Select("display_name", "slug", "description", "updated_at").Updates(ctx, unit)
+		return err
+	})
+	if err != nil {
+		// Another unit may have taken the slug since it was checked.
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return &unitsv1.Unit{}, status.Error(
+				codes.AlreadyExists,
+				"slug is already taken",
+			)
+		}
+		return &unitsv1.Unit{}, status.Error(
+			codes.Internal,
+			"failed to update unit: "+err.Error(),
+		)
+	}
+
+	return unit.Proto(), nil
+}
@@ -19,6 +19,8 @@ func (s *Users) GetUser(ctx context.Context, req *usersv1.GetUserRequest) (*user
 		qb = qb.Where("email = ?", email)
 	} else if username := req.GetUsername(); username != "" {
 		qb = qb.Where("username = ?", username)
+	} else if ssoID := req.GetSsoId(); ssoID != "" {
+		qb = qb.Where("sso_id = ?", ssoID)
 	}
 
 	user, err := qb.First(ctx)
@@ -37,7 +39,7 @@ func (s *Users) GetUser(ctx context.Context, req *usersv1.GetUserRequest) (*user
 	}
 
 	return &usersv1.UserView{
-		User: user.Proto(),
+		User: s.view(ctx, user),
 		UnitCount: 0,
 	}, nil
 }
@@ -0,0 +1,119 @@
+package users
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"strings"
+
+	"github.com/milsim-tools/pincer/internal/models"
+	usersv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/users/v1"
+	"github.com/milsim-tools/pincer/pkg/authz"
+	"github.com/oklog/ulid/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// provisionAttempts bounds the usernames tried when provisioning a user whose
+// username is taken.
+const provisionAttempts = 5
+
+// ResolveIdentity returns the ID of the user linked to the token subject. If
+// just-in-time provisioning is enabled, a user is created from the token
+// claims the first time a subject is seen. A taken username is suffixed, while
+// a subject whose email belongs to another user is left unlinked.
+func (s *Users) ResolveIdentity(ctx context.Context, claims authz.Claims) (string, error) {
+	user, err := s.userBySSOID(ctx, claims.Subject)
+	if err == nil {
+		return user.ID, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return "", err
+	}
+
+	if !s.cfg.ProvisionUsers {
+		return "", nil
+	}
+
+	if claims.Email == "" {
+		s.logger.Warn("cannot provision user without an email claim", "sub", claims.Subject)
+		return "", nil
+	}
+
+	username := claims.PreferredUsername
+	if username == "" {
+		username, _, _ = strings.Cut(claims.Email, "@")
+	}
+
+	displayName := claims.Name
+	if displayName == "" {
+		displayName = username
+	}
+
+	user = UsersUser{
+		Model: models.Model{
+			ID: ulid.Make().String(),
+		},
+		SSOID:       claims.Subject,
+		DisplayName: displayName,
+		Username:    username,
+		Email:       claims.Email,
+		AvatarURL:   claims.Picture,
+	}
+
+	for attempt := 1; ; attempt++ {
+		err := gorm.G[UsersUser](s.db.Db).Create(ctx, &user)
+		if err == nil {
+			break
+		}
+
+		// Another request may have provisioned the same subject concurrently.
+		if existing, lookupErr := s.userBySSOID(ctx, claims.Subject); lookupErr == nil {
+			return existing.ID, nil
+		}
+		if !errors.Is(err, gorm.ErrDuplicatedKey) {
+			return "", err
+		}
+
+		if _, lookupErr := gorm.G[UsersUser](s.db.Db).Where("email = ?", claims.Email).First(ctx); lookupErr == nil {
+			s.logger.Warn("cannot provision user with the email of another user", "sub", claims.Subject)
+			return "", nil
+		}
+
+		// Otherwise the username is taken, and a suffix tells the users apart.
+		if attempt == provisionAttempts {
+			return "", err
+		}
+		user.Username = fmt.Sprintf("%s-%04d", username, rand.IntN(10000))
+	}
+
+	s.logger.Info("provisioned user on first login", "user_id", user.ID, "sub", claims.Subject)
+
+	return user.ID, nil
+}
+
+func (s *Users) userBySSOID(ctx context.Context, ssoID string) (UsersUser, error) {
+	return gorm.G[UsersUser](s.db.Db).Where("sso_id = ?", ssoID).First(ctx)
+}
+
+// RemoteIdentityResolver resolves identities against a users service running
+// in another process.
+type RemoteIdentityResolver struct {
+	Client usersv1.UsersServiceClient
+}
+
+func (r RemoteIdentityResolver) ResolveIdentity(ctx context.Context, claims authz.Claims) (string, error) {
+	view, err := r.Client.GetUser(ctx, &usersv1.GetUserRequest{
+		Value: &usersv1.GetUserRequest_SsoId{SsoId: claims.Subject},
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return "", nil
+		}
+		return "", err
+	}
+
+	return view.User.Id, nil
+}
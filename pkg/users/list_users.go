@@ -2,6 +2,7 @@ package users
 
 import (
 	"context"
+	"errors"
 
 	"github.com/milsim-tools/pincer/internal/helpers"
 	usersv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/users/v1"
@@ -10,41 +11,66 @@ import (
 	"gorm.io/gorm"
 )
 
+// orderByColumns are the fields users can be ordered by.
+var orderByColumns = helpers.OrderByColumns{
+	"username":     "username",
+	"display_name": "display_name",
+	"created_at":   "created_at",
+	"updated_at":   "updated_at",
+}
+
+// filter translates the filters of ListUsers.
+var filter = helpers.MustNewFilter(&usersv1.User{}, helpers.FilterColumns{
+	"id":           "id",
+	"username":     "username",
+	"display_name": "display_name",
+	"created_at":   "created_at",
+	"updated_at":   "updated_at",
+})
+
 func (s *Users) ListUsers(ctx context.Context, req *usersv1.ListUsersRequest) (*usersv1.ListUsersResponse, error) {
-	qb := gorm.G[UsersUser](s.db.Db).Select("*")
-	qb = helpers.ApplyPageLimit(qb, int(req.PageSize))
+	order, err := helpers.ParseOrderBy(req.OrderBy, orderByColumns)
+	if err != nil {
+		return &usersv1.ListUsersResponse{}, err
+	}
 
-	cursor, err := helpers.CursorFromString(req.PageToken)
+	where, err := filter.Parse(req.Filter)
 	if err != nil {
-		return &usersv1.ListUsersResponse{}, status.Error(
-			codes.InvalidArgument,
-			"invalid page_token format",
-		)
+		return &usersv1.ListUsersResponse{}, err
 	}
 
-	if cursor != nil {
-		qb = helpers.ApplyCursor(qb, cursor)
+	qb := gorm.G[UsersUser](s.db.Replica).Select("*")
+	if where != nil {
+		qb = qb.Where(where)
 	}
 
-	users, err := qb.Find(ctx)
+	users, next, err := helpers.Paginate(ctx, s.pages, qb, helpers.Page{
+		Size:    int(req.PageSize),
+		Token:   req.PageToken,
+		Order:   order,
+		Filters: []any{req.Filter},
+	})
 	if err != nil {
+		if errors.Is(err, helpers.ErrInvalidPageToken) {
+			return &usersv1.ListUsersResponse{}, status.Error(codes.InvalidArgument, err.Error())
+		}
 		return &usersv1.ListUsersResponse{}, status.Error(
 			codes.Internal,
-			"failed to query unit: "+err.Error(),
+			"failed to query users: "+err.Error(),
 		)
 	}
 
 	var userViews []*usersv1.UserView
 	for _, user := range users {
 		userViews = append(userViews, &usersv1.UserView{
-			User:      user.Proto(),
+			User:      s.view(ctx, user),
 			UnitCount: 0,
 		})
 	}
 
 	resp := &usersv1.ListUsersResponse{
 		Users:         userViews,
-		NextPageToken: "",
+		NextPageToken: next,
 	}
 
 	return resp, nil
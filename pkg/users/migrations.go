@@ -0,0 +1,17 @@
+package users
+
+import (
+	"embed"
+
+	"github.com/milsim-tools/pincer/pkg/db/migrate"
+)
+
+//go:embed migrations/*.sql
+var migrationsFS embed.FS
+
+// Migrations are the schema migrations of the users module.
+var Migrations = migrate.Source{
+	Module: "users",
+	FS:     migrationsFS,
+	Dir:    "migrations",
+}
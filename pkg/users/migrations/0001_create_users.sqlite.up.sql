@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS users_users (
+  id text PRIMARY KEY,
+  created_at datetime,
+  updated_at datetime,
+  sso_id text NOT NULL,
+  display_name text NOT NULL,
+  username text NOT NULL,
+  email text NOT NULL,
+  bio text,
+  avatar_url text
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_users_users_sso_id ON users_users (sso_id);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_users_users_username ON users_users (username);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_users_users_email ON users_users (email);
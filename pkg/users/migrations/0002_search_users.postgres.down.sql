@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_users_users_search;
+
+ALTER TABLE users_users DROP COLUMN IF EXISTS search;
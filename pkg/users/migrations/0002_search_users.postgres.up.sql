@@ -0,0 +1,6 @@
+CREATE EXTENSION IF NOT EXISTS pg_trgm WITH SCHEMA public;
+
+ALTER TABLE users_users ADD COLUMN IF NOT EXISTS search tsvector
+  GENERATED ALWAYS AS (to_tsvector('simple', username || ' ' || display_name)) STORED;
+
+CREATE INDEX IF NOT EXISTS idx_users_users_search ON users_users USING gin (search);
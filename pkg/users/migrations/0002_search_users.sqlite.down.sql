@@ -0,0 +1 @@
+-- SQLite searches users with LIKE, which needs no index.
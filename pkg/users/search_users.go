@@ -0,0 +1,80 @@
+package users
+
+import (
+	"context"
+	"errors"
+
+	"github.com/milsim-tools/pincer/internal/helpers"
+	usersv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/users/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// search matches users by their names, indexed by the search column of the
+// users_users table on Postgres.
+var search = helpers.Search{
+	Vector: "search",
+	Columns: []helpers.SearchColumn{
+		{Name: "username", Weight: 1, Fuzzy: true},
+		{Name: "display_name", Weight: 1, Fuzzy: true},
+	},
+}
+
+// usersSearchResult is a user matching a search, with its rank.
+type usersSearchResult struct {
+	UsersUser
+
+	Rank float64
+}
+
+func (s *Users) SearchUsers(ctx context.Context, req *usersv1.SearchUsersRequest) (*usersv1.SearchUsersResponse, error) {
+	terms := helpers.SearchTerms(req.Query)
+	if len(terms) == 0 {
+		return &usersv1.SearchUsersResponse{}, helpers.InvalidArgument("query", "the query has no words to search for")
+	}
+
+	qb := gorm.G[usersSearchResult](s.db.Replica).
+		Table("(?) AS results", search.Query(s.db.Replica, "users_users", terms)).
+		Select("*")
+
+	results, next, err := helpers.Paginate(ctx, s.pages, qb, helpers.Page{
+		Size:    int(req.PageSize),
+		Token:   req.PageToken,
+		Order:   []helpers.Order{{Column: helpers.RankColumn, Desc: true}},
+		Filters: []any{terms},
+	})
+	if err != nil {
+		if errors.Is(err, helpers.ErrInvalidPageToken) {
+			return &usersv1.SearchUsersResponse{}, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return &usersv1.SearchUsersResponse{}, status.Error(
+			codes.Internal,
+			"failed to search users: "+err.Error(),
+		)
+	}
+
+	var userResults []*usersv1.UserSearchResult
+	for _, result := range results {
+		highlights := map[string]string{}
+		if highlight, ok := helpers.Highlight(result.Username, terms); ok {
+			highlights["username"] = highlight
+		}
+		if highlight, ok := helpers.Highlight(result.DisplayName, terms); ok {
+			highlights["display_name"] = highlight
+		}
+
+		userResults = append(userResults, &usersv1.UserSearchResult{
+			User:       s.view(ctx, result.UsersUser),
+			Rank:       result.Rank,
+			Highlights: highlights,
+		})
+	}
+
+	resp := &usersv1.SearchUsersResponse{
+		Results:       userResults,
+		NextPageToken: next,
+	}
+
+	return resp, nil
+}
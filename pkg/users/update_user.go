@@ -3,8 +3,9 @@ package users
 import (
 	"context"
 	"errors"
-	"slices"
+	"strings"
 
+	"github.com/milsim-tools/pincer/internal/helpers"
 	usersv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/users/v1"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -27,28 +28,39 @@ func (s *Users) UpdateUser(ctx context.Context, req *usersv1.UpdateUserRequest)
 		)
 	}
 
-	if slices.Contains(req.UpdateMask.GetPaths(), "user.sso_id") {
-		user.SSOID = req.User.SsoId
-	}
-
-	if slices.Contains(req.UpdateMask.GetPaths(), "user.display_name") {
-		user.DisplayName = req.User.DisplayName
-	}
-
-	if slices.Contains(req.UpdateMask.GetPaths(), "user.email") {
-		user.Email = req.User.Email
-	}
-
-	if slices.Contains(req.UpdateMask.GetPaths(), "user.bio") {
-		user.Bio = req.User.Bio
-	}
+	// Paths are accepted both relative to the user, as the REST gateway sends
+	// them, and prefixed with `user.`.
+	for _, path := range req.UpdateMask.GetPaths() {
+		field := strings.TrimPrefix(path, "user.")
 
-	if slices.Contains(req.UpdateMask.GetPaths(), "user.username") {
-		user.Username = req.User.Username
-	}
+		// The identity and email come from the identity provider. Letting
+		// users change them would let them link their account to another
+		// identity.
+		if (field == "sso_id" || field == "email") && !s.authorizer.IsTrusted(ctx) {
+			return &usersv1.User{}, status.Error(
+				codes.PermissionDenied,
+				field+" can only be updated by other modules",
+			)
+		}
 
-	if slices.Contains(req.UpdateMask.GetPaths(), "user.avatar_url") {
-		user.AvatarURL = req.User.AvatarUrl
+		switch field {
+		case "sso_id":
+			user.SSOID = req.User.SsoId
+		case "display_name":
+			user.DisplayName = req.User.DisplayName
+		case "email":
+			user.Email = req.User.Email
+		case "bio":
+			user.Bio = req.User.Bio
+		case "username":
+			user.Username = req.User.Username
+		case "avatar_url":
+			user.AvatarURL = req.User.AvatarUrl
+		case "id":
+			return &usersv1.User{}, helpers.InvalidArgument("update_mask", path+" cannot be updated")
+		default:
+			return &usersv1.User{}, helpers.InvalidArgument("update_mask", "unknown field "+path)
+		}
 	}
 
 	if _, err := gorm.G[UsersUser](s.db.Db).Updates(ctx, user); err != nil {
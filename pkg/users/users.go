@@ -1,20 +1,38 @@
 package users
 
 import (
+	"context"
 	"log/slog"
 
 	"github.com/grafana/dskit/services"
+	"github.com/milsim-tools/pincer/internal/helpers"
 	usersv1 "github.com/milsim-tools/pincer/pkg/api/gen/milsimtools/users/v1"
+	"github.com/milsim-tools/pincer/pkg/authz"
 	"github.com/milsim-tools/pincer/pkg/db"
 	"github.com/urfave/cli/v2"
 )
 
-var Flags = []cli.Flag{}
+const (
+	FlagProvisionUsers = "users-provision-on-login"
+)
+
+var Flags = []cli.Flag{
+	&cli.BoolFlag{
+		Name:    FlagProvisionUsers,
+		Usage:   "Create a user the first time an unknown token subject authenticates",
+		EnvVars: []string{"PINCER_USERS_PROVISION_ON_LOGIN"},
+	},
+}
 
-type Config struct{}
+type Config struct {
+	ProvisionUsers bool
+}
 
 func ConfigFromFlags(ctx *cli.Context) Config {
 	var config Config
+
+	config.ProvisionUsers = ctx.Bool(FlagProvisionUsers)
+
 	return config
 }
 
@@ -25,25 +43,43 @@ type Users struct {
 	cfg    Config
 	logger *slog.Logger
 
-	db *db.Db
+	db         *db.Db
+	pages      *helpers.Paginator
+	authorizer *authz.Authorizer
 }
 
 func New(
 	logger *slog.Logger,
 	cfg Config,
 	db *db.Db,
+	pages *helpers.Paginator,
+	authorizer *authz.Authorizer,
 ) (*Users, error) {
 	u := &Users{
-		cfg:    cfg,
-		logger: logger,
-		db:     db,
+		cfg:        cfg,
+		logger:     logger,
+		db:         db,
+		pages:      pages,
+		authorizer: authorizer,
 	}
 
-	if err := db.Db.AutoMigrate(&UsersUser{}); err != nil {
-		return nil, err
-	}
-
-	u.Service = services.NewIdleService(nil, nil)
+	u.Service = services.NewIdleService(u.starting, nil)
 
 	return u, nil
 }
+
+// starting migrates the database once it is reachable.
+func (s *Users) starting(ctx context.Context) error {
+	return s.db.Migrate(ctx, Migrations)
+}
+
+// view returns the user as the caller may see it. Only the user themself and
+// other modules see the email and identity of a user.
+func (s *Users) view(ctx context.Context, user UsersUser) *usersv1.User {
+	proto := user.Proto()
+	if !s.authorizer.IsTrusted(ctx) && !s.authorizer.IsSelf(ctx, user.ID) {
+		proto.Email = ""
+		proto.SsoId = ""
+	}
+	return proto
+}